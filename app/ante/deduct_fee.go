@@ -12,30 +12,62 @@ import (
 	sdkerrors "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types/errors"
 	authante "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/auth/ante"
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/auth/types"
+
+	wasm "github.com/NibiruChain/nibiru/v2/x/wasm/types"
+
+	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	"github.com/NibiruChain/nibiru/v2/evm"
 )
 
 // DeductFeeDecorator deducts fees from the fee payer. The fee payer is the fee
-// granter (if specified) or first signer of the tx. If the fee payer does not
-// have the funds to pay for the fees, return an InsufficientFunds error.
+// granter (if specified), the x/sudo gas sponsor of the called Wasm contracts
+// (if any), or first signer of the tx. If the fee payer does not have the funds
+// to pay for the fees, return an InsufficientFunds error.
+// Fees may be paid in unibi or in one of the fee tokens of the EVM params,
+// which are valued in unibi for the minimum gas price check.
 // Call next AnteHandler if fees successfully deducted.
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
-	accountKeeper  authante.AccountKeeper
-	bankKeeper     types.BankKeeper
-	feegrantKeeper authante.FeegrantKeeper
-	txFeeChecker   authante.TxFeeChecker
+	accountKeeper    authante.AccountKeeper
+	bankKeeper       types.BankKeeper
+	feegrantKeeper   authante.FeegrantKeeper
+	gasSponsorKeeper GasSponsorKeeper
+	feeTokenKeeper   FeeTokenKeeper
+	txFeeChecker     authante.TxFeeChecker
+}
+
+// GasSponsorKeeper pays the fees of transactions covered by an x/sudo gas
+// sponsor.
+type GasSponsorKeeper interface {
+	GetGasSponsor(ctx sdk.Context, sender, contract sdk.AccAddress) (sponsor sdk.AccAddress, ok bool)
+	DeductGasSponsorFee(ctx sdk.Context, sponsor, sender sdk.AccAddress, fee sdkmath.Int) error
+}
+
+// FeeTokenKeeper prices fees paid in the fee tokens of the EVM params, which
+// can pay for gas in place of NIBI.
+type FeeTokenKeeper interface {
+	FeeTokenToNative(ctx sdk.Context, fee sdk.Coin) (sdkmath.Int, error)
 }
 
-func NewDeductFeeDecorator(ak authante.AccountKeeper, bk types.BankKeeper, fk authante.FeegrantKeeper, tfc authante.TxFeeChecker) DeductFeeDecorator {
+func NewDeductFeeDecorator(
+	ak authante.AccountKeeper,
+	bk types.BankKeeper,
+	fk authante.FeegrantKeeper,
+	gsk GasSponsorKeeper,
+	ftk FeeTokenKeeper,
+	tfc authante.TxFeeChecker,
+) DeductFeeDecorator {
 	if tfc == nil {
 		tfc = checkTxFeeWithValidatorMinGasPrices
 	}
 
 	return DeductFeeDecorator{
-		accountKeeper:  ak,
-		bankKeeper:     bk,
-		feegrantKeeper: fk,
-		txFeeChecker:   tfc,
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		feegrantKeeper:   fk,
+		gasSponsorKeeper: gsk,
+		feeTokenKeeper:   ftk,
+		txFeeChecker:     tfc,
 	}
 }
 
@@ -68,7 +100,7 @@ func (anteDec DeductFeeDecorator) AnteHandle(
 	// Deduct fees
 	fee := feeTx.GetFee()
 	if !simulate {
-		fee, priority, err = anteDec.txFeeChecker(ctx, tx)
+		fee, priority, err = anteDec.checkTxFee(ctx, feeTx)
 		if err != nil {
 			return ctx, err
 		}
@@ -81,6 +113,43 @@ func (anteDec DeductFeeDecorator) AnteHandle(
 	return next(newCtx, tx, simulate)
 }
 
+// checkTxFee runs the TxFeeChecker on the fee of the tx. A fee paid in a fee
+// token is checked at its NIBI-equivalent value, but the fee token is still
+// what gets deducted.
+func (anteDec DeductFeeDecorator) checkTxFee(
+	ctx sdk.Context,
+	feeTx sdk.FeeTx,
+) (sdk.Coins, int64, error) {
+	fee := feeTx.GetFee()
+	if anteDec.feeTokenKeeper == nil || len(fee) != 1 ||
+		fee[0].Denom == appconst.DENOM_UNIBI {
+		return anteDec.txFeeChecker(ctx, feeTx)
+	}
+
+	feeNative, err := anteDec.feeTokenKeeper.FeeTokenToNative(ctx, fee[0])
+	if err != nil {
+		// Not a fee token, or a fee token without a price.
+		return anteDec.txFeeChecker(ctx, feeTx)
+	}
+	_, priority, err := anteDec.txFeeChecker(ctx, feeTokenTx{
+		FeeTx: feeTx,
+		fee:   sdk.NewCoins(sdk.NewCoin(appconst.DENOM_UNIBI, feeNative)),
+	})
+	if err != nil {
+		return nil, 0, sdkioerrors.Wrapf(err, "fee of %s is worth %sunibi", fee, feeNative)
+	}
+	return fee, priority, nil
+}
+
+// feeTokenTx overrides the fee of a tx paid in a fee token with its
+// NIBI-equivalent value.
+type feeTokenTx struct {
+	sdk.FeeTx
+	fee sdk.Coins
+}
+
+func (tx feeTokenTx) GetFee() sdk.Coins { return tx.fee }
+
 func (anteDec DeductFeeDecorator) checkDeductFee(
 	ctx sdk.Context,
 	sdkTx sdk.Tx,
@@ -114,6 +183,17 @@ func (anteDec DeductFeeDecorator) checkDeductFee(
 		deductFeesFrom = feeGranter
 	}
 
+	if feeGranter == nil {
+		if sponsor, ok := anteDec.deductFeeFromGasSponsor(ctx, sdkTx, feePayer, fee); ok {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				sdk.EventTypeTx,
+				sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+				sdk.NewAttribute(sdk.AttributeKeyFeePayer, sponsor.String()),
+			))
+			return nil
+		}
+	}
+
 	deductFeesFromAcc := anteDec.accountKeeper.GetAccount(ctx, deductFeesFrom)
 	if deductFeesFromAcc == nil {
 		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
@@ -139,6 +219,67 @@ func (anteDec DeductFeeDecorator) checkDeductFee(
 	return nil
 }
 
+// deductFeeFromGasSponsor pays fee from the escrow of the x/sudo gas sponsor
+// covering the tx, if any. A tx is covered when every message is a Wasm execute
+// from feePayer to contracts of the same sponsor and the fee is paid in unibi.
+//
+// Sponsors pay at most the gas limit times the global base fee, the same price
+// EVM txs pay, so a covered sender cannot drain the escrow by declaring a high
+// fee. If the fee is higher than that, or the sponsor cannot pay (max fee per
+// tx, escrow, or daily cap exceeded), nothing is deducted and ok is false so
+// that the fee payer pays as usual.
+func (anteDec DeductFeeDecorator) deductFeeFromGasSponsor(
+	ctx sdk.Context,
+	sdkTx sdk.Tx,
+	feePayer sdk.AccAddress,
+	fee sdk.Coins,
+) (sponsor sdk.AccAddress, ok bool) {
+	if anteDec.gasSponsorKeeper == nil || fee.IsZero() ||
+		len(fee) != 1 || fee[0].Denom != appconst.DENOM_UNIBI {
+		return nil, false
+	}
+
+	feeTx, isFeeTx := sdkTx.(sdk.FeeTx)
+	if !isFeeTx {
+		return nil, false
+	}
+	maxSponsoredFee := sdkmath.NewIntFromUint64(feeTx.GetGas()).
+		Mul(sdkmath.NewIntFromBigInt(evm.BASE_FEE_MICRONIBI))
+	if fee.AmountOf(appconst.DENOM_UNIBI).GT(maxSponsoredFee) {
+		return nil, false
+	}
+
+	msgs := sdkTx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, false
+	}
+	for _, msg := range msgs {
+		msgExec, isExec := msg.(*wasm.MsgExecuteContract)
+		if !isExec || msgExec.Sender != feePayer.String() {
+			return nil, false
+		}
+		contract, err := sdk.AccAddressFromBech32(msgExec.Contract)
+		if err != nil {
+			return nil, false
+		}
+		msgSponsor, found := anteDec.gasSponsorKeeper.GetGasSponsor(ctx, feePayer, contract)
+		if !found || (sponsor != nil && !sponsor.Equals(msgSponsor)) {
+			return nil, false
+		}
+		sponsor = msgSponsor
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := anteDec.gasSponsorKeeper.DeductGasSponsorFee(
+		cacheCtx, sponsor, feePayer, fee.AmountOf(appconst.DENOM_UNIBI),
+	)
+	if err != nil {
+		return nil, false
+	}
+	writeCache()
+	return sponsor, true
+}
+
 // deductFees deducts fees from the given account.
 func deductFees(
 	bankKeeper types.BankKeeper,
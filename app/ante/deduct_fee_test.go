@@ -1,6 +1,10 @@
 package ante_test
 
 import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
 	cryptotypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/crypto/types"
 	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
 
@@ -57,7 +61,7 @@ func (s *Suite) TestDeductFeeDecorator_ZeroGas() {
 	)
 	s.Require().NoError(err)
 
-	dfd := ante.NewDeductFeeDecorator(deps.App.AccountKeeper, deps.App.BankKeeper, deps.App.FeeGrantKeeper, nil)
+	dfd := ante.NewDeductFeeDecorator(deps.App.AccountKeeper, deps.App.BankKeeper, deps.App.FeeGrantKeeper, deps.App.SudoKeeper, deps.App.EvmKeeper, nil)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 
 	// Set IsCheckTx to true and expect error on zero gas (simulate=false)
@@ -106,7 +110,7 @@ func (s *Suite) TestEnsureMempoolFees() {
 	)
 	s.Require().NoError(err)
 
-	dfd := ante.NewDeductFeeDecorator(deps.App.AccountKeeper, deps.App.BankKeeper, deps.App.FeeGrantKeeper, nil)
+	dfd := ante.NewDeductFeeDecorator(deps.App.AccountKeeper, deps.App.BankKeeper, deps.App.FeeGrantKeeper, deps.App.SudoKeeper, deps.App.EvmKeeper, nil)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 
 	// Set high gas price so standard test fee fails
@@ -179,7 +183,7 @@ func (s *Suite) TestDeductFees() {
 	)
 	s.Require().NoError(err)
 
-	dfd := ante.NewDeductFeeDecorator(deps.App.AccountKeeper, deps.App.BankKeeper, nil, nil)
+	dfd := ante.NewDeductFeeDecorator(deps.App.AccountKeeper, deps.App.BankKeeper, nil, deps.App.SudoKeeper, deps.App.EvmKeeper, nil)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 
 	// Without funds, should error
@@ -245,7 +249,7 @@ func (s *Suite) TestZeroGasActorSkipsFeeDeduction() {
 	// Chain AnteDecZeroGasActors then DeductFeeDecorator
 	antehandler := sdk.ChainAnteDecorators(
 		ante.AnteDecZeroGasActors{PublicKeepers: deps.App.PublicKeepers},
-		ante.NewDeductFeeDecorator(deps.App.AccountKeeper, deps.App.BankKeeper, deps.App.FeeGrantKeeper, nil),
+		ante.NewDeductFeeDecorator(deps.App.AccountKeeper, deps.App.BankKeeper, deps.App.FeeGrantKeeper, deps.App.SudoKeeper, deps.App.EvmKeeper, nil),
 	)
 
 	balBefore := deps.App.BankKeeper.GetBalance(deps.Ctx(), zeroGasSender.NibiruAddr, appconst.DENOM_UNIBI)
@@ -257,3 +261,127 @@ func (s *Suite) TestZeroGasActorSkipsFeeDeduction() {
 	balAfter := deps.App.BankKeeper.GetBalance(deps.Ctx(), zeroGasSender.NibiruAddr, appconst.DENOM_UNIBI)
 	s.Require().Equal(balBefore, balAfter, "expect zero fees deducted for zero gas actor")
 }
+
+// feeTokenKeeperStub values every "uusdc" fee at 50 unibi per uusdc.
+type feeTokenKeeperStub struct{}
+
+func (feeTokenKeeperStub) FeeTokenToNative(_ sdk.Context, fee sdk.Coin) (sdkmath.Int, error) {
+	if fee.Denom != "uusdc" {
+		return sdkmath.Int{}, fmt.Errorf("%s is not a fee token", fee.Denom)
+	}
+	return fee.Amount.MulRaw(50), nil
+}
+
+// TestDeductFeeDecorator_FeeToken verifies that fees paid in a fee token meet
+// validator min gas prices in unibi through their NIBI-equivalent value, and
+// that the fee token itself is deducted.
+func (s *Suite) TestDeductFeeDecorator_FeeToken() {
+	deps := evmtest.NewTestDeps()
+	sender := deps.Sender
+	usdc := func(x int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("uusdc", x)) }
+	s.Require().NoError(testapp.FundAccount(deps.App.BankKeeper, deps.Ctx(), sender.NibiruAddr, usdc(1_000)))
+
+	txCfg := deps.App.GetTxConfig()
+	txBuilder := txCfg.NewTxBuilder()
+	msg := &wasm.MsgExecuteContract{
+		Sender:   sender.NibiruAddr.String(),
+		Contract: sender.NibiruAddr.String(),
+		Msg:      wasm.RawContractMessage([]byte("{}")),
+	}
+	s.Require().NoError(txBuilder.SetMsgs(msg))
+	txBuilder.SetFeeAmount(usdc(100)) // worth 5_000 unibi
+	txBuilder.SetGasLimit(1_000)
+
+	acc := deps.App.AccountKeeper.GetAccount(deps.Ctx(), sender.NibiruAddr)
+	blockTx, err := s.CreateTestTx(
+		txBuilder, []cryptotypes.PrivKey{sender.PrivKey},
+		[]uint64{acc.GetAccountNumber()},
+		[]uint64{acc.GetSequence()},
+		deps.Ctx().ChainID(), txCfg,
+	)
+	s.Require().NoError(err)
+
+	dfd := ante.NewDeductFeeDecorator(deps.App.AccountKeeper, deps.App.BankKeeper, deps.App.FeeGrantKeeper, deps.App.SudoKeeper, feeTokenKeeperStub{}, nil)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	// 5 unibi per gas * 1_000 gas > 5_000 unibi
+	ctx := deps.Ctx().WithIsCheckTx(true).
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin(appconst.DENOM_UNIBI, sdkmath.NewInt(6))))
+	_, err = antehandler(ctx, blockTx, false)
+	s.Require().ErrorContains(err, "insufficient fees")
+
+	ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoin(appconst.DENOM_UNIBI, sdkmath.NewInt(5))))
+	newCtx, err := antehandler(ctx, blockTx, false)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(5), newCtx.Priority())
+	s.Require().Equal(
+		sdk.NewInt64Coin("uusdc", 900),
+		deps.App.BankKeeper.GetBalance(deps.Ctx(), sender.NibiruAddr, "uusdc"),
+	)
+}
+
+// TestDeductFeeDecorator_GasSponsorFeeCap verifies that a gas sponsor pays at
+// most the gas limit times the base fee. A tx that declares a higher fee is paid
+// by the sender instead.
+func (s *Suite) TestDeductFeeDecorator_GasSponsorFeeCap() {
+	deps := evmtest.NewTestDeps()
+	sender := deps.Sender
+	s.Require().NoError(testapp.FundAccount(deps.App.BankKeeper, deps.Ctx(), sender.NibiruAddr, unibi(10_000)))
+
+	contract := evmtest.NewEthPrivAcc().NibiruAddr
+	sponsor := evmtest.NewEthPrivAcc().NibiruAddr
+	s.Require().NoError(testapp.FundModuleAccount(deps.App.BankKeeper, deps.Ctx(), sudo.ModuleName, unibi(10_000)))
+	deps.App.SudoKeeper.GasSponsors.Insert(deps.Ctx(), sponsor, sudo.GasSponsor{
+		Sponsor: sponsor.String(),
+		Balance: sdkmath.NewInt(10_000),
+		Policy: sudo.GasSponsorPolicy{
+			Contracts:         []string{contract.String()},
+			DailyCapPerSender: sdkmath.ZeroInt(),
+			MaxFeePerTx:       sdkmath.NewInt(10_000),
+		},
+	})
+	deps.App.SudoKeeper.GasSponsorContracts.Insert(deps.Ctx(), contract, sponsor)
+
+	txCfg := deps.App.GetTxConfig()
+	newTx := func(fee sdk.Coins, gasLimit uint64) sdk.Tx {
+		txBuilder := txCfg.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(&wasm.MsgExecuteContract{
+			Sender:   sender.NibiruAddr.String(),
+			Contract: contract.String(),
+			Msg:      wasm.RawContractMessage([]byte("{}")),
+		}))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(gasLimit)
+		acc := deps.App.AccountKeeper.GetAccount(deps.Ctx(), sender.NibiruAddr)
+		blockTx, err := s.CreateTestTx(
+			txBuilder, []cryptotypes.PrivKey{sender.PrivKey},
+			[]uint64{acc.GetAccountNumber()},
+			[]uint64{acc.GetSequence()},
+			deps.Ctx().ChainID(), txCfg,
+		)
+		s.Require().NoError(err)
+		return blockTx
+	}
+
+	dfd := ante.NewDeductFeeDecorator(deps.App.AccountKeeper, deps.App.BankKeeper, deps.App.FeeGrantKeeper, deps.App.SudoKeeper, deps.App.EvmKeeper, nil)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+	senderBalance := func() sdk.Coin {
+		return deps.App.BankKeeper.GetBalance(deps.Ctx(), sender.NibiruAddr, appconst.DENOM_UNIBI)
+	}
+
+	// 1 unibi per gas * 2_000 gas >= 2_000 unibi, so the sponsor pays.
+	_, err := antehandler(deps.Ctx(), newTx(unibi(2_000), 2_000), false)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(appconst.DENOM_UNIBI, 10_000), senderBalance())
+	gs, err := deps.App.SudoKeeper.GasSponsors.Get(deps.Ctx(), sponsor)
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.NewInt(8_000), gs.Balance)
+
+	// 1 unibi per gas * 1_000 gas < 5_000 unibi, so the sender pays.
+	_, err = antehandler(deps.Ctx(), newTx(unibi(5_000), 1_000), false)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(appconst.DENOM_UNIBI, 5_000), senderBalance())
+	gs, err = deps.App.SudoKeeper.GasSponsors.Get(deps.Ctx(), sponsor)
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.NewInt(8_000), gs.Balance)
+}
@@ -74,7 +74,7 @@ func (s *Suite) SetupTest() {
 		nibiruante.AnteDecEnsureSinglePostPriceMessage{},
 		nibiruante.AnteDecoratorStakingCommission{},
 		ante.NewConsumeGasForTxSizeDecorator(s.app.AccountKeeper),
-		ante.NewDeductFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, nil), // Replace fee ante from cosmos auth with a custom one.
+		ante.NewDeductFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.SudoKeeper, s.app.EvmKeeper, nil), // Replace fee ante from cosmos auth with a custom one.
 
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(s.app.AccountKeeper),
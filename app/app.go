@@ -375,7 +375,7 @@ func NewNibiruApp(
 
 		// ibc
 		ibc.NewAppModule(app.IbcKeeper),
-		ibctransfer.NewAppModule(app.ibcTransferKeeper),
+		ibctransfer.NewAppModule(app.IbcTransferKeeper),
 		ica.NewAppModule(&app.icaControllerKeeper, &app.icaHostKeeper),
 		ibcwasm.NewAppModule(app.WasmClientKeeper),
 
@@ -663,7 +663,7 @@ func (app *NibiruApp) GetAccountKeeper() authkeeper.AccountKeeper {
 }
 
 func (app *NibiruApp) GetTransferKeeper() ibctransferkeeper.Keeper {
-	return app.ibcTransferKeeper
+	return app.IbcTransferKeeper
 }
 
 func (app *NibiruApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
@@ -677,6 +677,12 @@ func (app *NibiruApp) GetTxConfig() client.TxConfig {
 	return app.txConfig
 }
 
+// GetEvmMempool returns the node-local [evm.Mempool]. The in-process JSON-RPC
+// server reads it to serve the "txpool" namespace.
+func (app *NibiruApp) GetEvmMempool() *evm.Mempool {
+	return app.EvmMempool
+}
+
 // ------------------------------------------------------------------------
 // Else
 // ------------------------------------------------------------------------
@@ -90,8 +90,6 @@ type AppKeepers struct {
 	   the IBC light client misbehavior evidence route. */
 	evidenceKeeper evidencekeeper.Keeper
 
-	/* ibcTransferKeeper is for cross-chain fungible token transfers. */
-	ibcTransferKeeper   ibctransferkeeper.Keeper
 	icaControllerKeeper icacontrollerkeeper.Keeper
 	icaHostKeeper       icahostkeeper.Keeper
 }
@@ -136,6 +134,7 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
 
 	// ---------------------------------- Nibiru Chain x/ keepers
+	app.SudoKeeper.SetBankKeeper(app.BankKeeper)
 	app.OracleKeeper = oraclekeeper.NewKeeper(
 		app.appCodec,
 		app.keys[oracletypes.StoreKey],
@@ -184,7 +183,7 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 		app.ScopedIBCKeeper,
 	)
 
-	app.ibcTransferKeeper = ibctransferkeeper.NewKeeper(
+	app.IbcTransferKeeper = ibctransferkeeper.NewKeeper(
 		app.appCodec,
 		app.keys[ibctransfertypes.StoreKey],
 		/* paramSubspace */ app.getSubspace(ibctransfertypes.ModuleName),
@@ -266,7 +265,8 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 		CapabilityKeeper: app.ScopedWasmKeeper,
 		BankKeeper:       app.BankKeeper,
 		Unpacker:         app.appCodec,
-		PortSource:       app.ibcTransferKeeper,
+		PortSource:       app.IbcTransferKeeper,
+		EvmKeeper:        app.EvmKeeper,
 	}
 	app.WasmMsgHandlerArgs = wmha
 	app.WasmKeeper = wasmkeeper.NewKeeper(
@@ -291,6 +291,9 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 		append(GetWasmOpts(*app, appOpts, wmha), wasmkeeper.WithWasmEngine(wasmVM))...,
 	)
 
+	app.OracleKeeper.SetAdapterKeepers(app.WasmKeeper, app.EvmKeeper)
+	app.EvmKeeper.SetOracleKeeper(app.OracleKeeper)
+
 	app.WasmClientKeeper = ibcwasmkeeper.NewKeeperWithVM(
 		app.appCodec,
 		app.keys[ibcwasmtypes.StoreKey],
@@ -300,16 +303,19 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 		app.GRPCQueryRouter(),
 	)
 
-	// DevGas uses WasmKeeper
+	// DevGas uses WasmKeeper and EvmKeeper
 	app.DevGasKeeper = devgaskeeper.NewKeeper(
 		app.keys[devgastypes.StoreKey],
 		app.appCodec,
 		app.BankKeeper,
 		app.WasmKeeper,
+		app.EvmKeeper,
 		app.AccountKeeper,
 		authtypes.FeeCollectorName,
 		govModuleAddr,
 	)
+	app.EvmKeeper.SetDevGasKeeper(app.DevGasKeeper)
+	app.SudoKeeper.SetContractOwnerKeeper(app.DevGasKeeper)
 	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
 		app.keys[tokenfactorytypes.StoreKey],
 		app.appCodec,
@@ -329,7 +335,7 @@ func (app *NibiruApp) initNonDepinjectKeepers(
 
 	ibcRouter := porttypes.NewRouter()
 
-	transferStack := ibctransfer.NewIBCModule(app.ibcTransferKeeper)
+	transferStack := ibctransfer.NewIBCModule(app.IbcTransferKeeper)
 
 	// Create Interchain Accounts Stack
 	// SendPacket, since it is originating from the application to core IBC:
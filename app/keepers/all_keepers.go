@@ -16,6 +16,7 @@ import (
 
 	// ---------------------------------------------------------------
 	// IBC imports
+	ibctransferkeeper "github.com/NibiruChain/nibiru/v2/lib/ibc-go/modules/apps/transfer/keeper"
 	ibckeeper "github.com/NibiruChain/nibiru/v2/lib/ibc-go/modules/core/keeper"
 
 	// ---------------------------------------------------------------
@@ -55,6 +56,8 @@ type PublicKeepers struct {
 	/* IbcKeeper defines each ICS keeper for IBC. IbcKeeper must be a pointer in
 	   the app, so we can SetRouter on it correctly. */
 	IbcKeeper *ibckeeper.Keeper
+	/* IbcTransferKeeper is for cross-chain fungible token transfers (ICS-20). */
+	IbcTransferKeeper ibctransferkeeper.Keeper
 
 	// ---------------
 	// Nibiru keepers
@@ -7,6 +7,7 @@ import (
 	"path"
 	"time"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	tracerslogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
 
 	"github.com/spf13/viper"
@@ -95,6 +96,18 @@ const (
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
 
+	// DefaultBundleInterval is the default interval at which the in-process
+	// ERC-4337 bundler submits the user operations in its pool.
+	DefaultBundleInterval = 2 * time.Second
+
+	// DefaultMaxBundleSize is the default max number of user operations in a
+	// single "handleOps" transaction of the in-process ERC-4337 bundler.
+	DefaultMaxBundleSize = 10
+
+	// DefaultMaxUserOpPoolSize is the default max number of user operations
+	// waiting in the pool of the in-process ERC-4337 bundler.
+	DefaultMaxUserOpPoolSize = 1000
+
 	// DefaultZeroCopy is the default value that defines if
 	// the zero-copied slices must be retained beyond current block's execution
 	// the sdk address cache will be disabled if zero-copy is enabled
@@ -163,6 +176,35 @@ type JSONRPCConfig struct {
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// Bundler configures the in-process ERC-4337 bundler served in the "eth"
+	// namespace.
+	Bundler BundlerConfig `mapstructure:"bundler"`
+}
+
+// BundlerConfig defines the configuration of the in-process ERC-4337 bundler.
+// When enabled, the JSON-RPC server exposes "eth_sendUserOperation",
+// "eth_estimateUserOperationGas", "eth_getUserOperationReceipt", and
+// "eth_supportedEntryPoints", and submits the pooled user operations to the
+// EntryPoint in "handleOps" transactions signed by the bundler key.
+type BundlerConfig struct {
+	// Enable defines if the ERC-4337 bundler should be enabled.
+	Enable bool `mapstructure:"enable"`
+	// EntryPoints is the list of supported EntryPoint (v0.6) contract
+	// addresses. The first one is the preferred entry point.
+	EntryPoints []string `mapstructure:"entry-points"`
+	// KeyFile is the path of a file with the hex-encoded secp256k1 private key
+	// that signs the "handleOps" transactions and pays for their gas.
+	KeyFile string `mapstructure:"key-file"`
+	// Beneficiary receives the gas refunds of the bundles. Defaults to the
+	// address of the bundler key.
+	Beneficiary string `mapstructure:"beneficiary"`
+	// BundleInterval is the interval at which pooled user operations are
+	// submitted.
+	BundleInterval time.Duration `mapstructure:"bundle-interval"`
+	// MaxBundleSize is the max number of user operations in a bundle.
+	MaxBundleSize int `mapstructure:"max-bundle-size"`
+	// MaxPoolSize is the max number of user operations waiting in the pool.
+	MaxPoolSize int `mapstructure:"max-pool-size"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -249,7 +291,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "net", "txpool", "debug"}
+	return []string{"web3", "eth", "net", "txpool", "debug", "trace"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -273,6 +315,21 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		EnableIndexer:            false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		Bundler:                  *DefaultBundlerConfig(),
+	}
+}
+
+// DefaultBundlerConfig returns the default ERC-4337 bundler configuration,
+// which is disabled.
+func DefaultBundlerConfig() *BundlerConfig {
+	return &BundlerConfig{
+		Enable:         false,
+		EntryPoints:    []string{},
+		KeyFile:        "",
+		Beneficiary:    "",
+		BundleInterval: DefaultBundleInterval,
+		MaxBundleSize:  DefaultMaxBundleSize,
+		MaxPoolSize:    DefaultMaxUserOpPoolSize,
 	}
 }
 
@@ -324,6 +381,45 @@ func (c JSONRPCConfig) Validate() error {
 		seenAPIs[api] = true
 	}
 
+	return c.Bundler.Validate()
+}
+
+// Validate returns an error if the bundler configuration fields are invalid.
+func (c BundlerConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+
+	if len(c.EntryPoints) == 0 {
+		return errors.New("cannot enable the bundler without any entry point")
+	}
+
+	for _, entryPoint := range c.EntryPoints {
+		if !gethcommon.IsHexAddress(entryPoint) {
+			return fmt.Errorf("invalid bundler entry point address '%s'", entryPoint)
+		}
+	}
+
+	if c.KeyFile == "" {
+		return errors.New("cannot enable the bundler without a key file")
+	}
+
+	if c.Beneficiary != "" && !gethcommon.IsHexAddress(c.Beneficiary) {
+		return fmt.Errorf("invalid bundler beneficiary address '%s'", c.Beneficiary)
+	}
+
+	if c.BundleInterval <= 0 {
+		return errors.New("bundler bundle interval must be positive")
+	}
+
+	if c.MaxBundleSize <= 0 {
+		return errors.New("bundler max bundle size must be positive")
+	}
+
+	if c.MaxPoolSize <= 0 {
+		return errors.New("bundler max pool size must be positive")
+	}
+
 	return nil
 }
 
@@ -482,6 +578,34 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+[json-rpc.bundler]
+
+# Enable defines if the in-process ERC-4337 bundler should be enabled. It serves
+# eth_sendUserOperation, eth_estimateUserOperationGas, eth_getUserOperationReceipt
+# and eth_supportedEntryPoints in the "eth" namespace.
+enable = {{ .JSONRPC.Bundler.Enable }}
+
+# EntryPoints is the list of supported EntryPoint (v0.6) contract addresses.
+# Example: "0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789"
+entry-points = "{{range $index, $elmt := .JSONRPC.Bundler.EntryPoints}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# KeyFile is the path of a file with the hex-encoded private key that signs the
+# handleOps transactions and pays for their gas.
+key-file = "{{ .JSONRPC.Bundler.KeyFile }}"
+
+# Beneficiary receives the gas refunds of the bundles. Defaults to the address of
+# the bundler key.
+beneficiary = "{{ .JSONRPC.Bundler.Beneficiary }}"
+
+# BundleInterval is the interval at which pooled user operations are submitted.
+bundle-interval = "{{ .JSONRPC.Bundler.BundleInterval }}"
+
+# MaxBundleSize is the max number of user operations in a single bundle.
+max-bundle-size = {{ .JSONRPC.Bundler.MaxBundleSize }}
+
+# MaxPoolSize is the max number of user operations waiting in the pool.
+max-pool-size = {{ .JSONRPC.Bundler.MaxPoolSize }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
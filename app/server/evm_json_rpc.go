@@ -12,6 +12,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi"
+	"github.com/NibiruChain/nibiru/v2/evm"
 
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
@@ -39,6 +40,7 @@ func StartEthereumJSONRPC(
 	tmEndpoint string,
 	config *srvconfig.Config,
 	indexer eth.EVMTxIndexer,
+	evmMempool *evm.Mempool,
 ) (*http.Server, chan struct{}, error) {
 	tmWsClientForRPCApi := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
 
@@ -53,7 +55,9 @@ func StartEthereumJSONRPC(
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpcapi.GetRPCAPIs(ctx, clientCtx, tmWsClientForRPCApi, allowUnprotectedTxs, indexer, rpcAPIArr)
+	apis := rpcapi.GetRPCAPIs(
+		ctx, clientCtx, tmWsClientForRPCApi, allowUnprotectedTxs, indexer, evmMempool, rpcAPIArr,
+	)
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -52,6 +52,9 @@ const (
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
 	JSONRPCEnableMetrics       = "metrics"
+	JSONRPCBundlerEnable       = "json-rpc.bundler.enable"
+	JSONRPCBundlerEntryPoints  = "json-rpc.bundler.entry-points"
+	JSONRPCBundlerKeyFile      = "json-rpc.bundler.key-file"
 )
 
 // EVM flags
@@ -0,0 +1,74 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/codec"
+	codectypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/codec/types"
+	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/server/rosetta"
+
+	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	ethrosetta "github.com/NibiruChain/nibiru/v2/eth/rosetta"
+)
+
+// RosettaCommand returns the "rosetta" command, which runs a standalone Mesh
+// (Rosetta) API server against the gRPC and Tendermint RPC endpoints of a
+// node. NIBI balances and operations are reported in wei, including the NIBI
+// moved by the EVM. See [ethrosetta.Extension].
+func RosettaCommand(ir codectypes.InterfaceRegistry, cdc codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rosetta",
+		Short: "Run a Mesh (Rosetta) API server connected to a Nibiru node",
+		Long: `Run a Mesh (Rosetta) API server connected to a Nibiru node.
+
+The server implements the Data and Construction APIs. NIBI is reported as the
+currency "NIBI" with 18 decimals, so that balances include the NIBI moved by
+EVM transactions. The Construction API signs with "eth_secp256k1" keys, the
+default key type of Nibiru accounts.
+
+Example:
+$ nibid rosetta --network cataclysm-1 --grpc localhost:9090 --tendermint localhost:26657
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conf, err := rosetta.FromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			protoCodec, ok := cdc.(*codec.ProtoCodec)
+			if !ok {
+				return fmt.Errorf("expected *codec.ProtoCodec, got: %T", cdc)
+			}
+			conf.WithCodec(ir, protoCodec)
+			conf.Extension = ethrosetta.NewExtension()
+
+			rosettaSrv, err := rosetta.ServerFromConfig(conf)
+			if err != nil {
+				return err
+			}
+			return rosettaSrv.Start()
+		},
+	}
+	rosetta.SetFlags(cmd.Flags())
+	setRosettaFlagDefaults(cmd.Flags())
+
+	return cmd
+}
+
+// setRosettaFlagDefaults replaces the defaults of the Cosmos-SDK rosetta flags
+// with values for Nibiru.
+func setRosettaFlagDefaults(flags *pflag.FlagSet) {
+	for name, value := range map[string]string{
+		rosetta.FlagBlockchain:      "nibiru",
+		rosetta.FlagDenomToSuggest:  appconst.DENOM_UNIBI,
+		rosetta.FlagPricesToSuggest: "1" + appconst.DENOM_UNIBI,
+	} {
+		flag := flags.Lookup(name)
+		flag.DefValue = value
+		_ = flag.Value.Set(value)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/app/server/config"
 	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/eth/indexer"
+	"github.com/NibiruChain/nibiru/v2/evm"
 
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 
@@ -41,6 +42,8 @@ import (
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/server/rosetta"
 	crgserver "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/server/rosetta/lib/server"
 
+	ethrosetta "github.com/NibiruChain/nibiru/v2/eth/rosetta"
+
 	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
 
 	sdkioerrors "cosmossdk.io/errors"
@@ -70,6 +73,12 @@ func NewDefaultStartOptions(appCreator types.AppCreator, defaultNodeHome string)
 	}
 }
 
+// evmMempoolApp is an application with a node-local [evm.Mempool], which the
+// JSON-RPC "txpool" namespace reads when the node runs in-process.
+type evmMempoolApp interface {
+	GetEvmMempool() *evm.Mempool
+}
+
 func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("application", backendType, dataDir)
@@ -200,6 +209,9 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int(JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
+	cmd.Flags().Bool(JSONRPCBundlerEnable, false, "Define if the in-process ERC-4337 bundler should be enabled")
+	cmd.Flags().StringSlice(JSONRPCBundlerEntryPoints, []string{}, "Defines the list of supported ERC-4337 EntryPoint addresses")
+	cmd.Flags().String(JSONRPCBundlerKeyFile, "", "the file with the hex-encoded private key of the ERC-4337 bundler")
 
 	cmd.Flags().String(EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
@@ -519,8 +531,12 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, opts Start
 
 		tmEndpoint := "/websocket"
 		tmRPCAddr := cfg.RPC.ListenAddress
+		var evmMempool *evm.Mempool
+		if evmApp, ok := app.(evmMempoolApp); ok {
+			evmMempool = evmApp.GetEvmMempool()
+		}
 		httpSrv, httpSrvDone, err = StartEthereumJSONRPC(
-			ctx, clientCtx, tmRPCAddr, tmEndpoint, &conf, evmIdxer,
+			ctx, clientCtx, tmRPCAddr, tmEndpoint, &conf, evmIdxer, evmMempool,
 		)
 		if err != nil {
 			return err
@@ -576,6 +592,7 @@ func startInProcess(ctx *sdkserver.Context, clientCtx client.Context, opts Start
 			GasPrices:           minGasPrices.Sort(),
 			Codec:               clientCtx.Codec.(*codec.ProtoCodec),
 			InterfaceRegistry:   clientCtx.InterfaceRegistry,
+			Extension:           ethrosetta.NewExtension(),
 		}
 
 		rosettaSrv, err = rosetta.ServerFromConfig(conf)
@@ -56,6 +56,7 @@ var AllUpgrades = []Upgrade{
 	Upgrade2_15_0,
 	Upgrade2_16_0,
 	Upgrade2_17_0,
+	Upgrade2_18_0,
 }
 
 // HandlerImpl is a struct wrapper for custom upgrade handler implementations.
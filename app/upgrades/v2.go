@@ -94,6 +94,12 @@ var (
 	}
 
 	Upgrade2_17_0 = NewVanillaUpgrade("v2.17.0")
+
+	Upgrade2_18_0 = Upgrade{
+		UpgradeName:   "v2.18.0",
+		Handler:       Handler_v2_18{},
+		StoreUpgrades: store.StoreUpgrades{},
+	}
 )
 
 var _ HandlerImpl = (*Handler_v2_16)(nil)
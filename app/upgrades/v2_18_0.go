@@ -0,0 +1,54 @@
+package upgrades
+
+import (
+	"fmt"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types/module"
+	upgradetypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/upgrade/types"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+)
+
+var _ HandlerImpl = (*Handler_v2_18)(nil)
+
+// Handler_v2_18 activates the Cancun fork rules of the EVM at the upgrade
+// height by setting the "cancun_time" EVM param to the upgrade block time.
+type Handler_v2_18 struct{}
+
+func (h Handler_v2_18) Handler(
+	mm *module.Manager,
+	cfg module.Configurator,
+	nibiru *keepers.PublicKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(
+		ctx sdk.Context,
+		plan upgradetypes.Plan,
+		fromVM module.VersionMap,
+	) (module.VersionMap, error) {
+		err := h.runUpgrade2_18_0(nibiru, ctx)
+		if err != nil {
+			ctx.Logger().Error("v2.18.0 upgrade failure", "err", err)
+			ctx.EventManager().EmitEvent(
+				NewEventUpgradeFailure("v2.18.0", err),
+			)
+		}
+		return mm.RunMigrations(ctx, cfg, fromVM)
+	}
+}
+
+func (h Handler_v2_18) runUpgrade2_18_0(
+	nibiru *keepers.PublicKeepers,
+	ctx sdk.Context,
+) error {
+	params := nibiru.EvmKeeper.GetParams(ctx)
+	if params.CancunTime != 0 {
+		// Already scheduled by governance
+		return nil
+	}
+	params.CancunTime = uint64(ctx.BlockTime().Unix())
+	if err := nibiru.EvmKeeper.SetParams(ctx, params); err != nil {
+		return fmt.Errorf("failed to activate Cancun: %w", err)
+	}
+	return nil
+}
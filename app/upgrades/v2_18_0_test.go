@@ -0,0 +1,34 @@
+package upgrades_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/app/upgrades"
+	"github.com/NibiruChain/nibiru/v2/evm/evmtest"
+)
+
+func TestUpgrade2_18_0_ActivatesCancun(t *testing.T) {
+	deps := evmtest.NewTestDeps()
+	require.Nil(t, deps.EvmKeeper.GetEVMConfig(deps.Ctx()).ChainConfig.CancunTime)
+
+	require.NoError(t, deps.RunUpgrade(upgrades.Upgrade2_18_0))
+
+	blockTime := uint64(deps.Ctx().BlockTime().Unix())
+	require.Equal(t, blockTime, deps.EvmKeeper.GetParams(deps.Ctx()).CancunTime)
+	chainConfig := deps.EvmKeeper.GetEVMConfig(deps.Ctx()).ChainConfig
+	require.True(t, chainConfig.IsCancun(big.NewInt(deps.Ctx().BlockHeight()), blockTime))
+}
+
+func TestUpgrade2_18_0_KeepsScheduledCancunTime(t *testing.T) {
+	deps := evmtest.NewTestDeps()
+	params := deps.EvmKeeper.GetParams(deps.Ctx())
+	params.CancunTime = uint64(deps.Ctx().BlockTime().Unix()) + 3600
+	require.NoError(t, deps.EvmKeeper.SetParams(deps.Ctx(), params))
+
+	require.NoError(t, deps.RunUpgrade(upgrades.Upgrade2_18_0))
+
+	require.Equal(t, params.CancunTime, deps.EvmKeeper.GetParams(deps.Ctx()).CancunTime)
+}
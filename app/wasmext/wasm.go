@@ -2,6 +2,7 @@ package wasmext
 
 import (
 	"github.com/NibiruChain/nibiru/v2/evm"
+	evmstate "github.com/NibiruChain/nibiru/v2/evm/evmstate"
 
 	sdkioerrors "cosmossdk.io/errors"
 
@@ -31,6 +32,7 @@ func NibiruWasmOptions(
 			grpcQueryRouter,
 			appCodec,
 		),
+		Custom: NewEvmCustomQuerier(msgHandlerArgs.EvmKeeper),
 	})
 
 	wasmMsgHandlerOption := wasmkeeper.WithMessageHandler(WasmMessageHandler(msgHandlerArgs))
@@ -56,7 +58,8 @@ func (h SDKMessageHandler) handleSdkMessage(ctx sdk.Context, contractAddr sdk.Ad
 	msgTypeUrl := sdk.MsgTypeURL(msg)
 	switch msgTypeUrl {
 	case sdk.MsgTypeURL(new(evm.MsgEthereumTx)):
-		return nil, sdkioerrors.Wrap(sdkerrors.ErrUnauthorized, "Wasm VM to EVM call pattern is not yet supported")
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrUnauthorized,
+			"Wasm VM dispatch of MsgEthereumTx is not allowed: use the \"evm_call\" custom message for Wasm VM to EVM calls")
 	case sdk.MsgTypeURL(new(authz.MsgExec)),
 		sdk.MsgTypeURL(new(authz.MsgGrant)),
 		sdk.MsgTypeURL(new(authz.MsgRevoke)):
@@ -85,6 +88,9 @@ type MsgHandlerArgs struct {
 	BankKeeper       wasm.Burner
 	Unpacker         sdkcodec.AnyUnpacker
 	PortSource       wasm.ICS20TransferPortSource
+	// EvmKeeper runs the EVM calls of "evm_call" and "evm_static_call"
+	// custom messages and queries from Wasm contracts.
+	EvmKeeper *evmstate.Keeper
 }
 
 // SDKMessageHandler can handles messages that can be encoded into sdk.Message types and routed.
@@ -114,6 +120,7 @@ func WasmMessageHandler(
 		NewSDKMessageHandler(args.Router, encoders),
 		wasmkeeper.NewIBCRawPacketHandler(args.Ics4Wrapper, args.ChannelKeeper, args.CapabilityKeeper),
 		wasmkeeper.NewBurnCoinMessageHandler(args.BankKeeper),
+		NewEvmCallMessageHandler(args.EvmKeeper),
 	)
 }
 
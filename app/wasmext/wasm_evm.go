@@ -0,0 +1,244 @@
+package wasmext
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	sdkioerrors "cosmossdk.io/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	sdkerrors "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types/errors"
+	"github.com/NibiruChain/nibiru/v2/lib/wasmvm/wvm"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/evm"
+	evmstate "github.com/NibiruChain/nibiru/v2/evm/evmstate"
+	wasmkeeper "github.com/NibiruChain/nibiru/v2/x/wasm/keeper"
+	wasm "github.com/NibiruChain/nibiru/v2/x/wasm/types"
+)
+
+// EvmCallMaxGasLimit is the most EVM gas a single Wasm-to-EVM call can use
+// when the Wasm execution itself runs with an infinite gas meter.
+const EvmCallMaxGasLimit uint64 = 10_000_000
+
+// Untyped event emitted for each Wasm-to-EVM call
+const (
+	EventTypeWasmEvmCall       = "wasm_evm_call"
+	EventAttrWasmEvmCallSender = "sender_evm"
+	EventAttrWasmEvmCallTo     = "to"
+	EventAttrWasmEvmCallGas    = "gas_used"
+	EventAttrWasmEvmCallLogs   = "evm_logs"
+)
+
+// NibiruMsg is the JSON schema of "CosmosMsg::Custom" messages understood by
+// Nibiru. Exactly one field must be set.
+//
+//	{ "evm_call": { "to": "0x...", "input": "<base64>", "gas_limit": 100000 } }
+type NibiruMsg struct {
+	EvmCall *EvmCall `json:"evm_call,omitempty"`
+}
+
+// NibiruQuery is the JSON schema of "QueryRequest::Custom" queries understood
+// by Nibiru. Exactly one field must be set.
+//
+//	{ "evm_static_call": { "to": "0x...", "input": "<base64>" } }
+type NibiruQuery struct {
+	EvmStaticCall *EvmCall `json:"evm_static_call,omitempty"`
+}
+
+// EvmCall is a call into an EVM contract made by a Wasm contract.
+//
+// The sender of the call is the Wasm contract's own 0x address, which is
+// [eth.NibiruAddrToEthAddr] of the contract address. For queries, "from" may
+// set the sender since queries have no caller.
+//
+// Calls are non-payable. Move funds with bank or FunToken messages instead.
+type EvmCall struct {
+	// To: Hex address of the EVM contract to call.
+	To string `json:"to"`
+	// Input: ABI-encoded call data (base64 in JSON, like CosmWasm "Binary").
+	Input []byte `json:"input"`
+	// GasLimit: Optional cap on the EVM gas for the call. Zero means all of
+	// the gas remaining in the Wasm execution.
+	GasLimit uint64 `json:"gas_limit,omitempty"`
+	// From: Optional sender for "evm_static_call". Ignored by "evm_call".
+	From string `json:"from,omitempty"`
+}
+
+// EvmCallResponse is the response data of "evm_call" and "evm_static_call".
+type EvmCallResponse struct {
+	// Ret: Bytes returned by the EVM contract (base64 in JSON).
+	Ret []byte `json:"ret"`
+	// GasUsed: EVM gas consumed by the call.
+	GasUsed uint64 `json:"gas_used"`
+}
+
+// NewEvmCallMessageHandler handles "evm_call" custom messages, which let a Wasm
+// contract perform an EVM call as its own 0x address.
+//
+// EVM gas used is charged to the gas meter of the Wasm execution one-to-one,
+// and the EVM gas limit never exceeds the gas left in that meter.
+//
+// Reentrancy guards:
+//   - Calls are rejected inside a Nibiru precompile (EVM -> Wasm -> EVM),
+//     since the outer EVM state has not been committed yet.
+//   - Calls are rejected during an EVM-originated callback
+//     ([evm.IsVMSenderCtx]).
+//   - The EVM call itself runs as a VM-originated callback, so mutating
+//     precompile methods (Wasm -> EVM -> Wasm) are disabled during it.
+func NewEvmCallMessageHandler(evmKeeper *evmstate.Keeper) wasmkeeper.MessageHandlerFunc {
+	return func(
+		ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wvm.CosmosMsg,
+	) (events []sdk.Event, data [][]byte, err error) {
+		if msg.Custom == nil {
+			return nil, nil, wasm.ErrUnknownMsg
+		}
+		var nibiruMsg NibiruMsg
+		if err := json.Unmarshal(msg.Custom, &nibiruMsg); err != nil {
+			return nil, nil, sdkioerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		if nibiruMsg.EvmCall == nil {
+			return nil, nil, sdkioerrors.Wrap(wasm.ErrInvalidMsg, "unknown variant of NibiruMsg")
+		}
+
+		sender := eth.NibiruAddrToEthAddr(contractAddr)
+		resp, evmResp, err := evmCallFromWasm(
+			ctx, evmKeeper, sender, *nibiruMsg.EvmCall, false, /*static*/
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		respBz, err := json.Marshal(resp)
+		if err != nil {
+			return nil, nil, sdkioerrors.Wrap(err, "failed to marshal evm_call response")
+		}
+		logsBz, _ := json.Marshal(evm.LogsToLogLite(evmResp.Logs))
+		event := sdk.NewEvent(
+			EventTypeWasmEvmCall,
+			sdk.NewAttribute(wasm.AttributeKeyContractAddr, contractAddr.String()),
+			sdk.NewAttribute(EventAttrWasmEvmCallSender, sender.Hex()),
+			sdk.NewAttribute(EventAttrWasmEvmCallTo, nibiruMsg.EvmCall.To),
+			sdk.NewAttribute(EventAttrWasmEvmCallGas, strconv.FormatUint(resp.GasUsed, 10)),
+			sdk.NewAttribute(EventAttrWasmEvmCallLogs, string(logsBz)),
+		)
+		return []sdk.Event{event}, [][]byte{respBz}, nil
+	}
+}
+
+// NewEvmCustomQuerier handles "evm_static_call" custom queries. The call runs
+// as a STATICCALL, so calls that would modify state fail.
+func NewEvmCustomQuerier(evmKeeper *evmstate.Keeper) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query NibiruQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, sdkioerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+		if query.EvmStaticCall == nil {
+			return nil, wvm.UnsupportedRequest{Kind: "unknown variant of NibiruQuery"}
+		}
+
+		var sender gethcommon.Address
+		if from := query.EvmStaticCall.From; from != "" {
+			if err := eth.ValidateAddress(from); err != nil {
+				return nil, err
+			}
+			sender = gethcommon.HexToAddress(from)
+		}
+		resp, _, err := evmCallFromWasm(
+			ctx, evmKeeper, sender, *query.EvmStaticCall, true, /*static*/
+		)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(resp)
+	}
+}
+
+// evmCallFromWasm runs an EVM call for a Wasm contract message or query and
+// charges the EVM gas used to the gas meter of "ctx". Static calls are
+// read-only and never commit, while other calls commit their state changes
+// into "ctx".
+func evmCallFromWasm(
+	ctx sdk.Context,
+	evmKeeper *evmstate.Keeper,
+	sender gethcommon.Address,
+	call EvmCall,
+	static bool,
+) (resp EvmCallResponse, evmResp *evm.MsgEthereumTxResponse, err error) {
+	if evm.IsPrecompileRunCtx(ctx) {
+		return resp, nil, sdkioerrors.Wrap(sdkerrors.ErrUnauthorized,
+			"Wasm VM to EVM call is not allowed inside an EVM precompile call")
+	}
+	if evm.IsVMSenderCtx(ctx) {
+		return resp, nil, sdkioerrors.Wrap(sdkerrors.ErrUnauthorized,
+			"Wasm VM to EVM call is not allowed during an EVM-originated contract callback")
+	}
+	if err := eth.ValidateNonZeroAddress(call.To); err != nil {
+		return resp, nil, err
+	}
+	to := gethcommon.HexToAddress(call.To)
+
+	// Bridge gas: the EVM may only use gas that is left in the Wasm execution.
+	gasLimit := ctx.GasMeter().GasRemaining()
+	if gasLimit > EvmCallMaxGasLimit {
+		gasLimit = EvmCallMaxGasLimit
+	}
+	if call.GasLimit != 0 && call.GasLimit < gasLimit {
+		gasLimit = call.GasLimit
+	}
+
+	// The EVM meters its own gas. KV store gas of the EVM execution is ignored
+	// and replaced by the EVM gas used, matching the gas of an Ethereum tx.
+	evmCtx := ctx.
+		WithGasMeter(sdk.NewInfiniteGasMeter()).
+		WithValue(evm.CtxKeyVMSenderGuard, true)
+	sdb := evmKeeper.NewSDB(evmCtx, evmKeeper.TxConfig(evmCtx, evmCtx.EvmTxHash()))
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		To:               &to,
+		From:             sender,
+		Nonce:            sdb.GetNonce(sender),
+		Value:            unusedBigInt,
+		GasLimit:         gasLimit,
+		GasPrice:         unusedBigInt,
+		GasFeeCap:        unusedBigInt,
+		GasTipCap:        unusedBigInt,
+		Data:             call.Input,
+		AccessList:       gethcore.AccessList{},
+		BlobGasFeeCap:    &big.Int{},
+		BlobHashes:       []gethcommon.Hash{},
+		SkipNonceChecks:  false,
+		SkipFromEOACheck: false,
+	}
+	evmObj := evmKeeper.NewEVM(
+		evmCtx, evmMsg, evmKeeper.GetEVMConfig(evmCtx), nil /*tracer*/, sdb,
+	)
+	if static {
+		evmResp, err = evmKeeper.StaticCallContract(
+			evmObj, sender, to, call.Input, gasLimit,
+		)
+	} else {
+		evmResp, err = evmKeeper.CallContract(
+			evmObj, sender, &to, call.Input, gasLimit, evm.COMMIT_ETH_TX, nil, /*weiValue*/
+		)
+	}
+
+	gasUsed := gasLimit
+	if evmResp != nil {
+		gasUsed = evmResp.GasUsed
+	}
+	ctx.GasMeter().ConsumeGas(gasUsed, "Wasm VM to EVM call")
+	if err != nil {
+		return resp, nil, fmt.Errorf("Wasm VM to EVM call failed: %w", err)
+	}
+	return EvmCallResponse{
+		Ret:     evmResp.Ret,
+		GasUsed: evmResp.GasUsed,
+	}, evmResp, nil
+}
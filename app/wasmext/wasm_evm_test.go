@@ -0,0 +1,160 @@
+package wasmext_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/NibiruChain/nibiru/v2/app/wasmext"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/lib/wasmvm/wvm"
+	"github.com/NibiruChain/nibiru/v2/x/nutil/testapp"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+)
+
+func (s *Suite) TestEvmCallFromWasm() {
+	deps := evmtest.NewTestDeps()
+	wasmMsgHandler := wasmext.WasmMessageHandler(deps.App.WasmMsgHandlerArgs)
+	evmQuerier := wasmext.NewEvmCustomQuerier(deps.App.EvmKeeper)
+
+	// Any account can stand in for the Wasm contract since the handler only
+	// needs its address.
+	contract := evmtest.NewEthPrivAcc()
+	recipient := evmtest.NewEthPrivAcc()
+
+	s.T().Log("Setup: give the contract's 0x address FunToken ERC20s")
+	funtoken := evmtest.CreateFunTokenForBankCoin(deps, "wasmevm", &s.Suite)
+	erc20 := funtoken.Erc20Addr.Address
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx(),
+		deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(funtoken.BankDenom, 1_000)),
+	))
+	_, err := deps.EvmKeeper.ConvertCoinToEvm(
+		sdk.WrapSDKContext(deps.Ctx()),
+		&evm.MsgConvertCoinToEvm{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			BankCoin:  sdk.NewInt64Coin(funtoken.BankDenom, 1_000),
+			ToEthAddr: eth.EIP55Addr{Address: contract.EthAddr},
+		},
+	)
+	s.Require().NoError(err)
+
+	erc20ABI := embeds.SmartContract_ERC20MinterWithMetadataUpdates.ABI
+	evmCallMsg := func(amount int64, gasLimit uint64) wvm.CosmosMsg {
+		input, err := erc20ABI.Pack("transfer", recipient.EthAddr, big.NewInt(amount))
+		s.Require().NoError(err)
+		customBz, err := json.Marshal(wasmext.NibiruMsg{
+			EvmCall: &wasmext.EvmCall{
+				To:       erc20.Hex(),
+				Input:    input,
+				GasLimit: gasLimit,
+			},
+		})
+		s.Require().NoError(err)
+		return wvm.CosmosMsg{Custom: customBz}
+	}
+	queryBalance := func(ctx sdk.Context, account evmtest.EthPrivKeyAcc) *big.Int {
+		input, err := erc20ABI.Pack("balanceOf", account.EthAddr)
+		s.Require().NoError(err)
+		reqBz, err := json.Marshal(wasmext.NibiruQuery{
+			EvmStaticCall: &wasmext.EvmCall{To: erc20.Hex(), Input: input},
+		})
+		s.Require().NoError(err)
+		respBz, err := evmQuerier(ctx, reqBz)
+		s.Require().NoError(err)
+		var resp wasmext.EvmCallResponse
+		s.Require().NoError(json.Unmarshal(respBz, &resp))
+		out, err := erc20ABI.Unpack("balanceOf", resp.Ret)
+		s.Require().NoError(err)
+		return out[0].(*big.Int)
+	}
+
+	s.Run("happy: evm_call as the contract's 0x address", func() {
+		ctx := deps.Ctx().WithGasMeter(sdk.NewGasMeter(1_000_000))
+		events, data, err := wasmMsgHandler.DispatchMsg(
+			ctx, contract.NibiruAddr, "ibcport-unused", evmCallMsg(400, 0),
+		)
+		s.Require().NoError(err)
+		s.Require().Len(data, 1)
+		var resp wasmext.EvmCallResponse
+		s.Require().NoError(json.Unmarshal(data[0], &resp))
+		s.Require().NotZero(resp.GasUsed)
+		s.Require().Equal(resp.GasUsed, ctx.GasMeter().GasConsumed(),
+			"EVM gas used must be charged to the Wasm gas meter")
+		s.Require().Len(events, 1)
+		s.Require().Equal(wasmext.EventTypeWasmEvmCall, events[0].Type)
+
+		s.Require().Equal("400", queryBalance(deps.Ctx(), recipient).String())
+		s.Require().Equal("600", queryBalance(deps.Ctx(), contract).String())
+	})
+
+	s.Run("sad: EVM revert fails the Wasm message", func() {
+		_, _, err := wasmMsgHandler.DispatchMsg(
+			deps.Ctx(), contract.NibiruAddr, "ibcport-unused", evmCallMsg(1_000_000, 0),
+		)
+		s.Require().ErrorContains(err, "Wasm VM to EVM call failed")
+		s.Require().Equal("600", queryBalance(deps.Ctx(), contract).String())
+	})
+
+	s.Run("sad: evm_static_call cannot modify state", func() {
+		input, err := erc20ABI.Pack("transfer", recipient.EthAddr, big.NewInt(1))
+		s.Require().NoError(err)
+		reqBz, err := json.Marshal(wasmext.NibiruQuery{
+			EvmStaticCall: &wasmext.EvmCall{
+				To:    erc20.Hex(),
+				Input: input,
+				From:  contract.EthAddr.Hex(),
+			},
+		})
+		s.Require().NoError(err)
+		_, err = evmQuerier(deps.Ctx(), reqBz)
+		s.Require().ErrorContains(err, vm.ErrWriteProtection.Error())
+		s.Require().Equal("600", queryBalance(deps.Ctx(), contract).String())
+	})
+
+	s.Run("sad: EVM gas is capped by the Wasm gas remaining", func() {
+		ctx := deps.Ctx().WithGasMeter(sdk.NewGasMeter(30_000))
+		_, _, err := wasmMsgHandler.DispatchMsg(
+			ctx, contract.NibiruAddr, "ibcport-unused", evmCallMsg(1, 0),
+		)
+		s.Require().ErrorContains(err, "gas required exceeds gas limit (30000)")
+	})
+
+	s.Run("sad: reentrancy from inside a precompile", func() {
+		ctx := deps.Ctx().WithValue(evm.CtxKeyPrecompileRun, true)
+		_, _, err := wasmMsgHandler.DispatchMsg(
+			ctx, contract.NibiruAddr, "ibcport-unused", evmCallMsg(1, 0),
+		)
+		s.Require().ErrorContains(err, "not allowed inside an EVM precompile call")
+
+		reqBz, err := json.Marshal(wasmext.NibiruQuery{
+			EvmStaticCall: &wasmext.EvmCall{To: erc20.Hex()},
+		})
+		s.Require().NoError(err)
+		_, err = evmQuerier(ctx, reqBz)
+		s.Require().ErrorContains(err, "not allowed inside an EVM precompile call")
+	})
+
+	s.Run("sad: reentrancy during an EVM-originated callback", func() {
+		ctx := deps.Ctx().WithValue(evm.CtxKeyVMSenderGuard, true)
+		_, _, err := wasmMsgHandler.DispatchMsg(
+			ctx, contract.NibiruAddr, "ibcport-unused", evmCallMsg(1, 0),
+		)
+		s.Require().ErrorContains(err, "EVM-originated contract callback")
+	})
+
+	s.Run("sad: unknown custom variant", func() {
+		_, _, err := wasmMsgHandler.DispatchMsg(
+			deps.Ctx(), contract.NibiruAddr, "ibcport-unused",
+			wvm.CosmosMsg{Custom: []byte(`{"foo":{}}`)},
+		)
+		s.Require().ErrorContains(err, "unknown variant of NibiruMsg")
+	})
+}
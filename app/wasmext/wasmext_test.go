@@ -28,8 +28,9 @@ func TestWasmExtSuite(t *testing.T) {
 	suite.Run(t, new(Suite))
 }
 
-// WasmVM to EVM call pattern is not yet supported. This test verifies the
-// Nibiru's [wasmkeeper.Option] function as expected.
+// Wasm VM dispatch of MsgEthereumTx is not allowed. Wasm contracts call the EVM
+// with the "evm_call" custom message instead. This test verifies the Nibiru's
+// [wasmkeeper.Option] function as expected.
 func (s *Suite) TestEvmFilter() {
 	deps := evmtest.NewTestDeps()
 	// wk := wasmkeeper.NewDefaultPermissionKeeper(deps.App.WasmKeeper)
@@ -75,7 +76,7 @@ func (s *Suite) TestEvmFilter() {
 			},
 		},
 	)
-	s.Require().ErrorContains(err, "Wasm VM to EVM call pattern is not yet supported")
+	s.Require().ErrorContains(err, "Wasm VM dispatch of MsgEthereumTx is not allowed")
 
 	coins := sdk.NewCoins(sdk.NewInt64Coin(evm.EVMBankDenom, 420)) // arbitrary constant
 	err = testapp.FundAccount(deps.App.BankKeeper, deps.Ctx(), deps.Sender.NibiruAddr, coins)
@@ -152,7 +153,7 @@ func (s *Suite) TestWasmSdkMessageHandlerRejectsBlockedMessages() {
 		{
 			name:    "evm ethereum tx",
 			msg:     ethTxMsg,
-			wantErr: "Wasm VM to EVM call pattern is not yet supported",
+			wantErr: "Wasm VM dispatch of MsgEthereumTx is not allowed",
 		},
 		{
 			name:    "authz exec",
@@ -1,14 +1,17 @@
 package main
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
 	"github.com/NibiruChain/nibiru/v2/eth"
+	ethkeyring "github.com/NibiruChain/nibiru/v2/eth/crypto/keyring"
 
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/client"
 	sdkkeys "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/client/keys"
@@ -29,10 +32,45 @@ func keysCommand(defaultNodeHome string) *cobra.Command {
 			break
 		}
 	}
+	cmd.AddCommand(addPasskeyCmd())
 
 	return cmd
 }
 
+// addPasskeyCmd saves the public key of a WebAuthn passkey as an offline key,
+// so that passkey accounts can build transactions with "--generate-only".
+func addPasskeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add-passkey [name] [pubkey-hex]",
+		Short: "Add the public key of a passkey (P-256 WebAuthn credential) to the keyring",
+		Long: `Add the public key of a passkey (P-256 WebAuthn credential) to the keyring.
+The public key is the SEC1 encoding of the credential's P-256 point, either
+compressed (33 bytes) or uncompressed (65 bytes), in hex.
+
+Passkeys sign on the user's device, so the key can only build transactions with
+"--generate-only". The passkey signs them, and "nibid tx broadcast" sends them.`,
+		Example: "nibid keys add-passkey my-passkey 03a1b2...",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pubKeyBz, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid pubkey hex: %w", err)
+			}
+			record, err := ethkeyring.SavePasskey(clientCtx.Keyring, args[0], pubKeyBz)
+			if err != nil {
+				return err
+			}
+
+			return printKeyringRecords(cmd.OutOrStdout(), []*cryptokeyring.Record{record}, clientCtx.OutputFormat)
+		},
+	}
+}
+
 func runKeysListCmd(cmd *cobra.Command, _ []string) error {
 	clientCtx, err := client.GetClientQueryContext(cmd)
 	if err != nil {
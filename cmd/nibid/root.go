@@ -148,13 +148,11 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig) {
 
 		// EVM Tx Indexer force catch up command
 		server.NewEVMTxIndexCmd(),
-	)
 
-	// TODO add rosettaj
-	// add rosetta
-	// rootCmd.AddCommand(
-	//	server.RosettaCommand(
-	//		encodingConfig.InterfaceRegistry, encodingConfig.Codec))
+		// Mesh (Rosetta) API server
+		server.RosettaCommand(
+			encodingConfig.InterfaceRegistry, encodingConfig.Codec),
+	)
 }
 
 // Implements the servertypes.ModuleInitFlags interface
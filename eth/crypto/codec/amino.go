@@ -7,6 +7,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/crypto/keyring"
 
 	"github.com/NibiruChain/nibiru/v2/eth/crypto/ethsecp256k1"
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/passkey"
 )
 
 // RegisterCrypto registers all crypto dependency types with the provided Amino
@@ -16,6 +17,8 @@ func RegisterCrypto(cdc *codec.LegacyAmino) {
 		ethsecp256k1.PubKeyName, nil)
 	cdc.RegisterConcrete(&ethsecp256k1.PrivKey{},
 		ethsecp256k1.PrivKeyName, nil)
+	cdc.RegisterConcrete(&passkey.PubKey{},
+		passkey.PubKeyName, nil)
 
 	keyring.RegisterLegacyAminoCodec(cdc)
 
@@ -0,0 +1,21 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+
+package keyring
+
+import (
+	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/crypto/keyring"
+
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/passkey"
+)
+
+// SavePasskey stores the public key of a passkey in the keyring under uid.
+// The private key of a passkey never leaves its authenticator, so the record
+// is an offline key: it builds transactions with "--generate-only", and the
+// passkey signs them in the browser or on the device.
+func SavePasskey(kr keyring.Keyring, uid string, pubKeyBz []byte) (*keyring.Record, error) {
+	pubKey, err := passkey.NewPubKey(pubKeyBz)
+	if err != nil {
+		return nil, err
+	}
+	return kr.SaveOfflineKey(uid, pubKey)
+}
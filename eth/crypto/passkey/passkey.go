@@ -0,0 +1,205 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+
+package passkey
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	sdkioerrors "cosmossdk.io/errors"
+	tmcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/codec"
+	cryptotypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types/errors"
+
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/secp256r1"
+)
+
+const (
+	// PubKeySize defines the size of the PubKey bytes
+	PubKeySize = 33
+	// KeyType is the string constant for the passkey algorithm
+	KeyType = "passkey"
+	// PubKeyName defines the amino encoding name for the passkey public key
+	PubKeyName = "eth/PubKeyPasskey"
+
+	// ClientDataTypeGet is the "type" of the client data of a WebAuthn
+	// assertion, which is what a passkey produces when it signs.
+	ClientDataTypeGet = "webauthn.get"
+
+	// flagUserPresent is the "user present" (UP) bit of the authenticator data
+	// flags.
+	flagUserPresent byte = 0x01
+	// minAuthenticatorDataSize is the size of the RP ID hash (32 bytes), the
+	// flags (1 byte), and the signature counter (4 bytes).
+	minAuthenticatorDataSize = 37
+	// signatureSize is the size of an [R || S] signature.
+	signatureSize = 64
+)
+
+// halfOrder is half of the order of the P-256 curve. Signatures must have S in
+// the lower half so that they are not malleable.
+var halfOrder = new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+
+var (
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+)
+
+// NewPubKey returns the passkey public key for the SEC1 encoding of a P-256
+// point, either compressed (33 bytes) or uncompressed (65 bytes). WebAuthn
+// clients get the point from the COSE key of the credential.
+func NewPubKey(bz []byte) (*PubKey, error) {
+	curve := elliptic.P256()
+	x, y := elliptic.UnmarshalCompressed(curve, bz)
+	if x == nil {
+		x, y = elliptic.Unmarshal(curve, bz) //nolint:staticcheck
+	}
+	if x == nil {
+		return nil, sdkioerrors.Wrap(sdkerrors.ErrInvalidPubKey, "not a P-256 public key in SEC1 format")
+	}
+	return &PubKey{Key: elliptic.MarshalCompressed(curve, x, y)}, nil
+}
+
+// Challenge returns the WebAuthn challenge a passkey signs for the given sign
+// bytes: the unpadded base64url encoding of their SHA-256 hash. Clients pass
+// the decoded hash as the challenge of "navigator.credentials.get".
+func Challenge(msg []byte) string {
+	hash := sha256.Sum256(msg)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// Address returns the address of the passkey, which is the last 20 bytes of
+// the Keccak256 hash of the uncompressed X and Y coordinates, as for Ethereum
+// addresses. The function will return an empty address if the public key is
+// invalid.
+func (pubKey PubKey) Address() tmcrypto.Address {
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), pubKey.Key)
+	if x == nil {
+		return nil
+	}
+
+	xy := make([]byte, 64)
+	x.FillBytes(xy[:32])
+	y.FillBytes(xy[32:])
+	return tmcrypto.Address(crypto.Keccak256(xy)[12:])
+}
+
+// Bytes returns the raw bytes of the passkey public key.
+func (pubKey PubKey) Bytes() []byte {
+	bz := make([]byte, len(pubKey.Key))
+	copy(bz, pubKey.Key)
+
+	return bz
+}
+
+// String implements the fmt.Stringer interface.
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeyPasskey{%X}", pubKey.Key)
+}
+
+// Type returns passkey
+func (pubKey PubKey) Type() string {
+	return KeyType
+}
+
+// Equals returns true if the pubkey type is the same and their bytes are deeply equal.
+func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return sdkioerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid pubkey size, expected %d, got %d", PubKeySize, len(bz))
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
+
+// VerifySignature verifies that the passkey signed msg. The signature is a
+// protobuf-encoded [Signature] holding a WebAuthn assertion. It is valid if:
+//   - the client data is of type "webauthn.get" and its challenge is
+//     [Challenge] of msg,
+//   - the authenticator data has the "user present" flag set, and
+//   - the ECDSA signature over SHA-256(authenticatorData ||
+//     SHA-256(clientDataJSON)) verifies with the public key.
+//
+// The origin and RP ID of the assertion are not checked, since the public key
+// already identifies the credential.
+func (pubKey PubKey) VerifySignature(msg, sig []byte) bool {
+	var assertion Signature
+	if err := assertion.Unmarshal(sig); err != nil {
+		return false
+	}
+	return pubKey.verifyAssertion(msg, assertion) == nil
+}
+
+func (pubKey PubKey) verifyAssertion(msg []byte, assertion Signature) error {
+	var clientData struct {
+		Type      string `json:"type"`
+		Challenge string `json:"challenge"`
+	}
+	if err := json.Unmarshal(assertion.ClientDataJSON, &clientData); err != nil {
+		return fmt.Errorf("invalid client data JSON: %w", err)
+	}
+	if clientData.Type != ClientDataTypeGet {
+		return fmt.Errorf("invalid client data type %q, expected %q", clientData.Type, ClientDataTypeGet)
+	}
+	if clientData.Challenge != Challenge(msg) {
+		return fmt.Errorf("client data challenge does not match the sign bytes")
+	}
+
+	authData := assertion.AuthenticatorData
+	if len(authData) < minAuthenticatorDataSize {
+		return fmt.Errorf("authenticator data is too short: %d bytes", len(authData))
+	}
+	if authData[32]&flagUserPresent == 0 {
+		return fmt.Errorf("authenticator data is missing the user present flag")
+	}
+
+	if len(assertion.Signature) != signatureSize {
+		return fmt.Errorf("invalid signature size, expected %d, got %d", signatureSize, len(assertion.Signature))
+	}
+	r := new(big.Int).SetBytes(assertion.Signature[:32])
+	s := new(big.Int).SetBytes(assertion.Signature[32:])
+	if s.Cmp(halfOrder) > 0 {
+		return fmt.Errorf("signature S is not in the lower half of the curve order")
+	}
+
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), pubKey.Key)
+	if x == nil {
+		return fmt.Errorf("invalid passkey public key")
+	}
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	if !secp256r1.Verify(digest[:], r, s, x, y) {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
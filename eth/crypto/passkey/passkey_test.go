@@ -0,0 +1,146 @@
+package passkey
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/codec"
+	cryptotypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/crypto/types"
+)
+
+// authenticator mimics a WebAuthn authenticator holding a passkey.
+type authenticator struct {
+	key *ecdsa.PrivateKey
+}
+
+func newAuthenticator(t *testing.T) authenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return authenticator{key: key}
+}
+
+func (a authenticator) pubKey() *PubKey {
+	return &PubKey{Key: elliptic.MarshalCompressed(elliptic.P256(), a.key.X, a.key.Y)}
+}
+
+// sign returns the WebAuthn assertion for clientData, with S normalized to
+// the lower half of the curve order like a client would do.
+func (a authenticator) sign(t *testing.T, clientData map[string]any, flags byte) Signature {
+	clientDataJSON, err := json.Marshal(clientData)
+	require.NoError(t, err)
+	authData := make([]byte, minAuthenticatorDataSize)
+	authData[32] = flags
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	r, s, err := ecdsa.Sign(rand.Reader, a.key, digest[:])
+	require.NoError(t, err)
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(elliptic.P256().Params().N, s)
+	}
+
+	sig := make([]byte, signatureSize)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	return Signature{
+		AuthenticatorData: authData,
+		ClientDataJSON:    clientDataJSON,
+		Signature:         sig,
+	}
+}
+
+func TestPubKey(t *testing.T) {
+	auth := newAuthenticator(t)
+	pubKey := auth.pubKey()
+	require.Implements(t, (*cryptotypes.PubKey)(nil), pubKey)
+	require.Len(t, pubKey.Address(), 20)
+	require.False(t, pubKey.Equals(newAuthenticator(t).pubKey()))
+
+	// uncompressed keys are compressed
+	uncompressed, err := NewPubKey(elliptic.Marshal(elliptic.P256(), auth.key.X, auth.key.Y)) //nolint:staticcheck
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(uncompressed))
+	_, err = NewPubKey([]byte{0x02, 0x01})
+	require.ErrorContains(t, err, "not a P-256 public key")
+
+	// amino encoding round trip
+	amino := codec.NewLegacyAmino()
+	amino.RegisterConcrete(&PubKey{}, PubKeyName, nil)
+	bz, err := amino.Marshal(pubKey)
+	require.NoError(t, err)
+	var decoded PubKey
+	require.NoError(t, amino.Unmarshal(bz, &decoded))
+	require.True(t, pubKey.Equals(&decoded))
+}
+
+func TestPubKey_VerifySignature(t *testing.T) {
+	auth := newAuthenticator(t)
+	pubKey := auth.pubKey()
+	msg := []byte("sign doc bytes")
+	validClientData := map[string]any{
+		"type":      ClientDataTypeGet,
+		"challenge": Challenge(msg),
+		"origin":    "https://app.nibiru.fi",
+	}
+
+	sig := auth.sign(t, validClientData, flagUserPresent)
+	sigBz, err := sig.Marshal()
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sigBz))
+
+	require.False(t, pubKey.VerifySignature([]byte("other msg"), sigBz), "challenge mismatch")
+	require.False(t, newAuthenticator(t).pubKey().VerifySignature(msg, sigBz), "other passkey")
+	require.False(t, pubKey.VerifySignature(msg, sig.Signature), "raw signature")
+
+	for _, tc := range []struct {
+		name    string
+		sig     func() Signature
+		wantErr string
+	}{
+		{
+			name: "wrong client data type",
+			sig: func() Signature {
+				return auth.sign(t, map[string]any{
+					"type": "webauthn.create", "challenge": Challenge(msg),
+				}, flagUserPresent)
+			},
+			wantErr: "invalid client data type",
+		},
+		{
+			name:    "user not present",
+			sig:     func() Signature { return auth.sign(t, validClientData, 0) },
+			wantErr: "user present flag",
+		},
+		{
+			name: "high S",
+			sig: func() Signature {
+				sig := auth.sign(t, validClientData, flagUserPresent)
+				s := new(big.Int).SetBytes(sig.Signature[32:])
+				s.Sub(elliptic.P256().Params().N, s)
+				s.FillBytes(sig.Signature[32:])
+				return sig
+			},
+			wantErr: "lower half",
+		},
+		{
+			name: "tampered authenticator data",
+			sig: func() Signature {
+				sig := auth.sign(t, validClientData, flagUserPresent)
+				sig.AuthenticatorData[0] ^= 0xff
+				return sig
+			},
+			wantErr: "invalid signature",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ErrorContains(t, pubKey.verifyAssertion(msg, tc.sig()), tc.wantErr)
+		})
+	}
+}
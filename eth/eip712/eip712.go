@@ -7,6 +7,10 @@ import (
 
 // WrapTxToTypedData wraps an Amino-encoded Cosmos Tx JSON SignDoc
 // bytestream into an EIP712-compatible TypedData request.
+//
+// Messages whose "type" is a proto type URL, such as
+// "/eth.evm.v1.MsgCreateFunToken", take their types from the registered
+// proto descriptor. Other message types are inferred from their JSON.
 func WrapTxToTypedData(
 	chainID uint64,
 	data []byte,
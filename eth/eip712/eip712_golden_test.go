@@ -0,0 +1,208 @@
+package eip712_test
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+
+	sdkmath "cosmossdk.io/math"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/bank/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/eip712"
+	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/x/sudo"
+	tftypes "github.com/NibiruChain/nibiru/v2/x/tokenfactory/types"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files in testdata")
+
+// Fixed addresses so that the golden payloads are deterministic.
+var (
+	goldenAddrA = sdk.AccAddress(gethcommon.HexToAddress("0x0101010101010101010101010101010101010101").Bytes())
+	goldenAddrB = sdk.AccAddress(gethcommon.HexToAddress("0x0202020202020202020202020202020202020202").Bytes())
+	goldenAddrC = sdk.AccAddress(gethcommon.HexToAddress("0x0303030303030303030303030303030303030303").Bytes())
+)
+
+// goldenTypedData is the part of the EIP-712 typed data shown to wallets that
+// depends on the transaction.
+type goldenTypedData struct {
+	PrimaryType string         `json:"primaryType"`
+	Types       apitypes.Types `json:"types"`
+	Message     map[string]any `json:"message"`
+}
+
+// TestTypedDataGolden checks the EIP-712 typed data of SIGN_MODE_DIRECT
+// transactions with Nibiru messages against the golden files in testdata.
+// Run with "-update" to regenerate them.
+func (s *EIP712TestSuite) TestTypedDataGolden() {
+	if s.useLegacyEIP712TypedData {
+		s.T().Skip("the legacy typed data only supports Amino messages")
+	}
+
+	erc20 := eth.EIP55Addr{Address: gethcommon.HexToAddress("0x1111111111111111111111111111111111111111")}
+	testCases := []struct {
+		name       string
+		msgs       []sdk.Msg
+		feeGranter sdk.AccAddress
+	}{
+		{
+			name: "evm",
+			msgs: []sdk.Msg{
+				&evm.MsgCreateFunToken{
+					FromBankDenom: "ufoo",
+					Sender:        goldenAddrA.String(),
+				},
+				&evm.MsgConvertEvmToCoin{
+					Sender:    goldenAddrA.String(),
+					Erc20Addr: erc20,
+					Amount:    sdkmath.NewInt(1000),
+					ToAddr:    goldenAddrB.String(),
+				},
+			},
+		},
+		{
+			name: "tokenfactory",
+			msgs: []sdk.Msg{
+				&tftypes.MsgMint{
+					Sender: goldenAddrA.String(),
+					Coin:   sdk.NewInt64Coin("tf/foo", 5),
+				},
+			},
+		},
+		{
+			name: "sudo",
+			msgs: []sdk.Msg{
+				&sudo.MsgSetGasSponsor{
+					Sender: goldenAddrA.String(),
+					Policy: sudo.GasSponsorPolicy{
+						Contracts:         []string{erc20.Hex()},
+						DailyCapPerSender: sdkmath.NewInt(500),
+						MaxFeePerTx:       sdkmath.NewInt(100),
+					},
+					Deposit: sdk.NewInt64Coin(s.denom, 1000),
+				},
+			},
+		},
+		{
+			name: "multi_signer_fee_grant",
+			msgs: []sdk.Msg{
+				banktypes.NewMsgSend(goldenAddrA, goldenAddrC, sdk.NewCoins(sdk.NewInt64Coin(s.denom, 1))),
+				&tftypes.MsgMint{
+					Sender: goldenAddrB.String(),
+					Coin:   sdk.NewInt64Coin("tf/foo", 5),
+					MintTo: goldenAddrC.String(),
+				},
+			},
+			feeGranter: goldenAddrC,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			signBytes := s.directSignBytes(tc.msgs, tc.feeGranter)
+
+			typedData, err := eip712.GetEIP712TypedDataForMsg(signBytes)
+			s.Require().NoError(err)
+			got, err := json.MarshalIndent(goldenTypedData{
+				PrimaryType: typedData.PrimaryType,
+				Types:       typedData.Types,
+				Message:     typedData.Message,
+			}, "", "  ")
+			s.Require().NoError(err)
+
+			goldenFile := filepath.Join("testdata", tc.name+".json")
+			if *updateGolden {
+				s.Require().NoError(os.WriteFile(goldenFile, append(got, '\n'), 0o644))
+			}
+			want, err := os.ReadFile(goldenFile)
+			s.Require().NoError(err)
+			s.JSONEq(string(want), string(got))
+
+			privKey, pubKey := s.createTestKeyPair()
+			s.verifyEIP712SignatureVerification(true, *privKey, *pubKey, signBytes)
+		})
+	}
+}
+
+// directSignBytes returns the SIGN_MODE_DIRECT sign bytes of a tx with msgs
+// and one signer info for each of their signers.
+func (s *EIP712TestSuite) directSignBytes(msgs []sdk.Msg, feeGranter sdk.AccAddress) []byte {
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	txBuilder.SetGasLimit(20000)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(s.denom, 2000)))
+	txBuilder.SetFeeGranter(feeGranter)
+	s.Require().NoError(txBuilder.SetMsgs(msgs...))
+
+	var sigs []signing.SignatureV2
+	for i := 0; i < numSigners(msgs); i++ {
+		_, pubKey := s.createTestKeyPair()
+		sigs = append(sigs, signing.SignatureV2{
+			PubKey:   pubKey,
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			Sequence: 78,
+		})
+	}
+	s.Require().NoError(txBuilder.SetSignatures(sigs...))
+
+	signBytes, err := s.clientCtx.TxConfig.SignModeHandler().GetSignBytes(
+		signing.SignMode_SIGN_MODE_DIRECT,
+		authsigning.SignerData{
+			ChainID:       TESTNET_CHAIN_ID + "-1",
+			AccountNumber: 25,
+			Sequence:      78,
+			PubKey:        sigs[0].PubKey,
+		},
+		txBuilder.GetTx(),
+	)
+	s.Require().NoError(err)
+	return signBytes
+}
+
+// TestTypedDataProtoAny checks that Any values nested in messages typed by
+// their type URL take their schema from the packed message.
+func (s *EIP712TestSuite) TestTypedDataProtoAny() {
+	payload := `{"msgs": [{
+		"type": "/cosmos.authz.v1beta1.MsgExec",
+		"value": {
+			"grantee": "grantee",
+			"msgs": [{
+				"type": "/cosmos.bank.v1beta1.MsgSend",
+				"value": {"from_address": "from", "to_address": "to", "amount": []}
+			}]
+		}
+	}]}`
+	typedData, err := eip712.WrapTxToTypedData(0, []byte(payload))
+	s.Require().NoError(err)
+
+	s.Equal([]apitypes.Type{
+		{Name: "value", Type: "TypeValue0"},
+		{Name: "type", Type: "string"},
+	}, typedData.Types["TypeMsgExec0"])
+	s.Equal([]apitypes.Type{
+		{Name: "msgs", Type: "TypeValueMsgs0[]"},
+		{Name: "grantee", Type: "string"},
+	}, typedData.Types["TypeValue0"])
+	s.Equal([]apitypes.Type{
+		{Name: "value", Type: "TypeValueMsgsValue0"},
+		{Name: "type", Type: "string"},
+	}, typedData.Types["TypeValueMsgs0"])
+	s.Equal([]apitypes.Type{
+		{Name: "to_address", Type: "string"},
+		{Name: "from_address", Type: "string"},
+		{Name: "amount", Type: "string[]"},
+	}, typedData.Types["TypeValueMsgsValue0"])
+
+	// Type URLs must resolve to a registered proto message.
+	_, err = eip712.WrapTxToTypedData(0, []byte(
+		`{"msgs": [{"type": "/nibiru.unknown.v1.MsgUnknown", "value": {}}]}`,
+	))
+	s.Require().ErrorContains(err, "unregistered message type")
+}
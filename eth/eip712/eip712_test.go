@@ -251,7 +251,7 @@ func (suite *EIP712TestSuite) TestEIP712() {
 			expectSuccess: !suite.useLegacyEIP712TypedData,
 		},
 		{
-			title: "Fails - Two MsgVotes with Different Signers",
+			title: "Succeeds - Two MsgVotes with Different Signers",
 			msgs: []sdk.Msg{
 				govtypes.NewMsgVote(
 					suite.createTestAddress(),
@@ -264,7 +264,7 @@ func (suite *EIP712TestSuite) TestEIP712() {
 					govtypes.OptionAbstain,
 				),
 			},
-			expectSuccess: false,
+			expectSuccess: !suite.useLegacyEIP712TypedData,
 		},
 		{
 			title:         "Fails - Empty Transaction",
@@ -296,7 +296,7 @@ func (suite *EIP712TestSuite) TestEIP712() {
 			expectSuccess: false,
 		},
 		{
-			title: "Fails - Single Message / Multi-Signer",
+			title: "Succeeds - Single Message / Multi-Signer",
 			msgs: []sdk.Msg{
 				banktypes.NewMsgMultiSend(
 					[]banktypes.Input{
@@ -321,7 +321,7 @@ func (suite *EIP712TestSuite) TestEIP712() {
 					},
 				),
 			},
-			expectSuccess: false,
+			expectSuccess: !suite.useLegacyEIP712TypedData,
 		},
 	}
 
@@ -351,7 +351,19 @@ func (suite *EIP712TestSuite) TestEIP712() {
 					Sequence: params.sequence,
 				}
 
-				err = txBuilder.SetSignatures([]signing.SignatureV2{txSig}...)
+				// Every other signer of the tx signs too, so the tx has one
+				// signer info per signer.
+				txSigs := []signing.SignatureV2{txSig}
+				for i := 1; i < numSigners(tc.msgs); i++ {
+					_, otherPubKey := suite.createTestKeyPair()
+					txSigs = append(txSigs, signing.SignatureV2{
+						PubKey:   otherPubKey,
+						Data:     &signing.SingleSignatureData{SignMode: signMode},
+						Sequence: params.sequence,
+					})
+				}
+
+				err = txBuilder.SetSignatures(txSigs...)
 				suite.Require().NoError(err)
 
 				chainID := TESTNET_CHAIN_ID + "-1"
@@ -393,6 +405,17 @@ func (suite *EIP712TestSuite) TestEIP712() {
 	}
 }
 
+// numSigners returns the number of unique signers of msgs.
+func numSigners(msgs []sdk.Msg) int {
+	signers := make(map[string]bool)
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			signers[signer.String()] = true
+		}
+	}
+	return len(signers)
+}
+
 // verifyEIP712SignatureVerification verifies that the payload passes signature verification if signed as its EIP-712 representation.
 func (suite *EIP712TestSuite) verifyEIP712SignatureVerification(expectedSuccess bool, privKey ethsecp256k1.PrivKey, pubKey ethsecp256k1.PubKey, signBytes []byte) {
 	eip712Bytes, err := eip712.GetEIP712BytesForMsg(signBytes)
@@ -2,8 +2,14 @@
 package eip712
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
 
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/auth/migrations/legacytx"
 
@@ -16,6 +22,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/eth"
 
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/codec"
+	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/codec/legacy"
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/codec/types"
 )
 
@@ -152,8 +159,8 @@ func decodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 		return apitypes.TypedData{}, errors.New("body contains unsupported fields: TimeoutHeight, ExtensionOptions, or NonCriticalExtensionOptions")
 	}
 
-	if len(authInfo.SignerInfos) != 1 {
-		return apitypes.TypedData{}, fmt.Errorf("invalid number of signer infos provided, expected 1 got %v", len(authInfo.SignerInfos))
+	if authInfo.Fee == nil {
+		return apitypes.TypedData{}, errors.New("auth info is missing the fee")
 	}
 
 	// Validate payload messages
@@ -170,28 +177,41 @@ func decodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 		return apitypes.TypedData{}, err
 	}
 
-	signerInfo := authInfo.SignerInfos[0]
+	// Every signer of the tx signs the same payload, so it includes the
+	// sequences of all of them.
+	numSigners := len(payloadSigners(msgs, authInfo.Fee.Payer))
+	if len(authInfo.SignerInfos) != numSigners {
+		return apitypes.TypedData{}, fmt.Errorf("invalid number of signer infos provided, expected %v got %v", numSigners, len(authInfo.SignerInfos))
+	}
+	sequences := make([]string, len(authInfo.SignerInfos))
+	for i, signerInfo := range authInfo.SignerInfos {
+		sequences[i] = strconv.FormatUint(signerInfo.Sequence, 10)
+	}
 
 	chainID := eth.ParseEthChainID(signDoc.ChainId)
 
-	stdFee := &legacytx.StdFee{
-		Amount: authInfo.Fee.Amount,
-		Gas:    authInfo.Fee.GasLimit,
+	stdFee := legacytx.StdFee{
+		Amount:  authInfo.Fee.Amount,
+		Gas:     authInfo.Fee.GasLimit,
+		Payer:   authInfo.Fee.Payer,
+		Granter: authInfo.Fee.Granter,
 	}
 
 	tip := authInfo.Tip
 
 	// WrapTxToTypedData expects the payload as an Amino Sign Doc
-	signBytes := legacytx.StdSignBytes(
+	signBytes, err := protoSignDocJSON(
 		signDoc.ChainId,
 		signDoc.AccountNumber,
-		signerInfo.Sequence,
-		body.TimeoutHeight,
-		*stdFee,
+		sequences,
+		stdFee,
 		msgs,
 		body.Memo,
 		tip,
 	)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
 
 	typedData, err := WrapTxToTypedData(
 		chainID.Uint64(),
@@ -204,6 +224,147 @@ func decodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 	return typedData, nil
 }
 
+// protoSignDocJSON builds the Amino-style JSON sign doc of a Protobuf sign doc.
+// It matches legacytx.StdSignBytes, except that:
+//   - Messages that have no Amino JSON encoding are encoded as
+//     {"type": <type URL>, "value": <proto JSON>}. See msgSignJSON.
+//   - With multiple signers, "sequence" lists the sequence of each signer
+//     info, separated by commas.
+func protoSignDocJSON(
+	chainID string,
+	accnum uint64,
+	sequences []string,
+	fee legacytx.StdFee,
+	msgs []sdk.Msg,
+	memo string,
+	tip *txTypes.Tip,
+) ([]byte, error) {
+	msgsBytes := make([]json.RawMessage, len(msgs))
+	for i, msg := range msgs {
+		msgBytes, err := msgSignJSON(msg)
+		if err != nil {
+			return nil, err
+		}
+		msgsBytes[i] = msgBytes
+	}
+
+	var stdTip *legacytx.StdTip
+	if tip != nil {
+		if tip.Tipper == "" {
+			return nil, errors.New("tipper cannot be empty")
+		}
+		stdTip = &legacytx.StdTip{Amount: tip.Amount, Tipper: tip.Tipper}
+	}
+
+	bz, err := legacy.Cdc.MarshalJSON(legacytx.StdSignDoc{
+		AccountNumber: accnum,
+		ChainID:       chainID,
+		Fee:           json.RawMessage(fee.Bytes()),
+		Memo:          memo,
+		Msgs:          msgsBytes,
+		Tip:           stdTip,
+	})
+	if err != nil {
+		return nil, err
+	}
+	bz, err = sjson.SetBytes(bz, "sequence", strings.Join(sequences, ","))
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.SortJSON(bz)
+}
+
+// msgSignJSON returns the JSON of msg in an EIP-712 payload. Messages
+// registered with Amino keep their Amino JSON sign bytes. Any other registered
+// message is encoded as {"type": <type URL>, "value": <proto JSON>}, with its
+// EIP-712 types derived from the proto descriptor.
+func msgSignJSON(msg sdk.Msg) (json.RawMessage, error) {
+	if signBytes, ok := aminoMsgSignBytes(msg); ok {
+		return signBytes, nil
+	}
+
+	protoJSON, err := protoCodec.MarshalJSON(msg)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal message %s to JSON: %w", sdk.MsgTypeURL(msg), err)
+	}
+	value, err := normalizeProtoJSON(gjson.ParseBytes(protoJSON))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(map[string]json.RawMessage{
+		msgTypeField:  json.RawMessage(strconv.Quote(sdk.MsgTypeURL(msg))),
+		msgValueField: value,
+	})
+}
+
+// aminoMsgSignBytes returns the sign bytes of msg if it is a legacy message
+// with an Amino JSON encoding of the form {"type": <Amino name>, "value": <msg>}.
+// Many messages implement GetSignBytes with a codec that has no Amino name for
+// them, or one that panics, so those use the proto encoding instead.
+func aminoMsgSignBytes(msg sdk.Msg) (signBytes []byte, ok bool) {
+	legacyMsg, ok := msg.(legacytx.LegacyMsg)
+	if !ok {
+		return nil, false
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			signBytes, ok = nil, false
+		}
+	}()
+
+	signBytes = legacyMsg.GetSignBytes()
+	aminoJSON := gjson.ParseBytes(signBytes)
+	ok = aminoJSON.IsObject() &&
+		len(aminoJSON.Map()) == 2 &&
+		aminoJSON.Get(msgTypeField).Type == gjson.String &&
+		aminoJSON.Get(msgValueField).Exists()
+	return signBytes, ok
+}
+
+// normalizeProtoJSON rewrites the Any values in proto JSON, which carry their
+// type URL in an "@type" field, to the {"type", "value"} form of messages.
+func normalizeProtoJSON(value gjson.Result) (json.RawMessage, error) {
+	switch {
+	case value.IsArray():
+		elems := []json.RawMessage{}
+		for _, elem := range value.Array() {
+			normalized, err := normalizeProtoJSON(elem)
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, normalized)
+		}
+		return json.Marshal(elems)
+	case value.IsObject():
+		fields := map[string]json.RawMessage{}
+		var err error
+		value.ForEach(func(key, fieldValue gjson.Result) bool {
+			fields[key.Str], err = normalizeProtoJSON(fieldValue)
+			return err == nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		typeURL, isAny := fields["@type"]
+		if !isAny {
+			return json.Marshal(fields)
+		}
+		delete(fields, "@type")
+		anyValue, err := json.Marshal(fields)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(map[string]json.RawMessage{
+			msgTypeField:  typeURL,
+			msgValueField: anyValue,
+		})
+	default:
+		return json.RawMessage(value.Raw), nil
+	}
+}
+
 // validateCodecInit ensures that both Amino and Protobuf encoding codecs have been set on app init,
 // so the module does not panic if either codec is not found.
 func validateCodecInit() error {
@@ -215,28 +376,45 @@ func validateCodecInit() error {
 }
 
 // validatePayloadMessages ensures that the transaction messages can be represented in an EIP-712
-// encoding by checking that messages exist and share a single signer.
+// encoding by checking that messages exist and that each message has a signer. Messages
+// may have different signers, in which case each signer signs the same payload.
 func validatePayloadMessages(msgs []sdk.Msg) error {
 	if len(msgs) == 0 {
 		return errors.New("unable to build EIP-712 payload: transaction does contain any messages")
 	}
 
-	var msgSigner sdk.AccAddress
-
-	for i, m := range msgs {
-		if len(m.GetSigners()) != 1 {
-			return errors.New("unable to build EIP-712 payload: expect exactly 1 signer")
+	for _, m := range msgs {
+		if len(m.GetSigners()) == 0 {
+			return errors.New("unable to build EIP-712 payload: expect at least 1 signer")
 		}
+	}
+
+	return nil
+}
 
-		if i == 0 {
-			msgSigner = m.GetSigners()[0]
-			continue
+// payloadSigners returns the unique signers of the transaction in the order the
+// SDK expects their signer infos: message signers first, then the fee payer if
+// it is not already a signer.
+func payloadSigners(msgs []sdk.Msg, feePayer string) []sdk.AccAddress {
+	var signers []sdk.AccAddress
+	seen := make(map[string]bool)
+	addSigner := func(signer sdk.AccAddress) {
+		if !seen[signer.String()] {
+			seen[signer.String()] = true
+			signers = append(signers, signer)
 		}
+	}
 
-		if !msgSigner.Equals(m.GetSigners()[0]) {
-			return errors.New("unable to build EIP-712 payload: multiple signers detected")
+	for _, m := range msgs {
+		for _, signer := range m.GetSigners() {
+			addSigner(signer)
+		}
+	}
+	if feePayer != "" {
+		if payer, err := sdk.AccAddressFromBech32(feePayer); err == nil {
+			addSigner(payer)
 		}
 	}
 
-	return nil
+	return signers
 }
@@ -27,7 +27,12 @@ const (
 	ethInt64  = "int64"
 	ethString = "string"
 
-	msgTypeField = "type"
+	msgTypeField  = "type"
+	msgValueField = "value"
+
+	// emptyArrayType is the type given to empty JSON arrays, whose element
+	// type cannot be inferred.
+	emptyArrayType = "string[]"
 
 	maxDuplicateTypeDefs = 1000
 )
@@ -66,10 +71,7 @@ func createEIP712Types(messagePayload eip712MessagePayload) (apitypes.Types, err
 			{Name: "sequence", Type: "string"},
 			// Note timeout_height was removed because it was not getting filled with the legacyTx
 		},
-		"Fee": {
-			{Name: "amount", Type: "Coin[]"},
-			{Name: "gas", Type: "string"},
-		},
+		"Fee": feeTypes(messagePayload.payload),
 		"Coin": {
 			{Name: "denom", Type: "string"},
 			{Name: "amount", Type: "string"},
@@ -88,6 +90,21 @@ func createEIP712Types(messagePayload eip712MessagePayload) (apitypes.Types, err
 	return eip712Types, nil
 }
 
+// feeTypes returns the EIP-712 type of the fee. The optional fee payer and
+// fee granter are only part of the type when the payload sets them.
+func feeTypes(payload gjson.Result) []apitypes.Type {
+	types := []apitypes.Type{
+		{Name: "amount", Type: "Coin[]"},
+		{Name: "gas", Type: "string"},
+	}
+	for _, field := range []string{"payer", "granter"} {
+		if payload.Get("fee."+field).Str != "" {
+			types = append(types, apitypes.Type{Name: field, Type: ethString})
+		}
+	}
+	return types
+}
+
 // addMsgTypesToRoot adds all types for the given message
 // to eip712Types, recursively handling object sub-fields.
 func addMsgTypesToRoot(eip712Types apitypes.Types, msgField string, msg gjson.Result) (err error) {
@@ -102,7 +119,14 @@ func addMsgTypesToRoot(eip712Types apitypes.Types, msgField string, msg gjson.Re
 		return err
 	}
 
-	msgTypeDef, err := recursivelyAddTypesToRoot(eip712Types, msgRootType, rootPrefix, msg)
+	// Messages typed by their proto type URL take their schema from the
+	// registered proto descriptor. Amino messages are inferred from JSON.
+	var msgTypeDef string
+	if isTypeURL(msg.Get(msgTypeField).Str) {
+		msgTypeDef, err = addProtoAnyTypesToRoot(eip712Types, msgRootType, rootPrefix, msg)
+	} else {
+		msgTypeDef, err = recursivelyAddTypesToRoot(eip712Types, msgRootType, rootPrefix, msg)
+	}
 	if err != nil {
 		return err
 	}
@@ -124,6 +148,10 @@ func msgRootType(msg gjson.Result) (string, error) {
 	// Convert e.g. cosmos-sdk/MsgSend to TypeMsgSend
 	typeTokenized := strings.Split(msgType, "/")
 	msgSignature := typeTokenized[len(typeTokenized)-1]
+	if isTypeURL(msgType) {
+		// Convert e.g. /eth.evm.v1.MsgCreateFunToken to TypeMsgCreateFunToken
+		msgSignature = msgSignature[strings.LastIndex(msgSignature, ".")+1:]
+	}
 	rootType := fmt.Sprintf("%v%v", typePrefix, msgSignature)
 
 	return rootType, nil
@@ -164,61 +192,75 @@ func recursivelyAddTypesToRoot(
 			continue
 		}
 
-		// Handle array type by unwrapping the first element.
-		// Note that arrays with multiple types are not supported
-		// using EIP-712, so we can ignore that case.
-		isCollection := false
-		if field.IsArray() {
-			fieldAsArray := field.Array()
-
-			if len(fieldAsArray) == 0 {
-				// Arbitrarily add string[] type to handle empty arrays,
-				// since we cannot access the underlying object.
-				emptyArrayType := "string[]"
-				typesToAdd = appendedTypesList(typesToAdd, fieldName, emptyArrayType)
+		fieldTypeDef, err := jsonFieldType(typeMap, rootType, prefix, fieldName, field)
+		if err != nil {
+			return "", err
+		}
+		if fieldTypeDef != "" {
+			typesToAdd = appendedTypesList(typesToAdd, fieldName, fieldTypeDef)
+		}
+	}
 
-				continue
-			}
+	return addTypesToRoot(typeMap, typeDef, typesToAdd)
+}
 
-			field = fieldAsArray[0]
-			isCollection = true
+// jsonFieldType returns the EIP-712 type of the given field, inferred from its
+// JSON value. Object values add their type definitions to typeMap. It returns
+// an empty string for fields that cannot be represented, such as nulls and
+// nested arrays.
+func jsonFieldType(
+	typeMap apitypes.Types,
+	rootType string,
+	prefix string,
+	fieldName string,
+	field gjson.Result,
+) (string, error) {
+	// Handle array type by unwrapping the first element.
+	// Note that arrays with multiple types are not supported
+	// using EIP-712, so we can ignore that case.
+	isCollection := false
+	if field.IsArray() {
+		fieldAsArray := field.Array()
+
+		if len(fieldAsArray) == 0 {
+			// Arbitrarily add string[] type to handle empty arrays,
+			// since we cannot access the underlying object.
+			return emptyArrayType, nil
 		}
 
-		ethType := getEthTypeForJSON(field)
+		field = fieldAsArray[0]
+		isCollection = true
+	}
 
-		// Handle JSON primitive types by adding the corresponding
-		// EIP-712 type to the types schema.
-		if ethType != "" {
-			if isCollection {
-				ethType += "[]"
-			}
-			typesToAdd = appendedTypesList(typesToAdd, fieldName, ethType)
+	ethType := getEthTypeForJSON(field)
 
-			continue
+	// Handle JSON primitive types by adding the corresponding
+	// EIP-712 type to the types schema.
+	if ethType != "" {
+		if isCollection {
+			ethType += "[]"
 		}
+		return ethType, nil
+	}
 
-		// Handle object types recursively. Note that nested array types are not supported
-		// in EIP-712, so we can exclude that case.
-		if field.IsObject() {
-			fieldPrefix := prefixForSubField(prefix, fieldName)
-
-			fieldTypeDef, err := recursivelyAddTypesToRoot(typeMap, rootType, fieldPrefix, field)
-			if err != nil {
-				return "", err
-			}
-
-			fieldTypeDef = sanitizeTypedef(fieldTypeDef)
-			if isCollection {
-				fieldTypeDef += "[]"
-			}
+	// Handle object types recursively. Note that nested array types are not supported
+	// in EIP-712, so we can exclude that case.
+	if field.IsObject() {
+		fieldPrefix := prefixForSubField(prefix, fieldName)
 
-			typesToAdd = appendedTypesList(typesToAdd, fieldName, fieldTypeDef)
+		fieldTypeDef, err := recursivelyAddTypesToRoot(typeMap, rootType, fieldPrefix, field)
+		if err != nil {
+			return "", err
+		}
 
-			continue
+		fieldTypeDef = sanitizeTypedef(fieldTypeDef)
+		if isCollection {
+			fieldTypeDef += "[]"
 		}
+		return fieldTypeDef, nil
 	}
 
-	return addTypesToRoot(typeMap, typeDef, typesToAdd)
+	return "", nil
 }
 
 // sortedJSONKeys returns the sorted JSON keys for the input object,
@@ -0,0 +1,183 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package eip712
+
+import (
+	"strings"
+
+	sdkioerrors "cosmossdk.io/errors"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/tidwall/gjson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	sdkerrors "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types/errors"
+)
+
+// anyFullName is the full name of "google.protobuf.Any". Any values are
+// represented in EIP-712 payloads as {"type": <type URL>, "value": <msg>}.
+const anyFullName protoreflect.FullName = "google.protobuf.Any"
+
+// isTypeURL returns true if the message type is a proto type URL, such as
+// "/eth.evm.v1.MsgCreateFunToken", rather than an Amino name.
+func isTypeURL(msgType string) bool {
+	return strings.HasPrefix(msgType, "/")
+}
+
+// protoMsgDescriptor returns the descriptor of the registered proto message
+// with the given type URL.
+func protoMsgDescriptor(typeURL string) (protoreflect.MessageDescriptor, error) {
+	fullName := typeURL[strings.LastIndex(typeURL, "/")+1:]
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(fullName))
+	if err != nil {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidType, "unregistered message type %s: %s", typeURL, err)
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, sdkioerrors.Wrapf(sdkerrors.ErrInvalidType, "%s is not a message type", typeURL)
+	}
+	return msgDesc, nil
+}
+
+// addProtoAnyTypesToRoot adds the types for an Any value, given as a
+// {"type", "value"} JSON object, to typeMap. The type of "value" comes from
+// the proto descriptor registered for the type URL.
+func addProtoAnyTypesToRoot(
+	typeMap apitypes.Types,
+	rootType string,
+	prefix string,
+	payload gjson.Result,
+) (string, error) {
+	typeURL := payload.Get(msgTypeField).Str
+	msgDesc, err := protoMsgDescriptor(typeURL)
+	if err != nil {
+		return "", err
+	}
+
+	value := payload.Get(msgValueField)
+	if !value.IsObject() {
+		return "", sdkioerrors.Wrapf(sdkerrors.ErrInvalidType, "value of %s is not a JSON object", typeURL)
+	}
+	valueTypeDef, err := recursivelyAddProtoTypesToRoot(
+		typeMap, rootType, prefixForSubField(prefix, msgValueField), value, msgDesc,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return addTypesToRoot(typeMap, typeDefForPrefix(prefix, rootType), []apitypes.Type{
+		{Name: msgValueField, Type: sanitizeTypedef(valueTypeDef)},
+		{Name: msgTypeField, Type: ethString},
+	})
+}
+
+// recursivelyAddProtoTypesToRoot is the equivalent of recursivelyAddTypesToRoot
+// for a JSON object that encodes the proto message msgDesc. Fields that are
+// null in the payload are left out of the type, like in the JSON case, but
+// list element types and nested messages follow the descriptor.
+func recursivelyAddProtoTypesToRoot(
+	typeMap apitypes.Types,
+	rootType string,
+	prefix string,
+	payload gjson.Result,
+	msgDesc protoreflect.MessageDescriptor,
+) (string, error) {
+	typesToAdd := []apitypes.Type{}
+
+	// Must sort the JSON keys for deterministic type generation.
+	sortedFieldNames, err := sortedJSONKeys(payload)
+	if err != nil {
+		return "", sdkioerrors.Wrap(err, "unable to sort object keys")
+	}
+
+	for _, fieldName := range sortedFieldNames {
+		field := payload.Get(fieldName)
+		if !field.Exists() {
+			continue
+		}
+
+		var fieldTypeDef string
+		fieldDesc := msgDesc.Fields().ByName(protoreflect.Name(fieldName))
+		if fieldDesc == nil || fieldDesc.IsMap() {
+			fieldTypeDef, err = jsonFieldType(typeMap, rootType, prefix, fieldName, field)
+		} else {
+			fieldTypeDef, err = protoFieldType(typeMap, rootType, prefix, fieldName, field, fieldDesc)
+		}
+		if err != nil {
+			return "", err
+		}
+		if fieldTypeDef != "" {
+			typesToAdd = appendedTypesList(typesToAdd, fieldName, fieldTypeDef)
+		}
+	}
+
+	return addTypesToRoot(typeMap, typeDefForPrefix(prefix, rootType), typesToAdd)
+}
+
+// protoFieldType returns the EIP-712 type of the given field of a proto
+// message. It returns an empty string for null fields.
+func protoFieldType(
+	typeMap apitypes.Types,
+	rootType string,
+	prefix string,
+	fieldName string,
+	field gjson.Result,
+	fieldDesc protoreflect.FieldDescriptor,
+) (string, error) {
+	isCollection := false
+	if fieldDesc.IsList() && field.IsArray() {
+		fieldAsArray := field.Array()
+		if len(fieldAsArray) == 0 {
+			return protoScalarEthType(fieldDesc.Kind()) + "[]", nil
+		}
+		// EIP-712 arrays have a single element type, so the first element
+		// decides it, as in the JSON case.
+		field = fieldAsArray[0]
+		isCollection = true
+	}
+
+	var ethType string
+	switch {
+	case field.Type == gjson.Null:
+		return "", nil
+	case fieldDesc.Message() != nil && field.IsObject():
+		fieldPrefix := prefixForSubField(prefix, fieldName)
+		var typeDef string
+		var err error
+		if fieldDesc.Message().FullName() == anyFullName {
+			typeDef, err = addProtoAnyTypesToRoot(typeMap, rootType, fieldPrefix, field)
+		} else {
+			typeDef, err = recursivelyAddProtoTypesToRoot(typeMap, rootType, fieldPrefix, field, fieldDesc.Message())
+		}
+		if err != nil {
+			return "", err
+		}
+		ethType = sanitizeTypedef(typeDef)
+	default:
+		// Scalars, custom types, and well-known types such as timestamps
+		// are typed by their JSON encoding.
+		ethType = getEthTypeForJSON(field)
+	}
+
+	if ethType == "" {
+		return "", nil
+	}
+	if isCollection {
+		ethType += "[]"
+	}
+	return ethType, nil
+}
+
+// protoScalarEthType returns the EIP-712 type of the JSON encoding of a proto
+// scalar kind. Proto JSON encodes 64-bit integers as strings. Message kinds
+// give string, the type used for empty arrays.
+func protoScalarEthType(kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.BoolKind:
+		return ethBool
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return ethInt64
+	default:
+		return ethString
+	}
+}
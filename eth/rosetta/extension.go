@@ -0,0 +1,390 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rosetta
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	tmcoretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	cryptotypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/crypto/types"
+	sdkrosetta "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/server/rosetta"
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	grpctypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types/grpc"
+	bank "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/bank/types"
+
+	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/ethsecp256k1"
+	"github.com/NibiruChain/nibiru/v2/evm"
+)
+
+var _ sdkrosetta.Extension = (*Extension)(nil)
+
+// NibiCurrency is the rosetta currency of NIBI. Balances and operations are
+// denominated in wei (10^-18 NIBI) instead of "unibi" (10^-6 NIBI) because
+// the EVM moves NIBI at wei precision. See the dual-balance model of the
+// Nibiru x/bank keeper.
+var NibiCurrency = &rosettatypes.Currency{
+	Symbol:   "NIBI",
+	Decimals: 18,
+}
+
+// OpTypeWeiChange is the type of the operations that reconcile NIBI balances
+// changed by the EVM. The EVM state DB writes balances with "AddWei" and
+// "SubWei", which emit "wei_change" events without amounts instead of the
+// "coin_spent" and "coin_received" events of x/bank.
+const OpTypeWeiChange = bank.EventTypeWeiChange
+
+// Metadata keys set on rosetta transactions.
+const (
+	MetaEthTxHash          = "eth_tx_hash"
+	MetaEvmVmError         = "evm_vm_error"
+	MetaFunTokenConversion = "funtoken_conversions"
+	MetaWeiBlockDelta      = "wei_block_delta"
+	MetaNetWeiBlockDelta   = "net_wei_block_delta"
+)
+
+// Extension implements [sdkrosetta.Extension] for Nibiru, so that the rosetta
+// server understands NIBI moved by the EVM in addition to x/bank transfers,
+// and accounts using "eth_secp256k1" keys.
+//
+// Data API: NIBI is reported as a single [NibiCurrency] balance equal to the
+// wei balance of the account in the EVM, and "unibi" coin operations are
+// converted to wei. For every account whose balance was written by the EVM in
+// a block, the difference between its wei balance before and after the block
+// and the operations already known for it is added as a [OpTypeWeiChange]
+// operation. It goes to the only transaction that touched the account or, if
+// there were several, to the end block transaction. This keeps the balances
+// of the Data API consistent with the wei store, including any nonzero
+// [evm.EventWeiBlockDelta], which is recorded on the end block transaction.
+//
+// Construction API: public keys are "eth_secp256k1" keys, the default key
+// type of Nibiru accounts, and payloads are the keccak256 digest of the sign
+// bytes. Accounts with "secp256k1" keys are still supported.
+type Extension struct {
+	evmQuery evm.QueryClient
+}
+
+// NewExtension returns a rosetta [Extension] for Nibiru.
+func NewExtension() *Extension {
+	return &Extension{}
+}
+
+// Bootstrap implements [sdkrosetta.Extension].
+func (e *Extension) Bootstrap(grpcConn grpc.ClientConnInterface, _ cmtrpcclient.Client) error {
+	e.evmQuery = evm.NewQueryClient(grpcConn)
+	return nil
+}
+
+// SupportedOperations implements [sdkrosetta.Extension].
+func (e *Extension) SupportedOperations() []string {
+	return []string{OpTypeWeiChange}
+}
+
+// Balances implements [sdkrosetta.Extension]. It replaces the "unibi" bank
+// balance with the wei balance of the account.
+func (e *Extension) Balances(
+	ctx context.Context, addr string, height *int64, amounts []*rosettatypes.Amount,
+) ([]*rosettatypes.Amount, error) {
+	balWei, err := e.weiBalance(ctx, addr, height)
+	if err != nil {
+		return nil, err
+	}
+	nibi := &rosettatypes.Amount{Value: balWei.String(), Currency: NibiCurrency}
+	for i, amount := range amounts {
+		if amount.Currency.Symbol == appconst.DENOM_UNIBI {
+			amounts[i] = nibi
+			return amounts, nil
+		}
+	}
+	return append(amounts, nibi), nil
+}
+
+// Transaction implements [sdkrosetta.Extension]. It converts "unibi" amounts
+// to wei.
+func (e *Extension) Transaction(tx *rosettatypes.Transaction) {
+	for _, op := range tx.Operations {
+		if op.Amount == nil || op.Amount.Currency == nil ||
+			op.Amount.Currency.Symbol != appconst.DENOM_UNIBI {
+			continue
+		}
+		value, ok := new(big.Int).SetString(op.Amount.Value, 10)
+		if !ok {
+			continue
+		}
+		op.Amount = &rosettatypes.Amount{
+			Value:    evm.NativeToWei(value).String(),
+			Currency: NibiCurrency,
+		}
+	}
+}
+
+// BlockTransactions implements [sdkrosetta.Extension].
+func (e *Extension) BlockTransactions(
+	ctx context.Context,
+	block *tmcoretypes.ResultBlock,
+	results *tmcoretypes.ResultBlockResults,
+	txs []*rosettatypes.Transaction,
+) error {
+	addTxMetadata(txs, results)
+
+	height := block.Block.Height
+	touched := evmTouchedAccounts(results)
+	if len(touched) == 0 || height <= 1 {
+		// Balances before the first block are the genesis balances, which
+		// rosetta takes from its bootstrap file.
+		return nil
+	}
+
+	balanceDeltas := make(map[string]*big.Int, len(touched))
+	prevHeight := height - 1
+	for addr := range touched {
+		pre, err := e.weiBalance(ctx, addr, &prevHeight)
+		if err != nil {
+			return err
+		}
+		post, err := e.weiBalance(ctx, addr, &height)
+		if err != nil {
+			return err
+		}
+		balanceDeltas[addr] = new(big.Int).Sub(post, pre)
+	}
+	addWeiChangeOps(txs, touched, balanceDeltas)
+	return nil
+}
+
+// PubKey implements [sdkrosetta.Extension]. It converts the public key to an
+// "eth_secp256k1" key.
+func (e *Extension) PubKey(pk *rosettatypes.PublicKey) (cryptotypes.PubKey, error) {
+	if pk.CurveType != rosettatypes.Secp256k1 {
+		return nil, nil
+	}
+	pubKey, err := btcec.ParsePubKey(pk.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secp256k1 public key: %w", err)
+	}
+	return &ethsecp256k1.PubKey{Key: pubKey.SerializeCompressed()}, nil
+}
+
+// SignDigest implements [sdkrosetta.Extension]. Signatures of "eth_secp256k1"
+// keys commit to the keccak256 digest of the sign bytes.
+func (e *Extension) SignDigest(pubKey cryptotypes.PubKey, signBytes []byte) []byte {
+	if _, isEthKey := pubKey.(*ethsecp256k1.PubKey); isEthKey {
+		return crypto.Keccak256(signBytes)
+	}
+	return nil
+}
+
+// weiBalance returns the balance of NIBI in wei of a bech32 address at the
+// given height, or at the latest block if height is nil.
+func (e *Extension) weiBalance(ctx context.Context, addr string, height *int64) (*big.Int, error) {
+	if height != nil {
+		ctx = metadata.AppendToOutgoingContext(
+			ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(*height, 10),
+		)
+	}
+	res, err := e.evmQuery.EthAccount(ctx, &evm.QueryEthAccountRequest{Address: addr})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the wei balance of %s: %w", addr, err)
+	}
+	balWei, ok := new(big.Int).SetString(res.BalanceWei, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid wei balance %q for %s", res.BalanceWei, addr)
+	}
+	return balWei, nil
+}
+
+// evmTouchedAccounts returns the accounts whose balances were written by the
+// EVM state DB in a block, mapped to the indexes of the rosetta transactions
+// that touched them. Index 0 is the begin block transaction and the last
+// index is the end block transaction.
+func evmTouchedAccounts(results *tmcoretypes.ResultBlockResults) map[string][]int {
+	touched := make(map[string][]int)
+	collect := func(txIdx int, events []abci.Event) {
+		for _, event := range events {
+			if event.Type != bank.EventTypeWeiChange {
+				continue
+			}
+			var reason, addrs string
+			for _, attr := range event.Attributes {
+				switch attr.Key {
+				case bank.AttributeKeyWeiChangeReason:
+					reason = attr.Value
+				case bank.AttributeKeyWeiChangeAddrs:
+					addrs = attr.Value
+				}
+			}
+			if reason != bank.WeiChangeReason_AddWei.Value &&
+				reason != bank.WeiChangeReason_SubWei.Value {
+				// x/bank transfers also emit coin events with amounts.
+				continue
+			}
+			for _, addr := range strings.Split(addrs, ",") {
+				addr = strings.TrimSpace(addr)
+				if addr == "" {
+					continue
+				}
+				txIdxs := touched[addr]
+				if len(txIdxs) == 0 || txIdxs[len(txIdxs)-1] != txIdx {
+					touched[addr] = append(txIdxs, txIdx)
+				}
+			}
+		}
+	}
+
+	collect(0, results.BeginBlockEvents)
+	for i, txResult := range results.TxsResults {
+		collect(i+1, txResult.Events)
+	}
+	collect(len(results.TxsResults)+1, results.EndBlockEvents)
+	return touched
+}
+
+// addWeiChangeOps adds a [OpTypeWeiChange] operation for each account whose
+// balance delta over the block is not explained by the NIBI operations of the
+// block's transactions.
+func addWeiChangeOps(
+	txs []*rosettatypes.Transaction,
+	touched map[string][]int,
+	balanceDeltas map[string]*big.Int,
+) {
+	known := make(map[string]*big.Int)
+	for _, tx := range txs {
+		for _, op := range tx.Operations {
+			if op.Account == nil || op.Amount == nil || op.Amount.Currency == nil ||
+				op.Amount.Currency.Symbol != NibiCurrency.Symbol {
+				continue
+			}
+			value, ok := new(big.Int).SetString(op.Amount.Value, 10)
+			if !ok {
+				continue
+			}
+			if known[op.Account.Address] == nil {
+				known[op.Account.Address] = new(big.Int)
+			}
+			known[op.Account.Address].Add(known[op.Account.Address], value)
+		}
+	}
+
+	// Sort for deterministic operation indexes.
+	addrs := make([]string, 0, len(balanceDeltas))
+	for addr := range balanceDeltas {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	status := sdkrosetta.StatusTxSuccess
+	for _, addr := range addrs {
+		residual := new(big.Int).Set(balanceDeltas[addr])
+		if knownDelta := known[addr]; knownDelta != nil {
+			residual.Sub(residual, knownDelta)
+		}
+		if residual.Sign() == 0 {
+			continue
+		}
+
+		tx := txs[len(txs)-1]
+		if txIdxs := touched[addr]; len(txIdxs) == 1 {
+			tx = txs[txIdxs[0]]
+		}
+		tx.Operations = append(tx.Operations, &rosettatypes.Operation{
+			OperationIdentifier: &rosettatypes.OperationIdentifier{
+				Index: int64(len(tx.Operations)),
+			},
+			Type:    OpTypeWeiChange,
+			Status:  &status,
+			Account: &rosettatypes.AccountIdentifier{Address: addr},
+			Amount: &rosettatypes.Amount{
+				Value:    residual.String(),
+				Currency: NibiCurrency,
+			},
+		})
+	}
+}
+
+// addTxMetadata records the Ethereum transaction hashes, FunToken conversions
+// and the wei block delta of a block on its rosetta transactions.
+func addTxMetadata(txs []*rosettatypes.Transaction, results *tmcoretypes.ResultBlockResults) {
+	for i, txResult := range results.TxsResults {
+		tx := txs[i+1]
+		for _, event := range txResult.Events {
+			switch event.Type {
+			case proto.MessageName(new(evm.EventEthereumTx)):
+				typed, err := sdk.ParseTypedEvent(event)
+				if err != nil {
+					continue
+				}
+				ethTx := typed.(*evm.EventEthereumTx)
+				setMetadata(tx, MetaEthTxHash, ethTx.EthHash)
+				if ethTx.VmError != "" {
+					setMetadata(tx, MetaEvmVmError, ethTx.VmError)
+				}
+			case proto.MessageName(new(evm.EventConvertCoinToEvm)),
+				proto.MessageName(new(evm.EventConvertEvmToCoin)):
+				typed, err := sdk.ParseTypedEvent(event)
+				if err != nil {
+					continue
+				}
+				conversions, _ := tx.Metadata[MetaFunTokenConversion].([]any)
+				setMetadata(tx, MetaFunTokenConversion, append(conversions, funTokenConversion(typed)))
+			}
+		}
+	}
+
+	endBlockTx := txs[len(txs)-1]
+	for _, event := range results.EndBlockEvents {
+		if event.Type != proto.MessageName(new(evm.EventWeiBlockDelta)) {
+			continue
+		}
+		typed, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		delta := typed.(*evm.EventWeiBlockDelta)
+		setMetadata(endBlockTx, MetaWeiBlockDelta, delta.WeiBlockDelta.String())
+		setMetadata(endBlockTx, MetaNetWeiBlockDelta, delta.NetWeiBlockDelta.String())
+	}
+}
+
+func funTokenConversion(event proto.Message) map[string]any {
+	switch event := event.(type) {
+	case *evm.EventConvertCoinToEvm:
+		return map[string]any{
+			"direction":    "coin_to_evm",
+			"sender":       event.Sender,
+			"erc20":        event.Erc20ContractAddress,
+			"to":           event.ToEthAddr,
+			"bank_coin":    event.BankCoin.String(),
+			"erc20_amount": event.BankCoin.Amount.String(),
+		}
+	case *evm.EventConvertEvmToCoin:
+		return map[string]any{
+			"direction":    "evm_to_coin",
+			"sender":       event.Sender,
+			"erc20":        event.Erc20ContractAddress,
+			"to":           event.ToAddress,
+			"bank_coin":    event.BankCoin.String(),
+			"erc20_amount": event.BankCoin.Amount.String(),
+		}
+	}
+	return nil
+}
+
+func setMetadata(tx *rosettatypes.Transaction, key string, value any) {
+	if tx.Metadata == nil {
+		tx.Metadata = make(map[string]any)
+	}
+	tx.Metadata[key] = value
+}
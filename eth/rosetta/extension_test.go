@@ -0,0 +1,154 @@
+package rosetta
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmcoretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	bank "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/bank/types"
+
+	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	"github.com/NibiruChain/nibiru/v2/eth/crypto/ethsecp256k1"
+)
+
+func weiChangeEvent(reason sdk.Attribute, addrs ...string) abci.Event {
+	return abci.Event{
+		Type: bank.EventTypeWeiChange,
+		Attributes: []abci.EventAttribute{
+			{Key: reason.Key, Value: reason.Value},
+			{Key: bank.AttributeKeyWeiChangeAddrs, Value: bank.WeiChangeAddrsString(addrs...)},
+		},
+	}
+}
+
+func nibiOp(addr string, value string) *rosettatypes.Operation {
+	return &rosettatypes.Operation{
+		Account: &rosettatypes.AccountIdentifier{Address: addr},
+		Amount:  &rosettatypes.Amount{Value: value, Currency: NibiCurrency},
+	}
+}
+
+func TestTransaction(t *testing.T) {
+	tx := &rosettatypes.Transaction{Operations: []*rosettatypes.Operation{
+		{Amount: &rosettatypes.Amount{
+			Value:    "-5",
+			Currency: &rosettatypes.Currency{Symbol: appconst.DENOM_UNIBI},
+		}},
+		{Amount: &rosettatypes.Amount{
+			Value:    "7",
+			Currency: &rosettatypes.Currency{Symbol: "ibc/ABC"},
+		}},
+		{},
+	}}
+	NewExtension().Transaction(tx)
+	require.Equal(t, &rosettatypes.Amount{Value: "-5000000000000", Currency: NibiCurrency}, tx.Operations[0].Amount)
+	require.Equal(t, "ibc/ABC", tx.Operations[1].Amount.Currency.Symbol)
+	require.Nil(t, tx.Operations[2].Amount)
+}
+
+func TestEvmTouchedAccounts(t *testing.T) {
+	results := &tmcoretypes.ResultBlockResults{
+		BeginBlockEvents: []abci.Event{
+			weiChangeEvent(bank.WeiChangeReason_MintCoins, "nibi1mint"),
+		},
+		TxsResults: []*abci.ResponseDeliverTx{
+			{Events: []abci.Event{
+				weiChangeEvent(bank.WeiChangeReason_SubWei, "nibi1alice"),
+				weiChangeEvent(bank.WeiChangeReason_AddWei, "nibi1bob", "nibi1alice"),
+			}},
+			{Events: []abci.Event{
+				weiChangeEvent(bank.WeiChangeReason_SendCoins, "nibi1carol"),
+				weiChangeEvent(bank.WeiChangeReason_AddWei, "nibi1bob"),
+			}},
+		},
+		EndBlockEvents: []abci.Event{
+			weiChangeEvent(bank.WeiChangeReason_SubWei, "nibi1dave"),
+		},
+	}
+	require.Equal(t, map[string][]int{
+		"nibi1alice": {1},
+		"nibi1bob":   {1, 2},
+		"nibi1dave":  {3},
+	}, evmTouchedAccounts(results))
+}
+
+func TestAddWeiChangeOps(t *testing.T) {
+	txs := []*rosettatypes.Transaction{
+		{}, // begin block
+		{Operations: []*rosettatypes.Operation{
+			nibiOp("nibi1alice", "-1000000000000"),
+		}},
+		{},
+		{}, // end block
+	}
+	addWeiChangeOps(
+		txs,
+		map[string][]int{
+			"nibi1alice": {1},
+			"nibi1bob":   {1, 2},
+			"nibi1carol": {2},
+		},
+		map[string]*big.Int{
+			// 1 unibi sent with x/bank plus 25 wei of gas paid in the EVM.
+			"nibi1alice": big.NewInt(-1_000_000_000_025),
+			// Credited in two transactions.
+			"nibi1bob": big.NewInt(10),
+			// Fully explained by other operations.
+			"nibi1carol": big.NewInt(0),
+		},
+	)
+
+	require.Empty(t, txs[0].Operations)
+	require.Len(t, txs[1].Operations, 2)
+	op := txs[1].Operations[1]
+	require.Equal(t, OpTypeWeiChange, op.Type)
+	require.EqualValues(t, 1, op.OperationIdentifier.Index)
+	require.Equal(t, "nibi1alice", op.Account.Address)
+	require.Equal(t, "-25", op.Amount.Value)
+	require.Equal(t, NibiCurrency, op.Amount.Currency)
+
+	require.Empty(t, txs[2].Operations)
+	require.Len(t, txs[3].Operations, 1)
+	require.Equal(t, "nibi1bob", txs[3].Operations[0].Account.Address)
+	require.Equal(t, "10", txs[3].Operations[0].Amount.Value)
+}
+
+func TestPubKeyAndSignDigest(t *testing.T) {
+	ext := NewExtension()
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	wantPubKey := privKey.PubKey()
+
+	// Rosetta clients may send compressed or uncompressed keys.
+	parsed, err := btcec.ParsePubKey(wantPubKey.Bytes())
+	require.NoError(t, err)
+	for _, bz := range [][]byte{parsed.SerializeCompressed(), parsed.SerializeUncompressed()} {
+		pubKey, err := ext.PubKey(&rosettatypes.PublicKey{Bytes: bz, CurveType: rosettatypes.Secp256k1})
+		require.NoError(t, err)
+		require.True(t, wantPubKey.Equals(pubKey))
+	}
+
+	pubKey, err := ext.PubKey(&rosettatypes.PublicKey{Bytes: []byte{1}, CurveType: rosettatypes.Edwards25519})
+	require.NoError(t, err)
+	require.Nil(t, pubKey)
+	_, err = ext.PubKey(&rosettatypes.PublicKey{Bytes: []byte{1}, CurveType: rosettatypes.Secp256k1})
+	require.Error(t, err)
+
+	// A signature over the digest verifies against the sign bytes.
+	signBytes := []byte("sign bytes")
+	digest := ext.SignDigest(wantPubKey, signBytes)
+	require.Equal(t, crypto.Keccak256(signBytes), digest)
+	sig, err := privKey.Sign(digest)
+	require.NoError(t, err)
+	require.True(t, wantPubKey.VerifySignature(signBytes, sig[:64]))
+
+	require.Nil(t, ext.SignDigest(secp256k1.GenPrivKey().PubKey(), signBytes))
+}
@@ -7,8 +7,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"runtime" // #nosec G702
 	"runtime/debug"
+	"strings"
 
 	"github.com/davecgh/go-spew/spew"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 	getheth "github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -150,6 +153,259 @@ func (a *DebugAPI) PrintBlock(number uint64) (string, error) {
 	return spew.Sdump(block), nil
 }
 
+// GetRawHeader returns an RLP-encoded block header.
+func (a *DebugAPI) GetRawHeader(
+	ctx context.Context,
+	blockNrOrHash rpc.BlockNumberOrHash,
+) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawHeader", "block number or hash", blockNrOrHash)
+	block, err := a.ethBlockByNumberOrHash(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(block.Header())
+}
+
+// GetRawBlock returns an RLP-encoded block.
+func (a *DebugAPI) GetRawBlock(
+	ctx context.Context,
+	blockNrOrHash rpc.BlockNumberOrHash,
+) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawBlock", "block number or hash", blockNrOrHash)
+	block, err := a.ethBlockByNumberOrHash(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(block)
+}
+
+// GetRawReceipts returns an array of EIP-2718 binary-encoded receipts.
+func (a *DebugAPI) GetRawReceipts(
+	ctx context.Context,
+	blockNrOrHash rpc.BlockNumberOrHash,
+) ([]hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawReceipts", "block number or hash", blockNrOrHash)
+	blockNum, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	resBlock, err := a.backend.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	blockRes, err := a.backend.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := a.backend.ReceiptsFromTendermintBlock(resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]hexutil.Bytes, len(receipts))
+	for i, receipt := range receipts {
+		if result[i], err = receipt.Receipt.MarshalBinary(); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// GetRawTransaction returns the bytes of the transaction for the given hash.
+func (a *DebugAPI) GetRawTransaction(
+	ctx context.Context,
+	hash common.Hash,
+) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawTransaction", "hash", hash)
+	return a.backend.GetRawTransactionByHash(hash)
+}
+
+// TraceBlock returns the structured logs created during the execution of the
+// transactions of an RLP-encoded block on top of the state of its parent
+// block. The block does not need to be part of the chain.
+func (a *DebugAPI) TraceBlock(
+	ctx context.Context,
+	blob hexutil.Bytes,
+	config *evm.TraceConfig,
+) ([]*evm.TxTraceResult, error) {
+	a.logger.Debug("debug_traceBlock", "size", len(blob))
+	block := new(gethcore.Block)
+	if err := rlp.DecodeBytes(blob, block); err != nil {
+		return nil, fmt.Errorf("could not decode block: %w", err)
+	}
+	return a.backend.TraceEthBlock(block, config)
+}
+
+// TraceBlockFromFile returns the structured logs created during the execution
+// of the RLP-encoded block stored in "file". See [DebugAPI.TraceBlock].
+func (a *DebugAPI) TraceBlockFromFile(
+	ctx context.Context,
+	file string,
+	config *evm.TraceConfig,
+) ([]*evm.TxTraceResult, error) {
+	a.logger.Debug("debug_traceBlockFromFile", "file", file)
+	blob, err := os.ReadFile(file) // #nosec G304 -- debug namespace only
+	if err != nil {
+		return nil, fmt.Errorf("could not read file: %w", err)
+	}
+	return a.TraceBlock(ctx, blob, config)
+}
+
+// TraceBadBlock returns structured logs created during execution of a bad
+// block. Blocks that fail validation never reach the CometBFT block store, so
+// Nibiru has no bad blocks to trace (see [DebugAPI.GetBadBlocks]).
+func (a *DebugAPI) TraceBadBlock(
+	ctx context.Context,
+	hash common.Hash,
+	config *evm.TraceConfig,
+) ([]*evm.TxTraceResult, error) {
+	a.logger.Debug("debug_traceBadBlock", "hash", hash)
+	return nil, fmt.Errorf("bad block %#x not found", hash)
+}
+
+// TraceChain returns the structured logs created during the execution of the
+// blocks from "start" to "end", excluding "start" and including "end", with
+// one result per block.
+//
+// Over HTTP, the whole range is returned at once and may span at most the
+// "block-range-cap" of the JSON-RPC config. Websocket clients can instead
+// stream one notification per block with
+// `debug_subscribe ["traceChain", start, end, config]`, which has the same cap.
+func (a *DebugAPI) TraceChain(
+	ctx context.Context,
+	start, end rpc.BlockNumber,
+	config *evm.TraceConfig,
+) ([]*BlockTraceResult, error) {
+	a.logger.Debug("debug_traceChain", "start", start, "end", end)
+	return a.backend.TraceChain(start, end, config)
+}
+
+// StandardTraceBlockToFile dumps the structured logs of the transactions in a
+// block to one file per transaction in the temporary directory and returns
+// the file names. Each line of a file is one step of the EVM, followed by a
+// summary line with the output and gas used. If "config.TxHash" is set, only
+// that transaction is dumped.
+func (a *DebugAPI) StandardTraceBlockToFile(
+	ctx context.Context,
+	hash common.Hash,
+	config *tracers.StdTraceConfig,
+) ([]string, error) {
+	a.logger.Debug("debug_standardTraceBlockToFile", "hash", hash)
+	resBlock, err := a.backend.TendermintBlockByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	traceConfig := &evm.TraceConfig{}
+	var txHash common.Hash
+	if config != nil {
+		traceConfig.EnableMemory = config.EnableMemory
+		traceConfig.DisableStack = config.DisableStack
+		traceConfig.DisableStorage = config.DisableStorage
+		traceConfig.EnableReturnData = config.EnableReturnData
+		traceConfig.Limit = int32(config.Limit) // #nosec G115
+		txHash = config.TxHash
+	}
+	traces, err := a.backend.TraceBlock(
+		rpc.BlockNumber(resBlock.Block.Height), traceConfig, resBlock,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileNames []string
+	for i, trace := range traces {
+		if txHash != (common.Hash{}) && trace.TxHash != txHash {
+			continue
+		}
+		fileName, err := writeStandardTrace(hash, i, trace)
+		if err != nil {
+			return fileNames, err
+		}
+		fileNames = append(fileNames, fileName)
+	}
+	if txHash != (common.Hash{}) && len(fileNames) == 0 {
+		return nil, fmt.Errorf("transaction %#x not found in block", txHash)
+	}
+	return fileNames, nil
+}
+
+// StandardTraceBadBlockToFile dumps structured logs for a bad block to file.
+// Nibiru has no bad blocks to trace (see [DebugAPI.TraceBadBlock]).
+func (a *DebugAPI) StandardTraceBadBlockToFile(
+	ctx context.Context,
+	hash common.Hash,
+	config *tracers.StdTraceConfig,
+) ([]string, error) {
+	a.logger.Debug("debug_standardTraceBadBlockToFile", "hash", hash)
+	return nil, fmt.Errorf("bad block %#x not found", hash)
+}
+
+// ethBlockByNumberOrHash returns the Ethereum block identified by
+// "blockNrOrHash".
+func (a *DebugAPI) ethBlockByNumberOrHash(
+	blockNrOrHash rpc.BlockNumberOrHash,
+) (*gethcore.Block, error) {
+	blockNum, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return a.backend.EthBlockByNumber(blockNum)
+}
+
+// writeStandardTrace writes the struct logs of a transaction trace to a new
+// file in the temporary directory, one JSON object per line, and returns the
+// file name.
+func writeStandardTrace(
+	blockHash common.Hash, txIndex int, trace *evm.TxTraceResult,
+) (fileName string, err error) {
+	if trace.Error != "" {
+		return "", fmt.Errorf("failed to trace tx %s: %s", trace.TxHash.Hex(), trace.Error)
+	}
+	resultBz, err := json.Marshal(trace.Result)
+	if err != nil {
+		return "", err
+	}
+	var result struct {
+		Gas         uint64            `json:"gas"`
+		Failed      bool              `json:"failed"`
+		ReturnValue string            `json:"returnValue"`
+		StructLogs  []json.RawMessage `json:"structLogs"`
+	}
+	if err := json.Unmarshal(resultBz, &result); err != nil {
+		return "", fmt.Errorf("unexpected trace result: %w", err)
+	}
+
+	prefix := fmt.Sprintf(
+		"block_%#x-%d-%#x-", blockHash.Bytes()[:4], txIndex, trace.TxHash.Bytes()[:4],
+	)
+	file, err := os.CreateTemp(os.TempDir(), prefix)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	encoder := json.NewEncoder(file)
+	for _, structLog := range result.StructLogs {
+		if err := encoder.Encode(structLog); err != nil {
+			return "", err
+		}
+	}
+	summary := map[string]any{
+		"output":  "0x" + strings.TrimPrefix(result.ReturnValue, "0x"),
+		"gasUsed": hexutil.Uint64(result.Gas),
+		"pass":    !result.Failed,
+	}
+	if err := encoder.Encode(summary); err != nil {
+		return "", err
+	}
+	return file.Name(), nil
+}
+
 // --------------------------------------------------------------------------
 // Code beyond this point falls under the disbaled portion of the debug namespace
 // --------------------------------------------------------------------------
@@ -267,109 +523,3 @@ func (a *DebugAPI) GetBadBlocks(ctx context.Context) ([]*getheth.BadBlockArgs, e
 	a.logger.Debug("debug_getBadBlocks")
 	return []*getheth.BadBlockArgs{}, nil
 }
-
-// GetRawBlock returns an RLP-encoded block.
-func (a *DebugAPI) GetRawBlock(
-	ctx context.Context,
-	blockNrOrHash rpc.BlockNumberOrHash,
-) (hexutil.Bytes, error) {
-	fnName := "debug_getRawBlock"
-	a.logger.Debug(noOpMethod(fnName))
-	return nil, ErrNotImplemented(fnName)
-}
-
-// GetRawReceipts returns an array of EIP-2718 binary-encoded receipts.
-func (a *DebugAPI) GetRawReceipts(
-	ctx context.Context,
-	blockNrOrHash rpc.BlockNumberOrHash,
-) ([]hexutil.Bytes, error) {
-	fnName := "debug_getRawReceipts"
-	a.logger.Debug(noOpMethod(fnName))
-	return nil, ErrNotImplemented(fnName)
-}
-
-// GetRawHeader returns an RLP-encoded block header.
-func (a *DebugAPI) GetRawHeader(
-	ctx context.Context,
-	blockNrOrHash rpc.BlockNumberOrHash,
-) (hexutil.Bytes, error) {
-	fnName := "debug_getRawHeader"
-	a.logger.Debug(noOpMethod(fnName))
-	return nil, ErrNotImplemented(fnName)
-}
-
-// GetRawTransaction returns the bytes of the transaction for the given hash.
-func (a *DebugAPI) GetRawTransaction(
-	ctx context.Context,
-	hash common.Hash,
-) (hexutil.Bytes, error) {
-	fnName := "debug_getRawTransaction"
-	a.logger.Debug(noOpMethod(fnName))
-	return nil, ErrNotImplemented(fnName)
-}
-
-// StandardTraceBadBlockToFile dumps structured logs for a bad block to file.
-func (a *DebugAPI) StandardTraceBadBlockToFile(
-	ctx context.Context,
-	hash common.Hash,
-	config *tracers.StdTraceConfig,
-) ([]string, error) {
-	fnName := "debug_standardTraceBadBlockToFile"
-	a.logger.Debug(noOpMethod(fnName))
-	return nil, ErrNotImplemented(fnName)
-}
-
-// StandardTraceBlockToFile dumps structured logs for a block to file.
-func (a *DebugAPI) StandardTraceBlockToFile(
-	ctx context.Context,
-	hash common.Hash,
-	config *tracers.StdTraceConfig,
-) ([]string, error) {
-	fnName := "debug_standardTraceBlockToFile"
-	a.logger.Debug(noOpMethod(fnName))
-	return nil, ErrNotImplemented(fnName)
-}
-
-// TraceBadBlock returns structured logs created during execution of a bad block.
-func (a *DebugAPI) TraceBadBlock(
-	ctx context.Context,
-	hash common.Hash,
-	config *tracers.TraceConfig,
-) ([]json.RawMessage, error) {
-	fnName := "debug_traceBadBlock"
-	a.logger.Debug(noOpMethod(fnName))
-	return nil, ErrNotImplemented(fnName)
-}
-
-// TraceBlock returns structured logs created during execution of EVM.
-func (a *DebugAPI) TraceBlock(
-	ctx context.Context,
-	blob hexutil.Bytes,
-	config *tracers.TraceConfig,
-) ([]json.RawMessage, error) {
-	fnName := "debug_traceBlock"
-	a.logger.Debug(noOpMethod(fnName))
-	return nil, ErrNotImplemented(fnName)
-}
-
-// TraceBlockFromFile returns structured logs created during execution from file.
-func (a *DebugAPI) TraceBlockFromFile(
-	ctx context.Context,
-	file string,
-	config *tracers.TraceConfig,
-) ([]json.RawMessage, error) {
-	fnName := "debug_traceBlockFromFile"
-	a.logger.Debug(noOpMethod(fnName))
-	return nil, ErrNotImplemented(fnName)
-}
-
-// TraceChain returns structured logs created between two blocks.
-func (a *DebugAPI) TraceChain(
-	ctx context.Context,
-	start, end rpc.BlockNumber,
-	config *tracers.TraceConfig,
-) (any, error) {
-	fnName := "debug_traceChain"
-	a.logger.Debug(noOpMethod(fnName))
-	return nil, ErrNotImplemented(fnName)
-}
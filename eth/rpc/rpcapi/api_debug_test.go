@@ -0,0 +1,56 @@
+package rpcapi_test
+
+import (
+	"context"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+)
+
+func (s *BackendSuite) TestDebugGetRaw() {
+	transferTx := s.SuccessfulTxTransfer()
+	blockNrOrHash := rpc.BlockNumberOrHash{BlockHash: transferTx.BlockHash}
+	debugAPI := s.cli.EvmRpc.Debug
+
+	s.Run("debug_getRawHeader", func() {
+		headerBz, err := debugAPI.GetRawHeader(context.Background(), blockNrOrHash)
+		s.Require().NoError(err)
+		header := new(gethcore.Header)
+		s.Require().NoError(rlp.DecodeBytes(headerBz, header))
+		s.Equal(transferTx.BlockNumber, header.Number)
+	})
+
+	s.Run("debug_getRawBlock", func() {
+		blockBz, err := debugAPI.GetRawBlock(context.Background(), blockNrOrHash)
+		s.Require().NoError(err)
+		block := new(gethcore.Block)
+		s.Require().NoError(rlp.DecodeBytes(blockBz, block))
+		s.Equal(transferTx.BlockNumber, block.Number())
+		s.NotNil(block.Transaction(transferTx.Receipt.TxHash))
+	})
+
+	s.Run("debug_getRawReceipts", func() {
+		receiptsBz, err := debugAPI.GetRawReceipts(context.Background(), blockNrOrHash)
+		s.Require().NoError(err)
+		s.Require().NotEmpty(receiptsBz)
+		receipt := new(gethcore.Receipt)
+		s.Require().NoError(receipt.UnmarshalBinary(receiptsBz[transferTx.Receipt.TransactionIndex]))
+		s.Equal(gethcore.ReceiptStatusSuccessful, receipt.Status)
+		s.Equal(transferTx.Receipt.CumulativeGasUsed, receipt.CumulativeGasUsed)
+	})
+
+	s.Run("debug_getRawTransaction", func() {
+		txBz, err := debugAPI.GetRawTransaction(context.Background(), transferTx.Receipt.TxHash)
+		s.Require().NoError(err)
+		tx := new(gethcore.Transaction)
+		s.Require().NoError(tx.UnmarshalBinary(txBz))
+		s.Equal(transferTx.Receipt.TxHash, tx.Hash())
+
+		txBz, err = debugAPI.GetRawTransaction(context.Background(), gethcommon.Hash{})
+		s.NoError(err)
+		s.Nil(txBz)
+	})
+}
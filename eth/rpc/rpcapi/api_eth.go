@@ -139,6 +139,18 @@ func (e *EthAPI) GetTransactionReceipt(
 	return out, err
 }
 
+// GetBlockReceipts returns the receipts of all transactions in the block
+// identified by number or hash.
+func (e *EthAPI) GetBlockReceipts(
+	blockNrOrHash rpc.BlockNumberOrHash,
+) ([]*TransactionReceipt, error) {
+	methodName := "eth_getBlockReceipts"
+	e.logger.Debug(methodName, "block number or hash", blockNrOrHash)
+	receipts, err := e.backend.GetBlockReceipts(blockNrOrHash)
+	logError(e.logger, err, methodName)
+	return receipts, err
+}
+
 // GetBlockTransactionCountByHash returns the number of transactions in the block identified by hash.
 func (e *EthAPI) GetBlockTransactionCountByHash(
 	blockHash common.Hash,
@@ -255,10 +267,15 @@ func (e *EthAPI) GetProof(address common.Address,
 //
 // Allows developers to read data from the blockchain which includes executing
 // smart contracts. However, no data is published to the blockchain network.
+//
+// The optional state overrides replace the balance, nonce, code, or storage
+// of accounts, and the optional block overrides replace fields of the block
+// header for the duration of the call, as in go-ethereum.
 func (e *EthAPI) Call(
 	args evm.JsonTxArgs,
 	blockNrOrHash rpc.BlockNumberOrHash,
-	_ *rpc.StateOverride,
+	overrides *rpc.StateOverride,
+	blockOverrides *rpc.BlockOverrides,
 ) (bz hexutil.Bytes, err error) {
 	e.logger.Debug("eth_call", "args", args.String(), "block number or hash", blockNrOrHash)
 
@@ -267,7 +284,7 @@ func (e *EthAPI) Call(
 		logError(e.logger, err, "eth_call")
 		return bz, err
 	}
-	msgEthTxResp, err := e.backend.DoCall(args, blockNum)
+	msgEthTxResp, err := e.backend.DoCallWithOverrides(args, blockNum, overrides, blockOverrides)
 	if err != nil {
 		logError(e.logger, err, "eth_call")
 		return bz, err
@@ -276,6 +293,54 @@ func (e *EthAPI) Call(
 	return (hexutil.Bytes)(msgEthTxResp.Ret), nil
 }
 
+// CreateAccessList returns the access list that the given call would use,
+// together with the gas it uses with that access list. Adding the access list
+// to the transaction can lower its gas cost (EIP-2930).
+func (e *EthAPI) CreateAccessList(
+	args evm.JsonTxArgs, blockNrOrHash *rpc.BlockNumberOrHash,
+) (*rpc.AccessListResult, error) {
+	methodName := "eth_createAccessList"
+	e.logger.Debug(methodName, "args", args.String(), "block number or hash", blockNrOrHash)
+
+	bNrOrHash := rpc.BlockNumberOrHash{}
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	} else {
+		pending := rpc.EthPendingBlockNumber
+		bNrOrHash.BlockNumber = &pending
+	}
+	result, err := e.backend.CreateAccessList(args, bNrOrHash)
+	logError(e.logger, err, methodName)
+	return result, err
+}
+
+// SimulateV1 executes a sequence of calls across one or more simulated blocks
+// on top of the given block (latest by default), like "eth_simulateV1" in
+// go-ethereum. Each block may override the state and block header fields,
+// and nothing is published to the blockchain network. The RPC gas cap bounds
+// the total gas of all calls in the request.
+func (e *EthAPI) SimulateV1(
+	opts evm.SimOpts, blockNrOrHash *rpc.BlockNumberOrHash,
+) ([]map[string]any, error) {
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+
+	blockNum := rpc.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNum, err = e.backend.BlockNumberFromTendermint(*blockNrOrHash)
+		if err != nil {
+			logError(e.logger, err, "eth_simulateV1")
+			return nil, err
+		}
+	}
+	blocks, err := e.backend.SimulateV1(opts, blockNum)
+	if err != nil {
+		logError(e.logger, err, "eth_simulateV1")
+		return nil, err
+	}
+	return blocks, nil
+}
+
 // --------------------------------------------------------------------------
 //                           Event Logs
 // --------------------------------------------------------------------------
@@ -297,12 +362,17 @@ func (e *EthAPI) GasPrice() (*hexutil.Big, error) {
 	return e.backend.GasPrice()
 }
 
-// EstimateGas returns an estimate of gas usage for the given smart contract call.
+// EstimateGas returns an estimate of gas usage for the given smart contract
+// call. The optional state and block overrides are the same as for
+// [EthAPI.Call].
 func (e *EthAPI) EstimateGas(
-	args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber,
+	args evm.JsonTxArgs,
+	blockNrOptional *rpc.BlockNumber,
+	overrides *rpc.StateOverride,
+	blockOverrides *rpc.BlockOverrides,
 ) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGasWithOverrides(args, blockNrOptional, overrides, blockOverrides)
 }
 
 func (e *EthAPI) FeeHistory(blockCount gethmath.HexOrDecimal64,
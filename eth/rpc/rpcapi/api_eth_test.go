@@ -71,7 +71,7 @@ func (s *Suite) TestExpectedMethods() {
 	apis := rpcapi.GetRPCAPIs(
 		serverCtx, client.Context{},
 		&cmtrpcclient.WSClient{},
-		true, nil,
+		true, nil, nil,
 		[]string{
 			rpcapi.NamespaceEth, // eth and filters services
 			rpcapi.NamespaceDebug,
@@ -300,7 +300,7 @@ func (s *NodeSuite) Test_EstimateGas() {
 		Gas:   &gasHex,
 		Value: (*hexutil.Big)(evm.NativeToWei(big.NewInt(1))),
 	}
-	gasEstimated, err := s.ethAPI.EstimateGas(msg, nil)
+	gasEstimated, err := s.ethAPI.EstimateGas(msg, nil, nil, nil)
 	s.NoError(err)
 	s.Equal(fmt.Sprintf("%d", gasLimit), fmt.Sprintf("%d", uint64(gasEstimated)))
 
@@ -309,7 +309,7 @@ func (s *NodeSuite) Test_EstimateGas() {
 		new(big.Int).Sub(evm.NativeToWei(big.NewInt(1)), big.NewInt(1)), // 10^12 - 1
 	} {
 		msg.Value = (*hexutil.Big)(msgValue)
-		_, err = s.ethAPI.EstimateGas(msg, nil)
+		_, err = s.ethAPI.EstimateGas(msg, nil, nil, nil)
 		s.NoError(err, "estimate gas should work")
 	}
 }
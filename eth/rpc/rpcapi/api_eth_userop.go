@@ -0,0 +1,70 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpcapi
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// UserOpAPI implements the ERC-4337 bundler methods of the "eth" namespace
+// (eth_sendUserOperation, eth_estimateUserOperationGas, etc.) on top of the
+// in-process [UserOpPool]. It is only registered when the bundler is enabled
+// in the "json-rpc.bundler" section of app.toml.
+//
+// See https://eips.ethereum.org/EIPS/eip-4337#rpc-methods-eth-namespace
+type UserOpAPI struct {
+	logger log.Logger
+	pool   *UserOpPool
+}
+
+// NewImplUserOpAPI returns a [UserOpAPI] for JSON-RPC registration.
+func NewImplUserOpAPI(logger log.Logger, pool *UserOpPool) *UserOpAPI {
+	return &UserOpAPI{
+		logger: logger.With("module", "userop"),
+		pool:   pool,
+	}
+}
+
+// SendUserOperation validates the user operation against the entry point and
+// adds it to the pool of the bundler. It returns the user operation hash.
+func (api *UserOpAPI) SendUserOperation(
+	op UserOperation, entryPoint gethcommon.Address,
+) (gethcommon.Hash, error) {
+	api.logger.Debug("eth_sendUserOperation", "sender", op.Sender, "entryPoint", entryPoint)
+	hash, err := api.pool.Add(op, entryPoint)
+	if err != nil {
+		logError(api.logger, err, "eth_sendUserOperation")
+		return gethcommon.Hash{}, err
+	}
+	return hash, nil
+}
+
+// EstimateUserOperationGas returns estimates of the preVerificationGas,
+// verificationGasLimit, and callGasLimit of the user operation. The gas and
+// fee fields of the user operation are ignored, but its signature should be
+// a valid-looking placeholder.
+func (api *UserOpAPI) EstimateUserOperationGas(
+	op UserOperation, entryPoint gethcommon.Address,
+) (*UserOperationGasEstimate, error) {
+	api.logger.Debug("eth_estimateUserOperationGas", "sender", op.Sender, "entryPoint", entryPoint)
+	estimate, err := api.pool.EstimateGas(op, entryPoint)
+	if err != nil {
+		logError(api.logger, err, "eth_estimateUserOperationGas")
+		return nil, err
+	}
+	return estimate, nil
+}
+
+// GetUserOperationReceipt returns the receipt of a user operation submitted
+// through this node, or null if it is unknown or not in a block yet.
+func (api *UserOpAPI) GetUserOperationReceipt(hash gethcommon.Hash) (*UserOperationReceipt, error) {
+	api.logger.Debug("eth_getUserOperationReceipt", "hash", hash)
+	return api.pool.Receipt(hash)
+}
+
+// SupportedEntryPoints returns the EntryPoint addresses supported by the
+// bundler, the preferred one first.
+func (api *UserOpAPI) SupportedEntryPoints() ([]gethcommon.Address, error) {
+	api.logger.Debug("eth_supportedEntryPoints")
+	return api.pool.SupportedEntryPoints(), nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpcapi
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/evm"
+)
+
+// TraceTypeTrace is the only trace type of "trace_call" and
+// "trace_replayTransaction" supported by Nibiru. The "stateDiff" and
+// "vmTrace" types are not.
+const TraceTypeTrace = "trace"
+
+// TraceAPI implements the Parity/OpenEthereum "trace" namespace (trace_block,
+// trace_transaction, trace_filter, etc.), which returns flat call traces as
+// produced by geth's "flatCallTracer". Block explorers such as Blockscout use
+// it to index internal transactions.
+type TraceAPI struct {
+	logger  log.Logger
+	backend *Backend
+}
+
+// NewImplTraceAPI returns a [TraceAPI] for JSON-RPC registration.
+func NewImplTraceAPI(logger log.Logger, backend *Backend) *TraceAPI {
+	return &TraceAPI{
+		logger:  logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the flat call traces of all transactions in a block.
+func (api *TraceAPI) Block(blockNr rpc.BlockNumber) ([]rpc.ParityTrace, error) {
+	api.logger.Debug("trace_block", "number", blockNr)
+	return api.backend.ParityTraceBlock(blockNr)
+}
+
+// Transaction returns the flat call traces of a transaction.
+func (api *TraceAPI) Transaction(hash gethcommon.Hash) ([]rpc.ParityTrace, error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+	return api.backend.ParityTraceTransaction(hash)
+}
+
+// Filter returns the flat call traces of a block range that match the
+// address filters of "args". See [rpc.TraceFilterArgs].
+func (api *TraceAPI) Filter(args rpc.TraceFilterArgs) ([]rpc.ParityTrace, error) {
+	api.logger.Debug("trace_filter", "fromBlock", args.FromBlock, "toBlock", args.ToBlock)
+	return api.backend.ParityTraceFilter(args)
+}
+
+// ReplayTransaction replays a transaction and returns its flat call traces
+// together with its output.
+func (api *TraceAPI) ReplayTransaction(
+	hash gethcommon.Hash, traceTypes []string,
+) (*rpc.ParityTraceResults, error) {
+	api.logger.Debug("trace_replayTransaction", "hash", hash, "traceTypes", traceTypes)
+	if err := validateTraceTypes(traceTypes); err != nil {
+		return nil, err
+	}
+	traces, err := api.backend.ParityTraceTransaction(hash)
+	if err != nil {
+		return nil, err
+	}
+	return newParityTraceResults(traces), nil
+}
+
+// Call executes a call on top of the state at the given block, which
+// defaults to the latest block, and returns its flat call traces together
+// with its output.
+func (api *TraceAPI) Call(
+	args evm.JsonTxArgs, traceTypes []string, blockNrOrHash *rpc.BlockNumberOrHash,
+) (*rpc.ParityTraceResults, error) {
+	api.logger.Debug("trace_call", "args", args.String(), "traceTypes", traceTypes)
+	if err := validateTraceTypes(traceTypes); err != nil {
+		return nil, err
+	}
+	if blockNrOrHash == nil {
+		latest := rpc.EthLatestBlockNumber
+		blockNrOrHash = &rpc.BlockNumberOrHash{BlockNumber: &latest}
+	}
+	traces, err := api.backend.ParityTraceCall(args, *blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return newParityTraceResults(traces), nil
+}
+
+// validateTraceTypes returns an error if "traceTypes" requests a trace type
+// other than [TraceTypeTrace].
+func validateTraceTypes(traceTypes []string) error {
+	for _, traceType := range traceTypes {
+		if traceType != TraceTypeTrace {
+			return fmt.Errorf("unsupported trace type %q: only %q is supported", traceType, TraceTypeTrace)
+		}
+	}
+	return nil
+}
+
+// newParityTraceResults returns the result of "trace_call" and
+// "trace_replayTransaction". The output is the return data of the top call,
+// or the deployed code if it is a contract creation.
+func newParityTraceResults(traces []rpc.ParityTrace) *rpc.ParityTraceResults {
+	res := &rpc.ParityTraceResults{Output: []byte{}, Trace: traces}
+	if len(traces) == 0 || traces[0].Result == nil {
+		return res
+	}
+	switch topResult := traces[0].Result; {
+	case topResult.Output != nil:
+		res.Output = *topResult.Output
+	case topResult.Code != nil:
+		res.Output = *topResult.Code
+	}
+	return res
+}
@@ -0,0 +1,118 @@
+package rpcapi_test
+
+import (
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/evm"
+)
+
+func (s *BackendSuite) TestTraceAPI() {
+	transferTx := s.SuccessfulTxTransfer()
+	transferBlock := *transferTx.BlockNumberRpc
+
+	// assertTransferTrace checks the single flat trace of the transfer tx.
+	assertTransferTrace := func(traces []rpc.ParityTrace) {
+		s.Require().Len(traces, 1)
+		trace := traces[0]
+		s.Equal("call", trace.Type)
+		s.Equal("call", trace.Action.CallType)
+		s.Equal(s.evmSenderEthAddr, *trace.Action.From)
+		s.Equal(s.accInfo.Recipient, *trace.Action.To)
+		s.Equal(amountToSend.String(), trace.Action.Value.ToInt().String())
+		s.Empty(trace.TraceAddress)
+		s.Zero(trace.Subtraces)
+		s.Equal(transferTx.Receipt.TxHash, *trace.TransactionHash)
+		s.Equal(transferBlock.Int64(), int64(trace.BlockNumber))
+		s.Equal(*transferTx.BlockHash, *trace.BlockHash)
+	}
+
+	s.Run("trace_transaction", func() {
+		traces, err := s.cli.EvmRpc.Trace.Transaction(transferTx.Receipt.TxHash)
+		s.Require().NoError(err)
+		assertTransferTrace(traces)
+	})
+
+	s.Run("trace_block", func() {
+		traces, err := s.cli.EvmRpc.Trace.Block(transferBlock)
+		s.Require().NoError(err)
+		assertTransferTrace(traces)
+
+		_, err = s.cli.EvmRpc.Trace.Block(0)
+		s.ErrorContains(err, "genesis is not traceable")
+	})
+
+	s.Run("trace_replayTransaction", func() {
+		res, err := s.cli.EvmRpc.Trace.ReplayTransaction(
+			transferTx.Receipt.TxHash, []string{"trace"},
+		)
+		s.Require().NoError(err)
+		assertTransferTrace(res.Trace)
+		s.Empty(res.Output)
+		s.Nil(res.StateDiff)
+
+		_, err = s.cli.EvmRpc.Trace.ReplayTransaction(
+			transferTx.Receipt.TxHash, []string{"vmTrace"},
+		)
+		s.ErrorContains(err, "unsupported trace type")
+	})
+
+	s.Run("trace_filter", func() {
+		from, to := transferBlock-1, transferBlock+1
+		traces, err := s.cli.EvmRpc.Trace.Filter(rpc.TraceFilterArgs{
+			FromBlock:   &from,
+			ToBlock:     &to,
+			FromAddress: []gethcommon.Address{s.evmSenderEthAddr},
+			ToAddress:   []gethcommon.Address{s.accInfo.Recipient},
+		})
+		s.Require().NoError(err)
+		assertTransferTrace(traces)
+
+		// No trace goes to the unused address.
+		traces, err = s.cli.EvmRpc.Trace.Filter(rpc.TraceFilterArgs{
+			FromBlock: &from,
+			ToBlock:   &to,
+			ToAddress: []gethcommon.Address{s.accInfo.UnusedAddress},
+		})
+		s.Require().NoError(err)
+		s.Empty(traces)
+
+		// "after" skips the only matching trace.
+		after := uint64(1)
+		traces, err = s.cli.EvmRpc.Trace.Filter(rpc.TraceFilterArgs{
+			FromBlock:   &from,
+			ToBlock:     &to,
+			FromAddress: []gethcommon.Address{s.evmSenderEthAddr},
+			ToAddress:   []gethcommon.Address{s.accInfo.Recipient},
+			After:       &after,
+		})
+		s.Require().NoError(err)
+		s.Empty(traces)
+
+		_, err = s.cli.EvmRpc.Trace.Filter(rpc.TraceFilterArgs{FromBlock: &to, ToBlock: &from})
+		s.ErrorContains(err, "needs to be at least fromBlock")
+
+		farBlock := from + rpc.BlockNumber(s.backend.RPCBlockRangeCap())
+		_, err = s.cli.EvmRpc.Trace.Filter(rpc.TraceFilterArgs{FromBlock: &from, ToBlock: &farBlock})
+		s.ErrorContains(err, "exceeds the block range cap")
+	})
+
+	s.Run("trace_call", func() {
+		value := (*hexutil.Big)(amountToSend)
+		res, err := s.cli.EvmRpc.Trace.Call(
+			evm.JsonTxArgs{
+				From:  &s.evmSenderEthAddr,
+				To:    &s.accInfo.Recipient,
+				Value: value,
+			},
+			[]string{"trace"},
+			nil,
+		)
+		s.Require().NoError(err)
+		s.Require().Len(res.Trace, 1)
+		s.Equal("call", res.Trace[0].Type)
+		s.Equal(s.accInfo.Recipient, *res.Trace[0].Action.To)
+		s.Equal(amountToSend.String(), res.Trace[0].Action.Value.ToInt().String())
+	})
+}
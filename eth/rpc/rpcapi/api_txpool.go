@@ -2,27 +2,36 @@
 package rpcapi
 
 import (
+	"fmt"
+
 	"github.com/cometbft/cometbft/libs/log"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/evm"
 )
 
-// TxPoolAPI implements the Ethereum JSON-RPC txpool namespace for client
-// compatibility (wallets and tooling that call txpool_status, txpool_content,
-// etc.). The methods return empty or zeroed values: the chain has no in-process
-// Geth-style transaction pool (pending/queued EVM subpools by sender). Submitted
-// transactions live in the CometBFT mempool, which is not the same model and is
-// not wired up here.
+// TxPoolAPI implements the Ethereum JSON-RPC txpool namespace (txpool_status,
+// txpool_content, etc.) on top of the EVM transactions in the mempool.
+//
+// Transactions are grouped by sender and nonce like in Geth. A transaction is
+// "pending" if it is part of the state nonce chain of its sender, meaning every
+// nonce from the committed account nonce up to its own nonce is in the
+// mempool, and "queued" if it comes after a nonce gap. Queued transactions
+// cannot execute until the gap is filled and are evicted on the next recheck
+// (see [evm.ErrMempoolNonceGap]).
 type TxPoolAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend *Backend
 }
 
 // NewImplTxPoolAPI returns a [TxPoolAPI] for JSON-RPC registration.
-func NewImplTxPoolAPI(logger log.Logger) *TxPoolAPI {
+func NewImplTxPoolAPI(logger log.Logger, backend *Backend) *TxPoolAPI {
 	return &TxPoolAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
@@ -35,24 +44,120 @@ func (api *TxPoolAPI) Content() (
 		"pending": make(map[string]map[string]*rpc.EthTxJsonRPC),
 		"queued":  make(map[string]map[string]*rpc.EthTxJsonRPC),
 	}
+	senders, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+	for _, sender := range senders {
+		if len(sender.Pending) > 0 {
+			content["pending"][sender.Sender.Hex()] = api.rpcTxsByNonce(sender.Pending)
+		}
+		if len(sender.Queued) > 0 {
+			content["queued"][sender.Sender.Hex()] = api.rpcTxsByNonce(sender.Queued)
+		}
+	}
+	return content, nil
+}
+
+// ContentFrom returns the transactions contained within the transaction pool
+// that were sent from the given address.
+func (api *TxPoolAPI) ContentFrom(address gethcommon.Address) (
+	map[string]map[string]*rpc.EthTxJsonRPC, error,
+) {
+	api.logger.Debug("txpool_contentFrom", "address", address)
+	content := map[string]map[string]*rpc.EthTxJsonRPC{
+		"pending": make(map[string]*rpc.EthTxJsonRPC),
+		"queued":  make(map[string]*rpc.EthTxJsonRPC),
+	}
+	senders, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+	for _, sender := range senders {
+		if sender.Sender != address {
+			continue
+		}
+		content["pending"] = api.rpcTxsByNonce(sender.Pending)
+		content["queued"] = api.rpcTxsByNonce(sender.Queued)
+	}
 	return content, nil
 }
 
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *TxPoolAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+	senders, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+	for _, sender := range senders {
+		if len(sender.Pending) > 0 {
+			content["pending"][sender.Sender.Hex()] = inspectTxsByNonce(sender.Pending)
+		}
+		if len(sender.Queued) > 0 {
+			content["queued"][sender.Sender.Hex()] = inspectTxsByNonce(sender.Queued)
+		}
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *TxPoolAPI) Status() map[string]hexutil.Uint {
+func (api *TxPoolAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+	senders, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+	var pending, queued int
+	for _, sender := range senders {
+		pending += len(sender.Pending)
+		queued += len(sender.Queued)
+	}
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(pending),
+		"queued":  hexutil.Uint(queued),
+	}, nil
+}
+
+// rpcTxsByNonce formats transactions that are not in a block yet, keyed by
+// their decimal nonce.
+func (api *TxPoolAPI) rpcTxsByNonce(msgs []*evm.MsgEthereumTx) map[string]*rpc.EthTxJsonRPC {
+	txs := make(map[string]*rpc.EthTxJsonRPC, len(msgs))
+	for _, msg := range msgs {
+		// use zero block values since it's not included in a block yet
+		rpcTx := rpc.NewRPCTxFromMsgEthTx(
+			msg,
+			gethcommon.Hash{},
+			uint64(0),
+			uint64(0),
+			nil,
+			api.backend.chainID,
+		)
+		txs[fmt.Sprintf("%d", msg.AsTransaction().Nonce())] = rpcTx
+	}
+	return txs
+}
+
+// inspectTxsByNonce summarizes transactions in the format of Geth's
+// txpool_inspect, keyed by their decimal nonce.
+func inspectTxsByNonce(msgs []*evm.MsgEthereumTx) map[string]string {
+	txs := make(map[string]string, len(msgs))
+	for _, msg := range msgs {
+		tx := msg.AsTransaction()
+		summary := fmt.Sprintf(
+			"contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice(),
+		)
+		if to := tx.To(); to != nil {
+			summary = fmt.Sprintf(
+				"%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice(),
+			)
+		}
+		txs[fmt.Sprintf("%d", tx.Nonce())] = summary
 	}
+	return txs
 }
@@ -0,0 +1,41 @@
+package rpcapi_test
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (s *BackendSuite) TestTxPool() {
+	testMutex.Lock()
+	defer testMutex.Unlock()
+
+	nonce := s.getCurrentNonce(s.evmSenderEthAddr)
+	txHash := s.SendNibiViaEthTransfer(recipient, amountToSend, false /*waitForNextBlock*/)
+	nonceKey := fmt.Sprintf("%d", nonce)
+
+	// The transfer is either still in the mempool or already in a block.
+	content, err := s.cli.EvmRpc.TxPool.ContentFrom(s.evmSenderEthAddr)
+	s.Require().NoError(err)
+	s.Empty(content["queued"])
+	if rpcTx, inPool := content["pending"][nonceKey]; inPool {
+		s.Equal(txHash, rpcTx.Hash)
+		s.Equal(s.evmSenderEthAddr, rpcTx.From)
+
+		inspect, err := s.cli.EvmRpc.TxPool.Inspect()
+		s.Require().NoError(err)
+		summary := inspect["pending"][s.evmSenderEthAddr.Hex()][nonceKey]
+		s.True(strings.HasPrefix(summary, recipient.Hex()), summary)
+
+		status, err := s.cli.EvmRpc.TxPool.Status()
+		s.Require().NoError(err)
+		s.GreaterOrEqual(uint(status["pending"]), uint(1))
+	}
+
+	_, _, receipt, err := WaitForReceipt(s, txHash)
+	s.Require().NoError(err)
+	s.Require().NotNil(receipt)
+
+	content, err = s.cli.EvmRpc.TxPool.ContentFrom(s.evmSenderEthAddr)
+	s.Require().NoError(err)
+	s.NotContains(content["pending"], nonceKey)
+}
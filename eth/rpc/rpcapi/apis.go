@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/evm"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 )
@@ -28,6 +29,7 @@ const (
 	NamespaceNet    = "net"
 	NamespaceTxPool = "txpool"
 	NamespaceDebug  = "debug"
+	NamespaceTrace  = "trace"
 
 	apiVersion = "1.0"
 )
@@ -39,6 +41,7 @@ type APICreator = func(
 	tendermintWebsocketClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer eth.EVMTxIndexer,
+	evmMempool *evm.Mempool,
 ) []rpc.API
 
 // apiCreators defines the JSON-RPC API namespaces.
@@ -51,9 +54,10 @@ func init() {
 			tmWSClient *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer eth.EVMTxIndexer,
+			_ *evm.Mempool,
 		) []rpc.API {
 			evmBackend := NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
-			return []rpc.API{
+			apis := []rpc.API{
 				{
 					Namespace: NamespaceEth,
 					Version:   apiVersion,
@@ -67,8 +71,25 @@ func init() {
 					Public:    true,
 				},
 			}
+
+			bundlerCfg := evmBackend.cfg.JSONRPC.Bundler
+			if !bundlerCfg.Enable {
+				return apis
+			}
+			pool, err := NewUserOpPool(evmBackend, ctx.Logger, bundlerCfg)
+			if err != nil {
+				ctx.Logger.Error("failed to start the ERC-4337 bundler", "error", err.Error())
+				return apis
+			}
+			pool.Start(evmBackend.ctx)
+			return append(apis, rpc.API{
+				Namespace: NamespaceEth,
+				Version:   apiVersion,
+				Service:   NewImplUserOpAPI(ctx.Logger, pool),
+				Public:    true,
+			})
 		},
-		NamespaceWeb3: func(*server.Context, client.Context, *rpcclient.WSClient, bool, eth.EVMTxIndexer) []rpc.API {
+		NamespaceWeb3: func(*server.Context, client.Context, *rpcclient.WSClient, bool, eth.EVMTxIndexer, *evm.Mempool) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NamespaceWeb3,
@@ -78,7 +99,7 @@ func init() {
 				},
 			}
 		},
-		NamespaceNet: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ eth.EVMTxIndexer) []rpc.API {
+		NamespaceNet: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ eth.EVMTxIndexer, _ *evm.Mempool) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NamespaceNet,
@@ -88,12 +109,20 @@ func init() {
 				},
 			}
 		},
-		NamespaceTxPool: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ eth.EVMTxIndexer) []rpc.API {
+		NamespaceTxPool: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer eth.EVMTxIndexer,
+			evmMempool *evm.Mempool,
+		) []rpc.API {
+			evmBackend := NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend.evmMempool = evmMempool
 			return []rpc.API{
 				{
 					Namespace: NamespaceTxPool,
 					Version:   apiVersion,
-					Service:   NewImplTxPoolAPI(ctx.Logger),
+					Service:   NewImplTxPoolAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
@@ -103,6 +132,7 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer eth.EVMTxIndexer,
+			_ *evm.Mempool,
 		) []rpc.API {
 			evmBackend := NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
@@ -114,22 +144,41 @@ func init() {
 				},
 			}
 		},
+		NamespaceTrace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer eth.EVMTxIndexer,
+			_ *evm.Mempool,
+		) []rpc.API {
+			evmBackend := NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: NamespaceTrace,
+					Version:   apiVersion,
+					Service:   NewImplTraceAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
-// GetRPCAPIs returns the list of all APIs
+// GetRPCAPIs returns the list of all APIs. The node-local "evmMempool" is nil
+// unless the JSON-RPC server runs in-process with the node.
 func GetRPCAPIs(ctx *server.Context,
 	clientCtx client.Context,
 	tmWSClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer eth.EVMTxIndexer,
+	evmMempool *evm.Mempool,
 	selectedAPIs []string,
 ) []rpc.API {
 	var apis []rpc.API
 
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
+			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer, evmMempool)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
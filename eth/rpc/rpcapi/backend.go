@@ -13,6 +13,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/app/server/config"
 	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/evm"
 )
 
 // Backend implements implements the functionality shared within ethereum namespaces
@@ -28,6 +29,9 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	evmTxIndexer        eth.EVMTxIndexer
+	// evmMempool is the node-local EVM mempool, if the JSON-RPC server runs
+	// in-process with the node. See [Backend.TxPoolContent].
+	evmMempool *evm.Mempool
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -238,6 +238,17 @@ func (b *Backend) isZeroGasJsonTxArgsLatest(args evm.JsonTxArgs) bool {
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
 func (b *Backend) EstimateGas(
 	args evm.JsonTxArgs, blockNrOptional *rpc.BlockNumber,
+) (hexutil.Uint64, error) {
+	return b.EstimateGasWithOverrides(args, blockNrOptional, nil, nil)
+}
+
+// EstimateGasWithOverrides is [Backend.EstimateGas] with state and block
+// overrides applied before each execution of the estimation.
+func (b *Backend) EstimateGasWithOverrides(
+	args evm.JsonTxArgs,
+	blockNrOptional *rpc.BlockNumber,
+	overrides *rpc.StateOverride,
+	blockOverrides *rpc.BlockOverrides,
 ) (hexutil.Uint64, error) {
 	blockNr := rpc.EthPendingBlockNumber
 	if blockNrOptional != nil {
@@ -261,6 +272,9 @@ func (b *Backend) EstimateGas(
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 	}
+	if err := setCallOverrides(&req, overrides, blockOverrides); err != nil {
+		return 0, err
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -276,6 +290,41 @@ func (b *Backend) EstimateGas(
 // estimated gas used on the operation or an error if fails.
 func (b *Backend) DoCall(
 	args evm.JsonTxArgs, blockNr rpc.BlockNumber,
+) (*evm.MsgEthereumTxResponse, error) {
+	return b.DoCallWithOverrides(args, blockNr, nil, nil)
+}
+
+// DoCallWithOverrides is [Backend.DoCall] with state and block overrides
+// applied before the call.
+func (b *Backend) DoCallWithOverrides(
+	args evm.JsonTxArgs,
+	blockNr rpc.BlockNumber,
+	overrides *rpc.StateOverride,
+	blockOverrides *rpc.BlockOverrides,
+) (*evm.MsgEthereumTxResponse, error) {
+	res, err := b.doCallNoFail(args, blockNr, overrides, blockOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Failed() {
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			return nil, evm.NewRevertError(res.Ret)
+		}
+		return nil, status.Error(codes.Internal, res.VmError)
+	}
+
+	return res, nil
+}
+
+// doCallNoFail is [Backend.DoCall] without the conversion of failed executions
+// into errors, so that callers can decode the raw revert data, for example the
+// custom errors that the ERC-4337 EntryPoint uses to return simulation results.
+func (b *Backend) doCallNoFail(
+	args evm.JsonTxArgs,
+	blockNr rpc.BlockNumber,
+	overrides *rpc.StateOverride,
+	blockOverrides *rpc.BlockOverrides,
 ) (*evm.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
@@ -293,6 +342,9 @@ func (b *Backend) DoCall(
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 	}
+	if err := setCallOverrides(&req, overrides, blockOverrides); err != nil {
+		return nil, err
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -313,19 +365,200 @@ func (b *Backend) DoCall(
 	// this makes sure resources are cleaned up.
 	defer cancel()
 
-	res, err := b.queryClient.EthCall(ctx, &req)
+	return b.queryClient.EthCall(ctx, &req)
+}
+
+// CreateAccessList returns the access list of the call in args at the given
+// block. Like go-ethereum, it traces the call with the access list tracer
+// until the access list stops changing, since adding an access list to a call
+// can change the code paths it takes, and then executes the call with the
+// final access list to get the gas used.
+func (b *Backend) CreateAccessList(
+	args evm.JsonTxArgs, blockNrOrHash rpc.BlockNumberOrHash,
+) (*rpc.AccessListResult, error) {
+	blockNr, err := b.BlockNumberFromTendermint(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
+	if args.Nonce == nil {
+		nonce, err := b.GetTransactionCount(args.GetFrom(), blockNr)
+		if err != nil {
+			return nil, err
+		}
+		args.Nonce = nonce
+	}
+	if args.Gas == nil {
+		gas := hexutil.Uint64(b.RPCGasCap())
+		args.Gas = &gas
+	}
+
+	accessList := gethcore.AccessList{}
+	if args.AccessList != nil {
+		accessList = *args.AccessList
+	}
+	traceConfig := &evm.TraceConfig{Tracer: evm.TracerAccessList}
+	for {
+		args.AccessList = &accessList
+		traceResult, err := b.TraceCall(args, blockNr, traceConfig)
+		if err != nil {
+			return nil, err
+		}
+		var tracedList gethcore.AccessList
+		if err := json.Unmarshal(traceResult, &tracedList); err != nil {
+			return nil, err
+		}
+		if accessListsEqual(accessList, tracedList) {
+			break
+		}
+		accessList = tracedList
+	}
 
+	res, err := b.doCallNoFail(args, blockNr, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := &rpc.AccessListResult{
+		AccessList: &accessList,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}
 	if res.Failed() {
+		result.Error = res.VmError
 		if res.VmError == vm.ErrExecutionReverted.Error() {
-			return nil, evm.NewRevertError(res.Ret)
+			result.Error = evm.NewRevertError(res.Ret).Error()
 		}
-		return nil, status.Error(codes.Internal, res.VmError)
 	}
+	return result, nil
+}
 
-	return res, nil
+// accessListsEqual returns true if a and b contain the same addresses and
+// storage keys, in any order.
+func accessListsEqual(a, b gethcore.AccessList) bool {
+	toSet := func(al gethcore.AccessList) map[common.Address]map[common.Hash]struct{} {
+		set := make(map[common.Address]map[common.Hash]struct{}, len(al))
+		for _, tuple := range al {
+			if set[tuple.Address] == nil {
+				set[tuple.Address] = make(map[common.Hash]struct{})
+			}
+			for _, key := range tuple.StorageKeys {
+				set[tuple.Address][key] = struct{}{}
+			}
+		}
+		return set
+	}
+	setA, setB := toSet(a), toSet(b)
+	if len(setA) != len(setB) {
+		return false
+	}
+	for addr, keysA := range setA {
+		keysB, ok := setB[addr]
+		if !ok || len(keysA) != len(keysB) {
+			return false
+		}
+		for key := range keysA {
+			if _, ok := keysB[key]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// SimulateV1 executes the simulated blocks of opts on top of the state at
+// blockNr and returns them in the JSON-RPC format of "eth_simulateV1": block
+// fields with the transactions (hashes or objects) and the result of each
+// call.
+func (b *Backend) SimulateV1(
+	opts evm.SimOpts, blockNr rpc.BlockNumber,
+) ([]map[string]any, error) {
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, pkgerrors.New("header not found")
+	}
+
+	req := evm.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	ctx := rpc.NewContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	var simBlocks []evm.SimBlockResult
+	if err := json.Unmarshal(res.Result, &simBlocks); err != nil {
+		return nil, err
+	}
+
+	blocks := make([]map[string]any, len(simBlocks))
+	for i, simBlock := range simBlocks {
+		if blocks[i], err = b.formatSimBlock(simBlock, opts.ReturnFullTransactions); err != nil {
+			return nil, err
+		}
+	}
+	return blocks, nil
+}
+
+// formatSimBlock returns a simulated block in the JSON-RPC block format with
+// the call results under "calls".
+func (b *Backend) formatSimBlock(
+	simBlock evm.SimBlockResult, fullTx bool,
+) (map[string]any, error) {
+	transactions := make([]any, len(simBlock.Transactions))
+	for i, simTx := range simBlock.Transactions {
+		if !fullTx {
+			transactions[i] = simTx.Hash
+			continue
+		}
+		tx := new(gethcore.Transaction)
+		if err := tx.UnmarshalBinary(simTx.Tx); err != nil {
+			return nil, err
+		}
+		msg := new(evm.MsgEthereumTx)
+		if err := msg.FromEthereumTx(tx); err != nil {
+			return nil, err
+		}
+		rpcTx := rpc.NewRPCTxFromMsgEthTx(
+			msg,
+			simBlock.Hash,
+			uint64(simBlock.Number),
+			uint64(i),
+			simBlock.BaseFeePerGas.ToInt(),
+			b.chainID,
+		)
+		// The simulated txs are unsigned, so the sender can't be recovered.
+		rpcTx.From = simTx.From
+		transactions[i] = rpcTx
+	}
+
+	return map[string]any{
+		"number":        simBlock.Number,
+		"hash":          simBlock.Hash,
+		"parentHash":    simBlock.ParentHash,
+		"timestamp":     simBlock.Timestamp,
+		"gasLimit":      simBlock.GasLimit,
+		"gasUsed":       simBlock.GasUsed,
+		"miner":         simBlock.Miner,
+		"baseFeePerGas": simBlock.BaseFeePerGas,
+		"transactions":  transactions,
+		"calls":         simBlock.Calls,
+	}, nil
 }
 
 // GasPrice returns the current "suggested" gas price. Paid transactions
@@ -364,3 +597,29 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 func (b *Backend) ClientCtx() client.Context {
 	return b.clientCtx
 }
+
+// setCallOverrides JSON-encodes the state and block overrides of an
+// "eth_call" or "eth_estimateGas" into the request.
+func setCallOverrides(
+	req *evm.EthCallRequest,
+	overrides *rpc.StateOverride,
+	blockOverrides *rpc.BlockOverrides,
+) (err error) {
+	if overrides != nil && len(*overrides) > 0 {
+		if err := overrides.Validate(); err != nil {
+			return err
+		}
+		if req.StateOverrides, err = json.Marshal(overrides); err != nil {
+			return err
+		}
+	}
+	if blockOverrides != nil {
+		if err := blockOverrides.Validate(); err != nil {
+			return err
+		}
+		if req.BlockOverrides, err = json.Marshal(blockOverrides); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
 	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/evm/embeds"
 )
 
 func (s *BackendSuite) TestSetTxDefaults() {
@@ -103,10 +104,39 @@ func (s *BackendSuite) TestDoCall() {
 			BlockNumber: &blockNumber,
 		},
 		nil,
+		nil,
 	)
 	s.Require().ErrorContains(err, "insufficient balance for transfer")
 }
 
+func (s *BackendSuite) TestCreateAccessList() {
+	contractAddr := s.SuccessfulTxDeployContract().Receipt.ContractAddress
+	s.Require().NotNil(contractAddr)
+	input, err := embeds.SmartContract_TestERC20.ABI.Pack("balanceOf", s.evmSenderEthAddr)
+	s.Require().NoError(err)
+
+	blockNumber := rpc.EthLatestBlockNumber
+	result, err := s.cli.EvmRpc.Eth.CreateAccessList(
+		evm.JsonTxArgs{
+			From:  &s.evmSenderEthAddr,
+			To:    contractAddr,
+			Input: (*hexutil.Bytes)(&input),
+		},
+		&rpc.BlockNumberOrHash{BlockNumber: &blockNumber},
+	)
+	s.Require().NoError(err)
+	s.Require().Empty(result.Error)
+	s.Require().Greater(uint64(result.GasUsed), gethparams.TxGas)
+
+	// The balance slot of the sender is read from the contract, which is the
+	// recipient and therefore left out of the access list.
+	s.Require().NotNil(result.AccessList)
+	for _, tuple := range *result.AccessList {
+		s.NotEqual(*contractAddr, tuple.Address)
+		s.NotContains(evm.PRECOMPILE_ADDRS, tuple.Address)
+	}
+}
+
 func (s *BackendSuite) TestGasPrice() {
 	gasPrice, err := s.cli.EvmRpc.Eth.GasPrice()
 	s.Require().NoError(err)
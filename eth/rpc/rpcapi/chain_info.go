@@ -2,11 +2,14 @@
 package rpcapi
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
+	"sort"
 
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethmath "github.com/ethereum/go-ethereum/common/math"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
@@ -28,11 +31,11 @@ func (b *Backend) ChainID() *hexutil.Big {
 
 // ChainConfig returns the latest ethereum chain configuration
 func (b *Backend) ChainConfig() *params.ChainConfig {
-	_, err := b.queryClient.Params(b.ctx, &evm.QueryParamsRequest{})
+	res, err := b.queryClient.Params(b.ctx, &evm.QueryParamsRequest{})
 	if err != nil {
 		return nil
 	}
-	return evm.EthereumConfig(b.chainID)
+	return res.Params.EthereumConfig(b.chainID)
 }
 
 // BaseFeeWei returns the EIP-1559 base fee.
@@ -79,6 +82,160 @@ func (b *Backend) PendingTransactions() ([]*sdk.Tx, error) {
 	return result, nil
 }
 
+// maxUnconfirmedTxs is the largest page size of the CometBFT "unconfirmed_txs"
+// RPC, which has no further pages.
+const maxUnconfirmedTxs = 100
+
+// TxPoolSender holds the EVM transactions of one sender in the mempool, split
+// at the committed state nonce of the sender with
+// [evm.MempoolSender.SplitByStateNonce]. Both lists are in ascending nonce
+// order.
+type TxPoolSender struct {
+	Sender  gethcommon.Address
+	Pending []*evm.MsgEthereumTx
+	Queued  []*evm.MsgEthereumTx
+}
+
+// TxPoolContent returns the EVM transactions in the mempool grouped by sender,
+// in ascending address order.
+//
+// When the JSON-RPC server runs in-process with the node, the transactions
+// come from the per-sender slots of the node-local [evm.Mempool], which holds
+// every EVM transaction in the mempool. Otherwise, they come from the CometBFT
+// "unconfirmed_txs" RPC, which returns at most [maxUnconfirmedTxs]
+// transactions, and an error is returned if the mempool holds more, since the
+// missing transactions would make the pending ones look queued.
+func (b *Backend) TxPoolContent() ([]TxPoolSender, error) {
+	mempoolSenders, err := b.mempoolSenders()
+	if err != nil {
+		return nil, err
+	}
+
+	txDecoder := b.clientCtx.TxConfig.TxDecoder()
+	decodeTx := func(tx evm.MempoolTx) (*evm.MsgEthereumTx, error) {
+		ethMsg, err := decodeMempoolEthTx(txDecoder, tx.TxBytes)
+		if err != nil {
+			return nil, fmt.Errorf("decode mempool tx %s: %w", tx.EVMHash.Hex(), err)
+		}
+		return ethMsg, nil
+	}
+
+	result := make([]TxPoolSender, 0, len(mempoolSenders))
+	for _, senderEntry := range mempoolSenders {
+		stateNonce, err := b.getAccountNonce(senderEntry.Sender, false, 0, b.logger)
+		if err != nil {
+			return nil, err
+		}
+		pending, queued := senderEntry.SplitByStateNonce(stateNonce)
+		if len(pending) == 0 && len(queued) == 0 {
+			// Every transaction is already included in a block.
+			continue
+		}
+		poolSender := TxPoolSender{Sender: senderEntry.Sender}
+		for _, tx := range pending {
+			ethMsg, err := decodeTx(tx)
+			if err != nil {
+				return nil, err
+			}
+			poolSender.Pending = append(poolSender.Pending, ethMsg)
+		}
+		for _, tx := range queued {
+			ethMsg, err := decodeTx(tx)
+			if err != nil {
+				return nil, err
+			}
+			poolSender.Queued = append(poolSender.Queued, ethMsg)
+		}
+		result = append(result, poolSender)
+	}
+	return result, nil
+}
+
+// mempoolSenders returns the EVM transactions of the mempool grouped by
+// sender, in ascending address order and ascending nonce order within each
+// sender. See [Backend.TxPoolContent].
+func (b *Backend) mempoolSenders() ([]evm.MempoolSender, error) {
+	if b.evmMempool != nil {
+		return b.evmMempool.Snapshot(), nil
+	}
+
+	mc, ok := b.clientCtx.Client.(cmtrpcclient.MempoolClient)
+	if !ok {
+		return nil, pkgerrors.New("invalid rpc client")
+	}
+	limit := maxUnconfirmedTxs
+	res, err := mc.UnconfirmedTxs(b.ctx, &limit)
+	if err != nil {
+		return nil, err
+	}
+	if res.Total > len(res.Txs) {
+		return nil, fmt.Errorf(
+			"mempool holds %d txs, more than the %d visible to the CometBFT RPC: "+
+				"run the JSON-RPC server in-process with the node to read the full mempool",
+			res.Total, len(res.Txs),
+		)
+	}
+
+	var (
+		txDecoder = b.clientCtx.TxConfig.TxDecoder()
+		senders   = make(map[gethcommon.Address]*evm.MempoolSender)
+	)
+	for _, txBz := range res.Txs {
+		ethMsg, err := decodeMempoolEthTx(txDecoder, txBz)
+		if err != nil {
+			continue
+		}
+		sender, err := ethMsg.GetSender(b.chainID)
+		if err != nil {
+			continue
+		}
+		ethTx := ethMsg.AsTransaction()
+		senderEntry, found := senders[sender]
+		if !found {
+			senderEntry = &evm.MempoolSender{Sender: sender, MinNonce: ethTx.Nonce()}
+			senders[sender] = senderEntry
+		}
+		senderEntry.MinNonce = min(senderEntry.MinNonce, ethTx.Nonce())
+		senderEntry.Txs = append(senderEntry.Txs, evm.MempoolTx{
+			TxBytes: txBz,
+			TxKey:   txBz.Key(),
+			EVMHash: ethTx.Hash(),
+			Sender:  sender,
+			Nonce:   ethTx.Nonce(),
+		})
+	}
+
+	result := make([]evm.MempoolSender, 0, len(senders))
+	for _, senderEntry := range senders {
+		sort.Slice(senderEntry.Txs, func(i, j int) bool {
+			return senderEntry.Txs[i].Nonce < senderEntry.Txs[j].Nonce
+		})
+		result = append(result, *senderEntry)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return bytes.Compare(result[i].Sender[:], result[j].Sender[:]) < 0
+	})
+	return result, nil
+}
+
+// decodeMempoolEthTx decodes the standard EVM transaction message of the
+// mempool tx bytes "txBz", with its hash set.
+func decodeMempoolEthTx(txDecoder sdk.TxDecoder, txBz []byte) (*evm.MsgEthereumTx, error) {
+	tx, err := txDecoder(txBz)
+	if err != nil {
+		return nil, err
+	}
+	if !evm.IsEthTx(tx) {
+		return nil, pkgerrors.New("not an EVM tx")
+	}
+	ethMsg, err := evm.RequireStandardEVMTxMsg(tx)
+	if err != nil {
+		return nil, err
+	}
+	ethMsg.Hash = ethMsg.AsTransaction().Hash().Hex()
+	return ethMsg, nil
+}
+
 // FeeHistory returns data relevant for fee estimation based on the specified range of blocks.
 func (b *Backend) FeeHistory(
 	userBlockCount gethmath.HexOrDecimal64, // number blocks to fetch, maximum is 100
@@ -2,12 +2,18 @@
 package rpcapi
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/client"
+
 	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/evm"
 )
@@ -37,26 +43,80 @@ func (b *Backend) Accounts() ([]gethcommon.Address, error) {
 // yet received the latest block headers from its peers. In case it is synchronizing:
 // - startingBlock: block number this node started to synchronize from
 // - currentBlock:  block number this node is currently importing
-// - highestBlock:  block number of the highest block header this node has received from peers
-// - pulledStates:  number of state entries processed until now
-// - knownStates:   number of known state entries that still need to be pulled
+// - highestBlock:  highest block number known from the peers of this node
 func (b *Backend) Syncing() (any, error) {
-	status, err := b.clientCtx.Client.Status(b.ctx)
+	result, err := querySyncingResult(b.ctx, b.clientCtx.Client)
 	if err != nil {
 		return false, err
 	}
-
-	if !status.SyncInfo.CatchingUp {
+	if !result.Syncing {
 		return false, nil
 	}
+	return result.Status, nil
+}
+
+// SyncProgress is the sync progress reported by "eth_syncing" and the
+// "syncing" subscription. The state sync fields of Geth ("pulledStates",
+// "knownStates") do not apply.
+type SyncProgress struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
 
-	return map[string]any{
-		"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
-		"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
-		// "highestBlock":  nil, // NA
-		// "pulledStates":  nil, // NA
-		// "knownStates":   nil, // NA
-	}, nil
+// SyncingResult is the payload of an `eth_subscribe("syncing")` notification,
+// in the format of Geth.
+type SyncingResult struct {
+	Syncing bool         `json:"syncing"`
+	Status  SyncProgress `json:"status"`
+}
+
+// NewSyncingResult returns the sync status of a node from its CometBFT sync
+// info and the consensus states of its peers. The node is syncing while
+// CometBFT is catching up with block sync or state sync. The highest block is
+// the last block committed by the most advanced peer, and at least the latest
+// block of the node.
+func NewSyncingResult(syncInfo coretypes.SyncInfo, peers []coretypes.PeerStateInfo) SyncingResult {
+	highestBlock := syncInfo.LatestBlockHeight
+	for _, peer := range peers {
+		var peerState struct {
+			RoundState struct {
+				// Height is the height the peer is working on, one above its
+				// last committed block.
+				Height int64 `json:"height,string"`
+			} `json:"round_state"`
+		}
+		if err := json.Unmarshal(peer.PeerState, &peerState); err != nil {
+			continue
+		}
+		highestBlock = max(highestBlock, peerState.RoundState.Height-1)
+	}
+	return SyncingResult{
+		Syncing: syncInfo.CatchingUp,
+		Status: SyncProgress{
+			StartingBlock: hexutil.Uint64(syncInfo.EarliestBlockHeight), // #nosec G115
+			CurrentBlock:  hexutil.Uint64(syncInfo.LatestBlockHeight),   // #nosec G115
+			HighestBlock:  hexutil.Uint64(highestBlock),                 // #nosec G115
+		},
+	}
+}
+
+// querySyncingResult queries the sync status of the node. While the node is
+// catching up, it also queries the consensus states of its peers for the
+// highest block. Their heights are best effort: the sync status is returned
+// without them if the client cannot dump the consensus state.
+func querySyncingResult(ctx context.Context, rpcClient client.TendermintRPC) (SyncingResult, error) {
+	status, err := rpcClient.Status(ctx)
+	if err != nil {
+		return SyncingResult{}, err
+	}
+	var peers []coretypes.PeerStateInfo
+	if nc, ok := rpcClient.(cmtrpcclient.NetworkClient); ok && status.SyncInfo.CatchingUp {
+		if consensusState, err := nc.DumpConsensusState(ctx); err == nil {
+			peers = consensusState.Peers
+		}
+	}
+	return NewSyncingResult(status.SyncInfo, peers), nil
 }
 
 // RPCGasCap is the global gas cap for eth-call variants.
@@ -1,10 +1,16 @@
 package rpcapi_test
 
 import (
+	"encoding/json"
+	"testing"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/v2/app/server/config"
 	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc/rpcapi"
 	"github.com/NibiruChain/nibiru/v2/x/nutil"
 )
 
@@ -21,6 +27,30 @@ func (s *BackendSuite) TestSyncing() {
 	s.Require().False(syncing.(bool))
 }
 
+func TestNewSyncingResult(t *testing.T) {
+	peers := []coretypes.PeerStateInfo{
+		{PeerState: json.RawMessage(`{"round_state": {"height": "1000", "round": 0}}`)},
+		{PeerState: json.RawMessage(`{"round_state": {"height": "1300", "round": 0}}`)},
+		{PeerState: json.RawMessage(`invalid`)},
+	}
+	result := rpcapi.NewSyncingResult(coretypes.SyncInfo{
+		EarliestBlockHeight: 1,
+		LatestBlockHeight:   420,
+		CatchingUp:          true,
+	}, peers)
+	resultJson, err := json.Marshal(result)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"syncing": true,
+		"status": {"startingBlock": "0x1", "currentBlock": "0x1a4", "highestBlock": "0x513"}
+	}`, string(resultJson))
+
+	// Without peers ahead, the highest block is the latest block of the node.
+	result = rpcapi.NewSyncingResult(coretypes.SyncInfo{LatestBlockHeight: 420}, nil)
+	require.False(t, result.Syncing)
+	require.EqualValues(t, 420, result.Status.HighestBlock)
+}
+
 func (s *BackendSuite) TestRPCGasCap() {
 	s.Require().Equal(config.DefaultConfig().JSONRPC.GasCap, s.backend.RPCGasCap())
 }
@@ -5,16 +5,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"time"
 
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
 	pkgerrors "github.com/pkg/errors"
 
 	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
 	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/x/nutil/set"
 )
 
 // TraceTransaction returns the structured logs created during the execution of EVM
@@ -144,9 +148,7 @@ func (b *Backend) TraceBlock(height rpc.BlockNumber,
 	block *tmrpctypes.ResultBlock,
 ) ([]*evm.TxTraceResult, error) {
 	blockTxs := block.Block.Txs
-	txsLength := len(blockTxs)
-
-	if txsLength == 0 {
+	if len(blockTxs) == 0 {
 		// If there are no transactions return empty array
 		return []*evm.TxTraceResult{}, nil
 	}
@@ -171,37 +173,88 @@ func (b *Backend) TraceBlock(height rpc.BlockNumber,
 		}
 	}
 
-	// minus one to get the context at the beginning of the block
-	contextHeight := max(height-1, 1) // 0 is a special value for `ContextWithHeight`.
-	ctxWithHeight := rpc.NewContextWithHeight(int64(contextHeight))
+	return b.traceBlockTxs(&evm.QueryTraceBlockRequest{
+		Txs:             txsMessages,
+		TraceConfig:     config,
+		BlockNumber:     block.Block.Height,
+		BlockTime:       block.Block.Time,
+		BlockHash:       gethcommon.Bytes2Hex(block.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+	}, block.Block.Height)
+}
+
+// TraceEthBlock traces the transactions of an Ethereum block, such as one
+// decoded from the RLP blob passed to "debug_traceBlock", on top of the state
+// at the end of its parent block. The block itself does not need to be part
+// of the chain.
+func (b *Backend) TraceEthBlock(
+	ethBlock *gethcore.Block,
+	config *evm.TraceConfig,
+) ([]*evm.TxTraceResult, error) {
+	if !ethBlock.Number().IsInt64() || ethBlock.Number().Sign() <= 0 {
+		return nil, pkgerrors.New("genesis is not traceable")
+	}
+	height := ethBlock.Number().Int64()
+
+	// The parent block provides the state and the consensus params.
+	parent, err := b.TendermintBlockByNumber(rpc.BlockNumber(height - 1))
+	if err != nil {
+		return nil, fmt.Errorf("parent of block %d not found: %w", height, err)
+	}
+
+	txsMessages := make([]*evm.MsgEthereumTx, len(ethBlock.Transactions()))
+	for i, tx := range ethBlock.Transactions() {
+		ethMsg := new(evm.MsgEthereumTx)
+		if err := ethMsg.FromEthereumTx(tx); err != nil {
+			return nil, fmt.Errorf("invalid transaction %s: %w", tx.Hash().Hex(), err)
+		}
+		txsMessages[i] = ethMsg
+	}
+
+	header := ethBlock.Header()
+	return b.traceBlockTxs(&evm.QueryTraceBlockRequest{
+		Txs:             txsMessages,
+		TraceConfig:     config,
+		BlockNumber:     height,
+		BlockTime:       time.Unix(int64(header.Time), 0).UTC(), // #nosec G115
+		BlockHash:       gethcommon.Bytes2Hex(ethBlock.Hash().Bytes()),
+		ProposerAddress: sdk.ConsAddress(header.Coinbase.Bytes()),
+	}, parent.Block.Height)
+}
+
+// traceBlockTxs runs the "TraceBlock" query for the given request in the
+// context at the beginning of the block. The consensus params at
+// "paramsHeight" set the block gas limit.
+func (b *Backend) traceBlockTxs(
+	traceBlockRequest *evm.QueryTraceBlockRequest,
+	paramsHeight int64,
+) ([]*evm.TxTraceResult, error) {
+	if len(traceBlockRequest.Txs) == 0 {
+		return []*evm.TxTraceResult{}, nil
+	}
 
 	nc, ok := b.clientCtx.Client.(cmtrpcclient.NetworkClient)
 	if !ok {
 		return nil, pkgerrors.New("invalid rpc client")
 	}
 
-	cp, err := nc.ConsensusParams(b.ctx, &block.Block.Height)
+	cp, err := nc.ConsensusParams(b.ctx, &paramsHeight)
 	if err != nil {
 		return nil, err
 	}
+	traceBlockRequest.ChainId = b.chainID.Int64()
+	traceBlockRequest.BlockMaxGas = cp.ConsensusParams.Block.MaxGas
 
-	traceBlockRequest := &evm.QueryTraceBlockRequest{
-		Txs:             txsMessages,
-		TraceConfig:     config,
-		BlockNumber:     block.Block.Height,
-		BlockTime:       block.Block.Time,
-		BlockHash:       gethcommon.Bytes2Hex(block.BlockID.Hash),
-		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
-		ChainId:         b.chainID.Int64(),
-		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
-	}
+	// minus one to get the context at the beginning of the block
+	contextHeight := max(traceBlockRequest.BlockNumber-1, 1) // 0 is a special value for `ContextWithHeight`.
+	ctxWithHeight := rpc.NewContextWithHeight(contextHeight)
 
 	res, err := b.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
 	if err != nil {
 		return nil, err
 	}
 
-	decodedResults := make([]*evm.TxTraceResult, txsLength)
+	decodedResults := make([]*evm.TxTraceResult, len(traceBlockRequest.Txs))
 	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
 		return nil, err
 	}
@@ -209,6 +262,69 @@ func (b *Backend) TraceBlock(height rpc.BlockNumber,
 	return decodedResults, nil
 }
 
+// BlockTraceResult is the trace of a single block streamed by
+// "debug_traceChain".
+type BlockTraceResult struct {
+	Block  hexutil.Uint64       `json:"block"`
+	Hash   gethcommon.Hash      `json:"hash"`
+	Traces []*evm.TxTraceResult `json:"traces"`
+}
+
+// TraceChain traces the blocks from "start" to "end", excluding "start" and
+// including "end", and returns one result per block. The range may span at
+// most [Backend.RPCBlockRangeCap] blocks.
+func (b *Backend) TraceChain(
+	start, end rpc.BlockNumber,
+	config *evm.TraceConfig,
+) ([]*BlockTraceResult, error) {
+	from, err := b.resolveBlockNumber(start)
+	if err != nil {
+		return nil, err
+	}
+	to, err := b.resolveBlockNumber(end)
+	if err != nil {
+		return nil, err
+	}
+	if from >= to {
+		return nil, fmt.Errorf("end block #%d needs to come after start block #%d", to, from)
+	}
+	if blockRangeCap := int64(b.RPCBlockRangeCap()); to-from > blockRangeCap {
+		return nil, fmt.Errorf(
+			"block range %d exceeds the block range cap %d", to-from, blockRangeCap)
+	}
+
+	results := make([]*BlockTraceResult, 0, to-from)
+	for height := from + 1; height <= to; height++ {
+		resBlock, err := b.TendermintBlockByNumber(rpc.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		traces, err := b.TraceBlock(rpc.BlockNumber(height), config, resBlock)
+		if err != nil {
+			return nil, fmt.Errorf("failed to trace block %d: %w", height, err)
+		}
+		results = append(results, &BlockTraceResult{
+			Block:  hexutil.Uint64(height), // #nosec G115 -- height is positive
+			Hash:   gethcommon.BytesToHash(resBlock.Block.Hash()),
+			Traces: traces,
+		})
+	}
+	return results, nil
+}
+
+// resolveBlockNumber returns the height of "blockNum", resolving the "latest"
+// and "pending" tags to the latest block.
+func (b *Backend) resolveBlockNumber(blockNum rpc.BlockNumber) (int64, error) {
+	if blockNum >= 0 {
+		return int64(blockNum), nil
+	}
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return 0, err
+	}
+	return int64(latest), nil // #nosec G115 -- checked for int overflow already
+}
+
 // TraceCall implements eth debug_traceCall method which lets you run an eth_call
 // within the context of the given block execution using the final state of parent block as the base.
 // Method returns the structured logs created during the execution of EVM.
@@ -258,3 +374,165 @@ func (b *Backend) TraceCall(
 	}
 	return traceResult, nil
 }
+
+// traceConfigFlatCall returns the trace config of the Parity/OpenEthereum
+// "trace" namespace, which uses geth's "flatCallTracer".
+func traceConfigFlatCall() *evm.TraceConfig {
+	return &evm.TraceConfig{Tracer: "flatCallTracer"}
+}
+
+// decodeParityTraces decodes the result of the "flatCallTracer".
+func decodeParityTraces(traceResult []byte) ([]rpc.ParityTrace, error) {
+	traces := []rpc.ParityTrace{}
+	if err := json.Unmarshal(traceResult, &traces); err != nil {
+		return nil, fmt.Errorf("failed to decode flat call traces: %w", err)
+	}
+	return traces, nil
+}
+
+// ParityTraceTransaction returns the flat call traces of a transaction.
+func (b *Backend) ParityTraceTransaction(hash gethcommon.Hash) ([]rpc.ParityTrace, error) {
+	traceResult, err := b.TraceTransaction(hash, traceConfigFlatCall())
+	if err != nil {
+		return nil, err
+	}
+	return decodeParityTraces(traceResult)
+}
+
+// ParityTraceBlock returns the flat call traces of all transactions in a
+// block, in transaction order.
+func (b *Backend) ParityTraceBlock(blockNum rpc.BlockNumber) ([]rpc.ParityTrace, error) {
+	height, err := b.resolveBlockNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if height == 0 {
+		return nil, pkgerrors.New("genesis is not traceable")
+	}
+	resBlock, err := b.TendermintBlockByNumber(rpc.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	txTraceResults, err := b.TraceBlock(rpc.BlockNumber(height), traceConfigFlatCall(), resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []rpc.ParityTrace{}
+	for _, txTraceResult := range txTraceResults {
+		if txTraceResult.Error != "" {
+			return nil, fmt.Errorf(
+				"failed to trace tx %s: %s", txTraceResult.TxHash.Hex(), txTraceResult.Error)
+		}
+		resultBz, err := json.Marshal(txTraceResult.Result)
+		if err != nil {
+			return nil, err
+		}
+		txTraces, err := decodeParityTraces(resultBz)
+		if err != nil {
+			return nil, err
+		}
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// ParityTraceFilter returns the flat call traces of the blocks in the range
+// of "args" that match its address filters. The range is inclusive, defaults
+// to the latest block, and may span at most [Backend.RPCBlockRangeCap]
+// blocks.
+func (b *Backend) ParityTraceFilter(args rpc.TraceFilterArgs) ([]rpc.ParityTrace, error) {
+	fromBlock, toBlock := rpc.EthLatestBlockNumber, rpc.EthLatestBlockNumber
+	if args.FromBlock != nil {
+		fromBlock = *args.FromBlock
+	}
+	if args.ToBlock != nil {
+		toBlock = *args.ToBlock
+	}
+	from, err := b.resolveBlockNumber(fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	to, err := b.resolveBlockNumber(toBlock)
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, fmt.Errorf("toBlock #%d needs to be at least fromBlock #%d", to, from)
+	}
+	if blockRangeCap := int64(b.RPCBlockRangeCap()); to-from+1 > blockRangeCap {
+		return nil, fmt.Errorf(
+			"block range %d exceeds the block range cap %d", to-from+1, blockRangeCap)
+	}
+
+	var (
+		fromAddrs = set.New(args.FromAddress...)
+		toAddrs   = set.New(args.ToAddress...)
+		after     uint64
+		traces    = []rpc.ParityTrace{}
+	)
+	if args.After != nil {
+		after = *args.After
+	}
+	if args.Count != nil && *args.Count == 0 {
+		return traces, nil
+	}
+	// The genesis block is not traceable and has no txs.
+	for height := max(from, 1); height <= to; height++ {
+		blockTraces, err := b.ParityTraceBlock(rpc.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range blockTraces {
+			if !addressMatches(fromAddrs, trace.FromAddress()) ||
+				!addressMatches(toAddrs, trace.ToAddress()) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) >= *args.Count {
+				return traces, nil
+			}
+		}
+	}
+	return traces, nil
+}
+
+// addressMatches returns true if "addr" is in "addrs", or if "addrs" is empty.
+func addressMatches(addrs set.Set[gethcommon.Address], addr *gethcommon.Address) bool {
+	if addrs.Len() == 0 {
+		return true
+	}
+	return addr != nil && addrs.Has(*addr)
+}
+
+// ParityTraceCall executes a call on top of the state at the given block and
+// returns its flat call traces. The nonce and gas of the call default to the
+// sender's nonce and the RPC gas cap.
+func (b *Backend) ParityTraceCall(
+	args evm.JsonTxArgs, blockNrOrHash rpc.BlockNumberOrHash,
+) ([]rpc.ParityTrace, error) {
+	blockNr, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if args.Nonce == nil {
+		nonce, err := b.GetTransactionCount(args.GetFrom(), blockNr)
+		if err != nil {
+			return nil, err
+		}
+		args.Nonce = nonce
+	}
+	if args.Gas == nil {
+		gas := hexutil.Uint64(b.RPCGasCap())
+		args.Gas = &gas
+	}
+	traceResult, err := b.TraceCall(args, blockNr, traceConfigFlatCall())
+	if err != nil {
+		return nil, err
+	}
+	return decodeParityTraces(traceResult)
+}
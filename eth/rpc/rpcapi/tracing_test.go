@@ -1,13 +1,17 @@
 package rpcapi_test
 
 import (
+	"context"
 	"encoding/json"
 	"math/big"
+	"os"
+	"path/filepath"
 	"strings"
 
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/NibiruChain/nibiru/v2/eth/rpc"
@@ -194,6 +198,95 @@ func (s *BackendSuite) TestTraceBlock() {
 	}
 }
 
+func (s *BackendSuite) TestTraceChain() {
+	transferTx := s.SuccessfulTxTransfer()
+	height := transferTx.BlockNumberRpc.Int64()
+
+	blockTraces, err := s.cli.EvmRpc.Debug.TraceChain(
+		context.Background(),
+		rpc.BlockNumber(height-2),
+		rpc.BlockNumber(height),
+		traceConfigCallTracer(),
+	)
+	s.Require().NoError(err)
+	s.Require().Len(blockTraces, 2)
+	s.EqualValues(height-1, blockTraces[0].Block)
+	s.EqualValues(height, blockTraces[1].Block)
+	s.Equal(*transferTx.BlockHash, blockTraces[1].Hash)
+	s.Require().Len(blockTraces[1].Traces, 1)
+	AssertTraceCall(s, mustTraceRawMessage(s, blockTraces[1].Traces[0].Result))
+
+	_, err = s.cli.EvmRpc.Debug.TraceChain(
+		context.Background(), rpc.BlockNumber(height), rpc.BlockNumber(height), nil,
+	)
+	s.ErrorContains(err, "needs to come after start block")
+}
+
+func (s *BackendSuite) TestTraceRawBlock() {
+	transferTx := s.SuccessfulTxTransfer()
+	blob, err := s.cli.EvmRpc.Debug.GetRawBlock(
+		context.Background(), rpc.BlockNumberOrHash{BlockHash: transferTx.BlockHash},
+	)
+	s.Require().NoError(err)
+
+	s.Run("debug_traceBlock", func() {
+		txTraceResults, err := s.cli.EvmRpc.Debug.TraceBlock(
+			context.Background(), blob, traceConfigCallTracer(),
+		)
+		s.Require().NoError(err)
+		s.Require().Len(txTraceResults, 1)
+		s.Equal(transferTx.Receipt.TxHash, txTraceResults[0].TxHash)
+		AssertTraceCall(s, mustTraceRawMessage(s, txTraceResults[0].Result))
+
+		_, err = s.cli.EvmRpc.Debug.TraceBlock(context.Background(), []byte{0x01}, nil)
+		s.ErrorContains(err, "could not decode block")
+	})
+
+	s.Run("debug_traceBlockFromFile", func() {
+		file := filepath.Join(s.T().TempDir(), "block.rlp")
+		s.Require().NoError(os.WriteFile(file, blob, 0o600))
+		txTraceResults, err := s.cli.EvmRpc.Debug.TraceBlockFromFile(
+			context.Background(), file, traceConfigCallTracer(),
+		)
+		s.Require().NoError(err)
+		s.Require().Len(txTraceResults, 1)
+		AssertTraceCall(s, mustTraceRawMessage(s, txTraceResults[0].Result))
+	})
+
+	s.Run("debug_traceBadBlock", func() {
+		_, err := s.cli.EvmRpc.Debug.TraceBadBlock(
+			context.Background(), *transferTx.BlockHash, nil,
+		)
+		s.ErrorContains(err, "not found")
+	})
+}
+
+func (s *BackendSuite) TestStandardTraceBlockToFile() {
+	transferTx := s.SuccessfulTxTransfer()
+	fileNames, err := s.cli.EvmRpc.Debug.StandardTraceBlockToFile(
+		context.Background(), *transferTx.BlockHash, &tracers.StdTraceConfig{
+			TxHash: transferTx.Receipt.TxHash,
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().Len(fileNames, 1)
+	defer os.Remove(fileNames[0])
+
+	traceBz, err := os.ReadFile(fileNames[0])
+	s.Require().NoError(err)
+	lines := strings.Split(strings.TrimSpace(string(traceBz)), "\n")
+	var summary map[string]any
+	s.Require().NoError(json.Unmarshal([]byte(lines[len(lines)-1]), &summary))
+	s.Equal(true, summary["pass"])
+
+	_, err = s.cli.EvmRpc.Debug.StandardTraceBlockToFile(
+		context.Background(), *transferTx.BlockHash, &tracers.StdTraceConfig{
+			TxHash: gethcommon.Hash{0x01},
+		},
+	)
+	s.ErrorContains(err, "not found in block")
+}
+
 func (s *BackendSuite) TestTraceCall() {
 	block, err := s.cli.EvmRpc.Eth.BlockNumber()
 	s.Require().NoError(err)
@@ -247,6 +247,23 @@ func (b *Backend) GetTransactionReceipt(hash gethcommon.Hash) (*TransactionRecei
 		b.logger.Debug("block not found", "height", res.Height, "error", err.Error())
 		return nil, nil
 	}
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+	return b.receiptFromTxResult(hash, res, resBlock, blockRes)
+}
+
+// receiptFromTxResult builds the receipt of the Ethereum tx with the given
+// hash from its indexed result and the block that includes it.
+func (b *Backend) receiptFromTxResult(
+	hash gethcommon.Hash,
+	res *eth.TxResult,
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+) (*TransactionReceipt, error) {
+	hexTx := hash.Hex()
 	tx, err := b.clientCtx.TxConfig.TxDecoder()(resBlock.Block.Txs[res.TxIndex])
 	if err != nil {
 		b.logger.Debug("decoding failed", "error", err.Error())
@@ -261,11 +278,6 @@ func (b *Backend) GetTransactionReceipt(hash gethcommon.Hash) (*TransactionRecei
 	}
 
 	cumulativeGasUsed := uint64(0)
-	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
-	if err != nil {
-		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
-		return nil, nil
-	}
 	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
 		cumulativeGasUsed += uint64(txResult.GasUsed) // #nosec G701 -- checked for int overflow already
 	}
@@ -345,6 +357,82 @@ func (b *Backend) GetTransactionReceipt(hash gethcommon.Hash) (*TransactionRecei
 	return &receipt, nil
 }
 
+// ReceiptsFromTendermintBlock returns the receipts of the Ethereum transactions
+// in a block, in the order given by [Backend.EthMsgsFromTendermintBlock]. The
+// block and its results are fetched once by the caller, and only the indexed
+// result of each tx is looked up.
+func (b *Backend) ReceiptsFromTendermintBlock(
+	resBlock *tmrpctypes.ResultBlock,
+	blockRes *tmrpctypes.ResultBlockResults,
+) ([]*TransactionReceipt, error) {
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	receipts := make([]*TransactionReceipt, len(msgs))
+	for i, ethMsg := range msgs {
+		hash := gethcommon.HexToHash(ethMsg.Hash)
+		res, err := b.GetTxByEthHash(hash)
+		if err != nil {
+			return nil, fmt.Errorf("receipt not found: tx %s: %w", ethMsg.Hash, err)
+		}
+		if res.EthTxIndex == -1 {
+			res.EthTxIndex = int32(i) // #nosec G701
+		}
+		receipt, err := b.receiptFromTxResult(hash, res, resBlock, blockRes)
+		if err != nil {
+			return nil, err
+		}
+		receipts[i] = receipt
+	}
+	return receipts, nil
+}
+
+// GetBlockReceipts returns the receipts of all Ethereum transactions in the
+// block with the given number or hash. If the block is not found, this
+// resolves to nil.
+func (b *Backend) GetBlockReceipts(
+	blockNrOrHash rpc.BlockNumberOrHash,
+) ([]*TransactionReceipt, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		b.logger.Debug("block not found", "block number or hash", blockNrOrHash, "error", err.Error())
+		return nil, nil
+	}
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		b.logger.Debug("block not found", "height", blockNum.Int64(), "error", err.Error())
+		return nil, nil
+	}
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", resBlock.Block.Height, "error", err.Error())
+		return nil, nil
+	}
+	return b.ReceiptsFromTendermintBlock(resBlock, blockRes)
+}
+
+// GetRawTransactionByHash returns the EIP-2718 binary encoding of the
+// confirmed transaction identified by hash. If the transaction is not found,
+// this resolves to nil.
+func (b *Backend) GetRawTransactionByHash(hash gethcommon.Hash) (hexutil.Bytes, error) {
+	res, err := b.GetTxByEthHash(hash)
+	if err != nil {
+		b.logger.Debug("tx not found", "hash", hash.Hex(), "error", err.Error())
+		return nil, nil
+	}
+	resBlock, err := b.TendermintBlockByNumber(rpc.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+	tx, err := b.clientCtx.TxConfig.TxDecoder()(resBlock.Block.Txs[res.TxIndex])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode tx: %w", err)
+	}
+	ethMsg, err := MsgEthereumTxFromSdkMsg(tx.GetMsgs()[res.MsgIndex])
+	if err != nil {
+		return nil, err
+	}
+	return ethMsg.AsTransaction().MarshalBinary()
+}
+
 // GetTransactionByBlockHashAndIndex returns the Ethereum-formatted transaction
 // in the block with the given hash and specifed index in the block.
 func (b *Backend) GetTransactionByBlockHashAndIndex(
@@ -92,6 +92,38 @@ func (s *BackendSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (s *BackendSuite) TestGetBlockReceipts() {
+	tx := s.SuccessfulTxTransfer()
+	receipts, err := s.cli.EvmRpc.Eth.GetBlockReceipts(
+		rpc.BlockNumberOrHash{BlockHash: tx.BlockHash},
+	)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(receipts)
+
+	var found bool
+	for i, receipt := range receipts {
+		s.EqualValues(i, receipt.TransactionIndex)
+		s.Equal(*tx.BlockHash, receipt.BlockHash)
+		if receipt.TxHash != tx.Receipt.TxHash {
+			continue
+		}
+		found = true
+		want, err := s.cli.EvmRpc.Eth.GetTransactionReceipt(receipt.TxHash)
+		s.Require().NoError(err)
+		s.Equal(want, receipt)
+	}
+	s.Require().True(found, "missing receipt of tx %s", tx.Receipt.TxHash)
+
+	s.Run("sad: block not found", func() {
+		blockNumber := rpc.NewBlockNumber(big.NewInt(1_000_000_000))
+		receipts, err := s.cli.EvmRpc.Eth.GetBlockReceipts(
+			rpc.BlockNumberOrHash{BlockNumber: &blockNumber},
+		)
+		s.Require().NoError(err)
+		s.Require().Nil(receipts)
+	})
+}
+
 func (s *BackendSuite) TestGetTransactionLogs() {
 	logs, err := s.cli.EvmRpc.Eth.GetTransactionLogs(
 		s.SuccessfulTxDeployContract().Receipt.TxHash,
@@ -0,0 +1,379 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpcapi
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// UserOperation is an ERC-4337 user operation in the format of the v0.6
+// EntryPoint, as sent to "eth_sendUserOperation".
+type UserOperation struct {
+	Sender               gethcommon.Address `json:"sender"`
+	Nonce                *hexutil.Big       `json:"nonce"`
+	InitCode             hexutil.Bytes      `json:"initCode"`
+	CallData             hexutil.Bytes      `json:"callData"`
+	CallGasLimit         *hexutil.Big       `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big       `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big       `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big       `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big       `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     hexutil.Bytes      `json:"paymasterAndData"`
+	Signature            hexutil.Bytes      `json:"signature"`
+}
+
+// UserOperationGasEstimate is the response of "eth_estimateUserOperationGas".
+type UserOperationGasEstimate struct {
+	PreVerificationGas   hexutil.Uint64 `json:"preVerificationGas"`
+	VerificationGasLimit hexutil.Uint64 `json:"verificationGasLimit"`
+	CallGasLimit         hexutil.Uint64 `json:"callGasLimit"`
+}
+
+// UserOperationReceipt is the response of "eth_getUserOperationReceipt".
+type UserOperationReceipt struct {
+	UserOpHash    gethcommon.Hash     `json:"userOpHash"`
+	EntryPoint    gethcommon.Address  `json:"entryPoint"`
+	Sender        gethcommon.Address  `json:"sender"`
+	Nonce         *hexutil.Big        `json:"nonce"`
+	Paymaster     gethcommon.Address  `json:"paymaster"`
+	ActualGasCost *hexutil.Big        `json:"actualGasCost"`
+	ActualGasUsed *hexutil.Big        `json:"actualGasUsed"`
+	Success       bool                `json:"success"`
+	Reason        string              `json:"reason"`
+	Logs          []*gethcore.Log     `json:"logs"`
+	Receipt       *TransactionReceipt `json:"receipt"`
+}
+
+// userOpABI is the ABI encoding of a [UserOperation]. The field names match
+// the components of the UserOperation tuple of the EntryPoint ABI.
+type userOpABI struct {
+	Sender               gethcommon.Address
+	Nonce                *big.Int
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte
+	Signature            []byte
+}
+
+// validationResult holds the outputs of the "ValidationResult" error that
+// "simulateValidation" reverts with when the user operation is valid.
+type validationResult struct {
+	ReturnInfo struct {
+		PreOpGas         *big.Int
+		Prefund          *big.Int
+		SigFailed        bool
+		ValidAfter       *big.Int
+		ValidUntil       *big.Int
+		PaymasterContext []byte
+	}
+	SenderInfo    stakeInfo
+	FactoryInfo   stakeInfo
+	PaymasterInfo stakeInfo
+}
+
+type stakeInfo struct {
+	Stake           *big.Int
+	UnstakeDelaySec *big.Int
+}
+
+const userOpTupleABI = `{"name": "%s", "type": "tuple", "components": [
+	{"name": "sender", "type": "address"},
+	{"name": "nonce", "type": "uint256"},
+	{"name": "initCode", "type": "bytes"},
+	{"name": "callData", "type": "bytes"},
+	{"name": "callGasLimit", "type": "uint256"},
+	{"name": "verificationGasLimit", "type": "uint256"},
+	{"name": "preVerificationGas", "type": "uint256"},
+	{"name": "maxFeePerGas", "type": "uint256"},
+	{"name": "maxPriorityFeePerGas", "type": "uint256"},
+	{"name": "paymasterAndData", "type": "bytes"},
+	{"name": "signature", "type": "bytes"}
+]}`
+
+const stakeInfoTupleABI = `{"name": "%s", "type": "tuple", "components": [
+	{"name": "stake", "type": "uint256"},
+	{"name": "unstakeDelaySec", "type": "uint256"}
+]}`
+
+// entryPointABI is the subset of the ABI of the ERC-4337 EntryPoint (v0.6)
+// used by the bundler.
+var entryPointABI = mustParseABI(`[
+	{"type": "function", "name": "handleOps", "inputs": [
+		` + fmt.Sprintf(strings.Replace(userOpTupleABI, `"tuple"`, `"tuple[]"`, 1), "ops") + `,
+		{"name": "beneficiary", "type": "address"}
+	]},
+	{"type": "function", "name": "simulateValidation", "inputs": [
+		` + fmt.Sprintf(userOpTupleABI, "userOp") + `
+	]},
+	{"type": "error", "name": "FailedOp", "inputs": [
+		{"name": "opIndex", "type": "uint256"},
+		{"name": "reason", "type": "string"}
+	]},
+	{"type": "error", "name": "ValidationResult", "inputs": [
+		{"name": "returnInfo", "type": "tuple", "components": [
+			{"name": "preOpGas", "type": "uint256"},
+			{"name": "prefund", "type": "uint256"},
+			{"name": "sigFailed", "type": "bool"},
+			{"name": "validAfter", "type": "uint48"},
+			{"name": "validUntil", "type": "uint48"},
+			{"name": "paymasterContext", "type": "bytes"}
+		]},
+		` + fmt.Sprintf(stakeInfoTupleABI, "senderInfo") + `,
+		` + fmt.Sprintf(stakeInfoTupleABI, "factoryInfo") + `,
+		` + fmt.Sprintf(stakeInfoTupleABI, "paymasterInfo") + `
+	]},
+	{"type": "error", "name": "ValidationResultWithAggregation", "inputs": [
+		{"name": "returnInfo", "type": "tuple", "components": [
+			{"name": "preOpGas", "type": "uint256"},
+			{"name": "prefund", "type": "uint256"},
+			{"name": "sigFailed", "type": "bool"},
+			{"name": "validAfter", "type": "uint48"},
+			{"name": "validUntil", "type": "uint48"},
+			{"name": "paymasterContext", "type": "bytes"}
+		]},
+		` + fmt.Sprintf(stakeInfoTupleABI, "senderInfo") + `,
+		` + fmt.Sprintf(stakeInfoTupleABI, "factoryInfo") + `,
+		` + fmt.Sprintf(stakeInfoTupleABI, "paymasterInfo") + `,
+		{"name": "aggregatorInfo", "type": "tuple", "components": [
+			{"name": "aggregator", "type": "address"},
+			` + fmt.Sprintf(stakeInfoTupleABI, "stakeInfo") + `
+		]}
+	]},
+	{"type": "event", "name": "UserOperationEvent", "inputs": [
+		{"name": "userOpHash", "type": "bytes32", "indexed": true},
+		{"name": "sender", "type": "address", "indexed": true},
+		{"name": "paymaster", "type": "address", "indexed": true},
+		{"name": "nonce", "type": "uint256"},
+		{"name": "success", "type": "bool"},
+		{"name": "actualGasCost", "type": "uint256"},
+		{"name": "actualGasUsed", "type": "uint256"}
+	]},
+	{"type": "event", "name": "UserOperationRevertReason", "inputs": [
+		{"name": "userOpHash", "type": "bytes32", "indexed": true},
+		{"name": "sender", "type": "address", "indexed": true},
+		{"name": "nonce", "type": "uint256"},
+		{"name": "revertReason", "type": "bytes"}
+	]}
+]`)
+
+// userOpHashArgs is the ABI encoding of the user operation fields that are
+// hashed into the user operation hash.
+var userOpHashArgs = mustNewArgs(
+	"address", "uint256", "bytes32", "bytes32", "uint256",
+	"uint256", "uint256", "uint256", "uint256", "bytes32",
+)
+
+// userOpPackArgs is the ABI encoding of all the user operation fields, used
+// to price its calldata.
+var userOpPackArgs = mustNewArgs(
+	"address", "uint256", "bytes", "bytes", "uint256", "uint256",
+	"uint256", "uint256", "uint256", "bytes", "bytes",
+)
+
+// userOpHashDomainArgs binds the user operation hash to an entry point and
+// chain.
+var userOpHashDomainArgs = mustNewArgs("bytes32", "address", "uint256")
+
+func mustParseABI(def string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(def))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+func mustNewArgs(types ...string) abi.Arguments {
+	args := make(abi.Arguments, len(types))
+	for i, t := range types {
+		abiType, err := abi.NewType(t, "", nil)
+		if err != nil {
+			panic(err)
+		}
+		args[i] = abi.Argument{Type: abiType}
+	}
+	return args
+}
+
+// bigOrZero returns the value of a user operation field, where missing fields
+// count as zero.
+func bigOrZero(x *hexutil.Big) *big.Int {
+	if x == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(x.ToInt())
+}
+
+// toABI returns the ABI encoding of the user operation.
+func (op UserOperation) toABI() userOpABI {
+	return userOpABI{
+		Sender:               op.Sender,
+		Nonce:                bigOrZero(op.Nonce),
+		InitCode:             op.InitCode,
+		CallData:             op.CallData,
+		CallGasLimit:         bigOrZero(op.CallGasLimit),
+		VerificationGasLimit: bigOrZero(op.VerificationGasLimit),
+		PreVerificationGas:   bigOrZero(op.PreVerificationGas),
+		MaxFeePerGas:         bigOrZero(op.MaxFeePerGas),
+		MaxPriorityFeePerGas: bigOrZero(op.MaxPriorityFeePerGas),
+		PaymasterAndData:     op.PaymasterAndData,
+		Signature:            op.Signature,
+	}
+}
+
+// Hash returns the user operation hash, as computed by "getUserOpHash" of the
+// v0.6 EntryPoint. It commits to every field except the signature, and to the
+// entry point and chain ID.
+func (op UserOperation) Hash(entryPoint gethcommon.Address, chainID *big.Int) gethcommon.Hash {
+	packed, err := userOpHashArgs.Pack(
+		op.Sender,
+		bigOrZero(op.Nonce),
+		crypto.Keccak256Hash(op.InitCode),
+		crypto.Keccak256Hash(op.CallData),
+		bigOrZero(op.CallGasLimit),
+		bigOrZero(op.VerificationGasLimit),
+		bigOrZero(op.PreVerificationGas),
+		bigOrZero(op.MaxFeePerGas),
+		bigOrZero(op.MaxPriorityFeePerGas),
+		crypto.Keccak256Hash(op.PaymasterAndData),
+	)
+	if err != nil {
+		panic(err) // static types
+	}
+	packed, err = userOpHashDomainArgs.Pack(crypto.Keccak256Hash(packed), entryPoint, chainID)
+	if err != nil {
+		panic(err) // static types
+	}
+	return crypto.Keccak256Hash(packed)
+}
+
+// Gas overheads of a user operation that are not metered by the EntryPoint and
+// must be covered by its "preVerificationGas". The values follow the
+// reference bundler.
+const (
+	preVerificationGasFixed      = 21_000
+	preVerificationGasPerUserOp  = 18_300
+	preVerificationGasPerWord    = 4
+	preVerificationGasZeroByte   = 4
+	preVerificationGasNonZero    = 16
+	preVerificationGasSigSize    = 65
+	preVerificationGasBundleSize = 1
+)
+
+// calcPreVerificationGas returns the min "preVerificationGas" of the user
+// operation: the calldata cost of the user operation in a "handleOps"
+// transaction plus its share of the transaction overhead. Short signatures
+// are padded to the size of an ECDSA signature so that estimates made with an
+// empty signature still cover the real one.
+func calcPreVerificationGas(op UserOperation) uint64 {
+	op.PreVerificationGas = (*hexutil.Big)(big.NewInt(preVerificationGasFixed))
+	if len(op.Signature) < preVerificationGasSigSize {
+		op.Signature = make([]byte, preVerificationGasSigSize)
+		for i := range op.Signature {
+			op.Signature[i] = 1
+		}
+	}
+	packed, err := userOpPackArgs.Pack(
+		op.Sender,
+		bigOrZero(op.Nonce),
+		[]byte(op.InitCode),
+		[]byte(op.CallData),
+		bigOrZero(op.CallGasLimit),
+		bigOrZero(op.VerificationGasLimit),
+		bigOrZero(op.PreVerificationGas),
+		bigOrZero(op.MaxFeePerGas),
+		bigOrZero(op.MaxPriorityFeePerGas),
+		[]byte(op.PaymasterAndData),
+		[]byte(op.Signature),
+	)
+	if err != nil {
+		panic(err) // static types
+	}
+
+	var callDataCost uint64
+	for _, b := range packed {
+		if b == 0 {
+			callDataCost += preVerificationGasZeroByte
+		} else {
+			callDataCost += preVerificationGasNonZero
+		}
+	}
+	words := uint64(len(packed)+31) / 32
+	return callDataCost +
+		preVerificationGasFixed/preVerificationGasBundleSize +
+		preVerificationGasPerUserOp +
+		preVerificationGasPerWord*words
+}
+
+// unpackValidationResult decodes the revert data of "simulateValidation". It
+// returns the validation result if the user operation is valid, or an error
+// with the reason the EntryPoint rejected it.
+func unpackValidationResult(revertData []byte) (*validationResult, error) {
+	if len(revertData) < 4 {
+		return nil, fmt.Errorf("simulateValidation did not revert with a result")
+	}
+	selector := revertData[:4]
+	switch {
+	case matchesSelector(selector, entryPointABI.Errors["ValidationResult"]):
+		abiErr := entryPointABI.Errors["ValidationResult"]
+		values, err := abiErr.Inputs.Unpack(revertData[4:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode ValidationResult: %w", err)
+		}
+		var res validationResult
+		if err := abiErr.Inputs.Copy(&res, values); err != nil {
+			return nil, fmt.Errorf("failed to decode ValidationResult: %w", err)
+		}
+		return &res, nil
+	case matchesSelector(selector, entryPointABI.Errors["ValidationResultWithAggregation"]):
+		return nil, fmt.Errorf("signature aggregators are not supported")
+	case matchesSelector(selector, entryPointABI.Errors["FailedOp"]):
+		_, reason, ok := unpackFailedOp(revertData)
+		if !ok {
+			return nil, fmt.Errorf("user operation rejected by the entry point")
+		}
+		return nil, fmt.Errorf("user operation rejected by the entry point: %s", reason)
+	default:
+		reason, err := abi.UnpackRevert(revertData)
+		if err != nil {
+			return nil, fmt.Errorf("simulateValidation reverted (raw hex: %x)", revertData)
+		}
+		return nil, fmt.Errorf("simulateValidation reverted with reason %q", reason)
+	}
+}
+
+// unpackFailedOp decodes the "FailedOp" error the EntryPoint reverts with when
+// it rejects a user operation. It returns the index of the user operation in
+// the "handleOps" call and the reason, or false if the revert data is not a
+// "FailedOp" error.
+func unpackFailedOp(revertData []byte) (opIndex uint64, reason string, ok bool) {
+	abiErr := entryPointABI.Errors["FailedOp"]
+	if len(revertData) < 4 || !matchesSelector(revertData[:4], abiErr) {
+		return 0, "", false
+	}
+	values, err := abiErr.Inputs.Unpack(revertData[4:])
+	if err != nil || len(values) != 2 {
+		return 0, "", false
+	}
+	index, _ := values[0].(*big.Int)
+	reason, _ = values[1].(string)
+	if index == nil || !index.IsUint64() {
+		return 0, "", false
+	}
+	return index.Uint64(), reason, true
+}
+
+func matchesSelector(selector []byte, abiErr abi.Error) bool {
+	return string(selector) == string(abiErr.ID[:4])
+}
@@ -0,0 +1,767 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpcapi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/NibiruChain/nibiru/v2/app/server/config"
+	"github.com/NibiruChain/nibiru/v2/eth/rpc"
+	"github.com/NibiruChain/nibiru/v2/evm"
+)
+
+const (
+	// userOpEstimationGasLimit is the verification gas limit given to user
+	// operations simulated by "eth_estimateUserOperationGas", and the max
+	// verification gas limit of a user operation.
+	userOpEstimationGasLimit = 10_000_000
+	// userOpValidationOverheadGas is the gas that "simulateValidation" uses
+	// on top of the verificationGasLimit of the user operation.
+	userOpValidationOverheadGas = 100_000
+	// userOpMinValidity is the min time a user operation must remain valid
+	// for to be accepted, so that it does not expire before it is bundled.
+	userOpMinValidity = 30 * time.Second
+	// userOpReceiptRetention is how long submitted user operations are kept
+	// to serve "eth_getUserOperationReceipt".
+	userOpReceiptRetention = 24 * time.Hour
+	// userOpReplacementBumpPercent is the min fee increase, in percent, for a
+	// user operation to replace a pooled one with the same sender and nonce.
+	userOpReplacementBumpPercent = 10
+	// userOpInclusionTimeout is how long a submitted bundle can stay out of a
+	// block before its user operations are returned to the pool, for example
+	// when the "handleOps" transaction was dropped from the mempool.
+	userOpInclusionTimeout = 5 * time.Minute
+)
+
+// UserOpPool is the in-process ERC-4337 bundler. It keeps the user operations
+// sent to "eth_sendUserOperation" in memory after simulating their validation
+// against the EntryPoint with "eth_call", and periodically submits them in
+// "handleOps" transactions signed by the bundler key.
+//
+// Pooled user operations are lost when the node restarts, like the
+// transactions of the mempool.
+type UserOpPool struct {
+	backend     *Backend
+	logger      log.Logger
+	cfg         config.BundlerConfig
+	entryPoints []gethcommon.Address
+	bundlerKey  *ecdsa.PrivateKey
+	bundler     gethcommon.Address
+	beneficiary gethcommon.Address
+
+	mu        sync.Mutex
+	pending   map[gethcommon.Hash]*pooledUserOp
+	submitted map[gethcommon.Hash]*pooledUserOp
+}
+
+// pooledUserOp is a user operation in the [UserOpPool].
+type pooledUserOp struct {
+	UserOperation
+	hash       gethcommon.Hash
+	entryPoint gethcommon.Address
+	receivedAt time.Time
+	// txHash is the hash of the "handleOps" transaction that included the
+	// user operation, and submittedAt the time it was broadcast, once the
+	// user operation is submitted.
+	txHash      gethcommon.Hash
+	submittedAt time.Time
+	// included is set once the "handleOps" transaction is in a block and did
+	// not revert.
+	included bool
+}
+
+// NewUserOpPool returns a [UserOpPool] for the bundler configuration. It
+// loads the bundler key from the configured key file.
+func NewUserOpPool(
+	backend *Backend, logger log.Logger, cfg config.BundlerConfig,
+) (*UserOpPool, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	bundlerKey, err := gethcrypto.LoadECDSA(cfg.KeyFile)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to load the bundler key")
+	}
+	bundler := gethcrypto.PubkeyToAddress(bundlerKey.PublicKey)
+
+	entryPoints := make([]gethcommon.Address, len(cfg.EntryPoints))
+	for i, entryPoint := range cfg.EntryPoints {
+		entryPoints[i] = gethcommon.HexToAddress(entryPoint)
+	}
+
+	beneficiary := bundler
+	if cfg.Beneficiary != "" {
+		beneficiary = gethcommon.HexToAddress(cfg.Beneficiary)
+	}
+
+	return &UserOpPool{
+		backend:     backend,
+		logger:      logger.With("module", "bundler"),
+		cfg:         cfg,
+		entryPoints: entryPoints,
+		bundlerKey:  bundlerKey,
+		bundler:     bundler,
+		beneficiary: beneficiary,
+		pending:     make(map[gethcommon.Hash]*pooledUserOp),
+		submitted:   make(map[gethcommon.Hash]*pooledUserOp),
+	}, nil
+}
+
+// Start submits the pooled user operations every bundle interval until ctx
+// is done.
+func (p *UserOpPool) Start(ctx context.Context) {
+	p.logger.Info(
+		"starting ERC-4337 bundler",
+		"bundler", p.bundler.Hex(),
+		"entryPoints", p.cfg.EntryPoints,
+	)
+	go func() {
+		ticker := time.NewTicker(p.cfg.BundleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.requeueFailedBundles(time.Now())
+				for _, entryPoint := range p.entryPoints {
+					p.submitBundle(entryPoint)
+				}
+				p.pruneSubmitted(time.Now())
+			}
+		}
+	}()
+}
+
+// SupportedEntryPoints returns the EntryPoint addresses the bundler accepts
+// user operations for, the preferred one first.
+func (p *UserOpPool) SupportedEntryPoints() []gethcommon.Address {
+	return p.entryPoints
+}
+
+// Add validates the user operation and adds it to the pool. It returns the
+// user operation hash.
+func (p *UserOpPool) Add(op UserOperation, entryPoint gethcommon.Address) (gethcommon.Hash, error) {
+	if err := p.validateUserOp(op, entryPoint); err != nil {
+		return gethcommon.Hash{}, err
+	}
+
+	pooled := &pooledUserOp{
+		UserOperation: op,
+		hash:          op.Hash(entryPoint, p.backend.chainID),
+		entryPoint:    entryPoint,
+		receivedAt:    time.Now(),
+	}
+	if err := p.insert(pooled); err != nil {
+		return gethcommon.Hash{}, err
+	}
+	p.logger.Debug("user operation added to the pool", "userOpHash", pooled.hash.Hex())
+	return pooled.hash, nil
+}
+
+// insert adds a validated user operation to the pool. A user operation with
+// the same sender, nonce, and entry point as a pooled one replaces it only if
+// it raises both fees by [userOpReplacementBumpPercent].
+func (p *UserOpPool) insert(pooled *pooledUserOp) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, found := p.pending[pooled.hash]; found {
+		return fmt.Errorf("user operation %s is already in the pool", pooled.hash.Hex())
+	}
+	if _, found := p.submitted[pooled.hash]; found {
+		return fmt.Errorf("user operation %s was already submitted", pooled.hash.Hex())
+	}
+
+	for hash, other := range p.pending {
+		if other.Sender != pooled.Sender ||
+			other.entryPoint != pooled.entryPoint ||
+			bigOrZero(other.Nonce).Cmp(bigOrZero(pooled.Nonce)) != 0 {
+			continue
+		}
+		if !isFeeBumped(other.MaxFeePerGas, pooled.MaxFeePerGas) ||
+			!isFeeBumped(other.MaxPriorityFeePerGas, pooled.MaxPriorityFeePerGas) {
+			return fmt.Errorf(
+				"replacement user operation must raise maxFeePerGas and maxPriorityFeePerGas by %d%%",
+				userOpReplacementBumpPercent,
+			)
+		}
+		delete(p.pending, hash)
+	}
+
+	if len(p.pending) >= p.cfg.MaxPoolSize {
+		return fmt.Errorf("user operation pool is full (%d user operations)", p.cfg.MaxPoolSize)
+	}
+	p.pending[pooled.hash] = pooled
+	return nil
+}
+
+// isFeeBumped returns true if newFee is at least
+// [userOpReplacementBumpPercent] percent higher than oldFee.
+func isFeeBumped(oldFee, newFee *hexutil.Big) bool {
+	minFee := new(big.Int).Mul(bigOrZero(oldFee), big.NewInt(100+userOpReplacementBumpPercent))
+	minFee.Div(minFee, big.NewInt(100))
+	return bigOrZero(newFee).Cmp(minFee) >= 0
+}
+
+// nextBundle returns the pooled user operations for the entry point to submit
+// in the next bundle: the ones with the highest priority fee first, at most
+// one per sender, and at most the max bundle size.
+func (p *UserOpPool) nextBundle(entryPoint gethcommon.Address) []*pooledUserOp {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	candidates := make([]*pooledUserOp, 0, len(p.pending))
+	for _, pooled := range p.pending {
+		if pooled.entryPoint == entryPoint {
+			candidates = append(candidates, pooled)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		cmp := bigOrZero(candidates[i].MaxPriorityFeePerGas).Cmp(bigOrZero(candidates[j].MaxPriorityFeePerGas))
+		if cmp != 0 {
+			return cmp > 0
+		}
+		if !candidates[i].receivedAt.Equal(candidates[j].receivedAt) {
+			return candidates[i].receivedAt.Before(candidates[j].receivedAt)
+		}
+		return bigOrZero(candidates[i].Nonce).Cmp(bigOrZero(candidates[j].Nonce)) < 0
+	})
+
+	bundle := []*pooledUserOp{}
+	senders := make(map[gethcommon.Address]bool)
+	for _, pooled := range candidates {
+		if len(bundle) >= p.cfg.MaxBundleSize {
+			break
+		}
+		if senders[pooled.Sender] {
+			continue
+		}
+		senders[pooled.Sender] = true
+		bundle = append(bundle, pooled)
+	}
+	return bundle
+}
+
+// submitBundle revalidates the next bundle of user operations for the entry
+// point, simulates its "handleOps" call, and submits it in a "handleOps"
+// transaction. User operations that became invalid or that the EntryPoint
+// rejects are dropped. If the submission fails, the user operations stay in
+// the pool and are retried with the next bundle.
+func (p *UserOpPool) submitBundle(entryPoint gethcommon.Address) {
+	bundle := []*pooledUserOp{}
+	for _, pooled := range p.nextBundle(entryPoint) {
+		if err := p.validateUserOp(pooled.UserOperation, entryPoint); err != nil {
+			p.logger.Info("dropping invalid user operation", "userOpHash", pooled.hash.Hex(), "error", err.Error())
+			p.remove(pooled.hash)
+			continue
+		}
+		bundle = append(bundle, pooled)
+	}
+	bundle = p.simulateHandleOps(entryPoint, bundle)
+	if len(bundle) == 0 {
+		return
+	}
+
+	txHash, err := p.sendHandleOps(entryPoint, bundle)
+	if err != nil {
+		p.logger.Error("failed to submit user operation bundle", "entryPoint", entryPoint.Hex(), "error", err.Error())
+		return
+	}
+	p.logger.Info("submitted user operation bundle", "txHash", txHash.Hex(), "userOps", len(bundle))
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, pooled := range bundle {
+		pooled.txHash = txHash
+		pooled.submittedAt = time.Now()
+		delete(p.pending, pooled.hash)
+		p.submitted[pooled.hash] = pooled
+	}
+}
+
+// simulateHandleOps runs the "handleOps" call of the bundle with "eth_call"
+// and returns the user operations that can be submitted. The validation of a
+// user operation can pass on its own and still fail in the bundle, for
+// example when it depends on state that another user operation changes. The
+// EntryPoint then reverts with "FailedOp", so the failing user operation is
+// dropped and the call is retried with the rest of the bundle. The whole
+// bundle is dropped if the call fails for another reason, so that it does
+// not block the pool.
+func (p *UserOpPool) simulateHandleOps(
+	entryPoint gethcommon.Address, bundle []*pooledUserOp,
+) []*pooledUserOp {
+	for len(bundle) > 0 {
+		input, err := p.packHandleOps(bundle)
+		if err != nil {
+			p.logger.Error("failed to simulate user operation bundle", "entryPoint", entryPoint.Hex(), "error", err.Error())
+			return nil
+		}
+		res, err := p.backend.doCallNoFail(evm.JsonTxArgs{
+			From:  &p.bundler,
+			To:    &entryPoint,
+			Input: (*hexutil.Bytes)(&input),
+		}, rpc.EthLatestBlockNumber, nil, nil)
+		if err != nil {
+			// The call did not run, so the bundle is retried with the next one.
+			p.logger.Error("failed to simulate user operation bundle", "entryPoint", entryPoint.Hex(), "error", err.Error())
+			return nil
+		}
+		if !res.Failed() {
+			return bundle
+		}
+
+		opIndex, reason, ok := unpackFailedOp(res.Ret)
+		if !ok || opIndex >= uint64(len(bundle)) {
+			p.logger.Info("dropping user operation bundle that fails in handleOps", "entryPoint", entryPoint.Hex(), "error", res.VmError)
+			for _, pooled := range bundle {
+				p.remove(pooled.hash)
+			}
+			return nil
+		}
+		failed := bundle[opIndex]
+		p.logger.Info("dropping user operation rejected in handleOps", "userOpHash", failed.hash.Hex(), "reason", reason)
+		p.remove(failed.hash)
+		bundle = slices.Delete(bundle, int(opIndex), int(opIndex)+1)
+	}
+	return bundle
+}
+
+// packHandleOps packs the "handleOps" call of a bundle.
+func (p *UserOpPool) packHandleOps(bundle []*pooledUserOp) ([]byte, error) {
+	ops := make([]userOpABI, len(bundle))
+	for i, pooled := range bundle {
+		ops[i] = pooled.toABI()
+	}
+	input, err := entryPointABI.Pack("handleOps", ops, p.beneficiary)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to pack handleOps")
+	}
+	return input, nil
+}
+
+// sendHandleOps signs and broadcasts the "handleOps" transaction of a bundle.
+func (p *UserOpPool) sendHandleOps(
+	entryPoint gethcommon.Address, bundle []*pooledUserOp,
+) (gethcommon.Hash, error) {
+	input, err := p.packHandleOps(bundle)
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+
+	args, err := p.backend.SetTxDefaults(evm.JsonTxArgs{
+		From:  &p.bundler,
+		To:    &entryPoint,
+		Input: (*hexutil.Bytes)(&input),
+	})
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+
+	var txData gethcore.TxData
+	if args.MaxFeePerGas != nil {
+		txData = &gethcore.DynamicFeeTx{
+			ChainID:   args.ChainID.ToInt(),
+			Nonce:     uint64(*args.Nonce),
+			GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+			GasFeeCap: args.MaxFeePerGas.ToInt(),
+			Gas:       uint64(*args.Gas),
+			To:        &entryPoint,
+			Data:      input,
+		}
+	} else {
+		txData = &gethcore.LegacyTx{
+			Nonce:    uint64(*args.Nonce),
+			GasPrice: args.GasPrice.ToInt(),
+			Gas:      uint64(*args.Gas),
+			To:       &entryPoint,
+			Data:     input,
+		}
+	}
+	tx, err := gethcore.SignNewTx(p.bundlerKey, gethcore.LatestSignerForChainID(p.backend.chainID), txData)
+	if err != nil {
+		return gethcommon.Hash{}, pkgerrors.Wrap(err, "failed to sign handleOps transaction")
+	}
+	txBz, err := tx.MarshalBinary()
+	if err != nil {
+		return gethcommon.Hash{}, err
+	}
+	return p.backend.SendRawTransaction(txBz)
+}
+
+func (p *UserOpPool) remove(hash gethcommon.Hash) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.pending, hash)
+}
+
+// requeueFailedBundles returns the user operations of the submitted bundles
+// whose "handleOps" transaction reverted, or is still not in a block after
+// [userOpInclusionTimeout], to the pending pool. They are revalidated with the
+// next bundle instead of waiting for a receipt that never comes.
+func (p *UserOpPool) requeueFailedBundles(now time.Time) {
+	p.mu.Lock()
+	txHashes := make(map[gethcommon.Hash]time.Time)
+	for _, pooled := range p.submitted {
+		if !pooled.included {
+			txHashes[pooled.txHash] = pooled.submittedAt
+		}
+	}
+	p.mu.Unlock()
+
+	for txHash, submittedAt := range txHashes {
+		txReceipt, err := p.backend.GetTransactionReceipt(txHash)
+		if err != nil {
+			txReceipt = nil
+		}
+		switch {
+		case txReceipt == nil && now.Sub(submittedAt) <= userOpInclusionTimeout:
+			// the bundle is not in a block yet
+		case txReceipt == nil || txReceipt.Status == gethcore.ReceiptStatusFailed:
+			p.logger.Info("requeuing the user operations of a failed bundle", "txHash", txHash.Hex())
+			p.markBundle(txHash, true)
+		default:
+			p.markBundle(txHash, false)
+		}
+	}
+}
+
+// markBundle marks the user operations of the bundle with the transaction
+// hash as included, or returns them to the pending pool if the bundle failed.
+func (p *UserOpPool) markBundle(txHash gethcommon.Hash, failed bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for hash, pooled := range p.submitted {
+		if pooled.txHash != txHash {
+			continue
+		}
+		if !failed {
+			pooled.included = true
+			continue
+		}
+		delete(p.submitted, hash)
+		pooled.txHash = gethcommon.Hash{}
+		pooled.submittedAt = time.Time{}
+		p.pending[hash] = pooled
+	}
+}
+
+// pruneSubmitted forgets the user operations submitted before the receipt
+// retention period.
+func (p *UserOpPool) pruneSubmitted(now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for hash, pooled := range p.submitted {
+		if now.Sub(pooled.submittedAt) > userOpReceiptRetention {
+			delete(p.submitted, hash)
+		}
+	}
+}
+
+func (p *UserOpPool) isSupported(entryPoint gethcommon.Address) bool {
+	for _, supported := range p.entryPoints {
+		if supported == entryPoint {
+			return true
+		}
+	}
+	return false
+}
+
+// validateUserOp checks the user operation fields, then simulates its
+// validation against the EntryPoint and checks that the validation follows
+// the ERC-7562 rules.
+func (p *UserOpPool) validateUserOp(op UserOperation, entryPoint gethcommon.Address) error {
+	if !p.isSupported(entryPoint) {
+		return fmt.Errorf("unsupported entry point %s", entryPoint.Hex())
+	}
+	for name, field := range map[string]*hexutil.Big{
+		"nonce":                op.Nonce,
+		"callGasLimit":         op.CallGasLimit,
+		"verificationGasLimit": op.VerificationGasLimit,
+		"preVerificationGas":   op.PreVerificationGas,
+		"maxFeePerGas":         op.MaxFeePerGas,
+		"maxPriorityFeePerGas": op.MaxPriorityFeePerGas,
+	} {
+		if field == nil {
+			return fmt.Errorf("missing user operation field %q", name)
+		}
+	}
+	if op.MaxPriorityFeePerGas.ToInt().Cmp(op.MaxFeePerGas.ToInt()) > 0 {
+		return fmt.Errorf("maxPriorityFeePerGas (%v) > maxFeePerGas (%v)", op.MaxPriorityFeePerGas, op.MaxFeePerGas)
+	}
+	if op.VerificationGasLimit.ToInt().Cmp(big.NewInt(userOpEstimationGasLimit)) > 0 {
+		return fmt.Errorf("verificationGasLimit (%v) is above the max of %d", op.VerificationGasLimit, userOpEstimationGasLimit)
+	}
+	if minGas := calcPreVerificationGas(op); op.PreVerificationGas.ToInt().Cmp(new(big.Int).SetUint64(minGas)) < 0 {
+		return fmt.Errorf("preVerificationGas (%v) is below the min of %d", op.PreVerificationGas, minGas)
+	}
+
+	// The bundler pays the base fee of the handleOps transaction and is
+	// refunded at most maxFeePerGas.
+	head, err := p.backend.CurrentHeader()
+	if err != nil {
+		return err
+	}
+	if head.BaseFee != nil && op.MaxFeePerGas.ToInt().Cmp(head.BaseFee) < 0 {
+		return fmt.Errorf("maxFeePerGas (%v) is below the base fee (%v)", op.MaxFeePerGas, head.BaseFee)
+	}
+
+	if len(op.InitCode) == 0 {
+		if err := p.checkDeployed(op.Sender); err != nil {
+			return err
+		}
+	}
+
+	res, err := p.simulateValidation(op, entryPoint)
+	if err != nil {
+		return err
+	}
+	if err := p.traceValidation(op, entryPoint, res); err != nil {
+		return err
+	}
+	if res.ReturnInfo.SigFailed {
+		return fmt.Errorf("invalid user operation signature")
+	}
+	now := time.Now()
+	if validUntil := res.ReturnInfo.ValidUntil; validUntil.Sign() != 0 &&
+		validUntil.Cmp(big.NewInt(now.Add(userOpMinValidity).Unix())) < 0 {
+		return fmt.Errorf("user operation expires too soon (validUntil %v)", validUntil)
+	}
+	if validAfter := res.ReturnInfo.ValidAfter; validAfter.Cmp(big.NewInt(now.Unix())) > 0 {
+		return fmt.Errorf("user operation is not valid yet (validAfter %v)", validAfter)
+	}
+	return nil
+}
+
+// checkDeployed returns an error if the sender account has no code.
+func (p *UserOpPool) checkDeployed(sender gethcommon.Address) error {
+	latest := rpc.EthLatestBlockNumber
+	code, err := p.backend.GetCode(sender, rpc.BlockNumberOrHash{BlockNumber: &latest})
+	if err != nil {
+		return err
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("sender %s is not deployed and the user operation has no initCode", sender.Hex())
+	}
+	return nil
+}
+
+// simulateValidation calls "simulateValidation" of the EntryPoint with the
+// user operation, which always reverts, and decodes the result from the
+// revert data.
+func (p *UserOpPool) simulateValidation(
+	op UserOperation, entryPoint gethcommon.Address,
+) (*validationResult, error) {
+	input, err := entryPointABI.Pack("simulateValidation", op.toABI())
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to pack simulateValidation")
+	}
+	res, err := p.backend.doCallNoFail(evm.JsonTxArgs{
+		From:  &p.bundler,
+		To:    &entryPoint,
+		Input: (*hexutil.Bytes)(&input),
+	}, rpc.EthLatestBlockNumber, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res.VmError != vm.ErrExecutionReverted.Error() {
+		if res.Failed() {
+			return nil, fmt.Errorf("simulateValidation failed: %s", res.VmError)
+		}
+		return nil, fmt.Errorf("simulateValidation of %s did not revert with a result", entryPoint.Hex())
+	}
+	return unpackValidationResult(res.Ret)
+}
+
+// traceValidation traces "simulateValidation" with the ERC-7562 tracer and
+// checks the opcodes and storage accesses of the validation against the
+// ERC-7562 rules with [checkValidationRules]. The trace gets the gas that the
+// EntryPoint can spend on the validation, capped by the RPC gas cap.
+func (p *UserOpPool) traceValidation(
+	op UserOperation, entryPoint gethcommon.Address, res *validationResult,
+) error {
+	input, err := entryPointABI.Pack("simulateValidation", op.toABI())
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to pack simulateValidation")
+	}
+	gas := userOpValidationGas(op)
+	if gasCap := p.backend.RPCGasCap(); gasCap != 0 && gas > gasCap {
+		gas = gasCap
+	}
+	traceResult, err := p.backend.TraceCall(evm.JsonTxArgs{
+		From:  &p.bundler,
+		To:    &entryPoint,
+		Gas:   (*hexutil.Uint64)(&gas),
+		Input: (*hexutil.Bytes)(&input),
+	}, rpc.EthLatestBlockNumber, &evm.TraceConfig{Tracer: evm.TracerERC7562})
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to trace simulateValidation")
+	}
+	var trace []evm.ERC7562Step
+	if err := json.Unmarshal(traceResult, &trace); err != nil {
+		return pkgerrors.Wrap(err, "failed to decode the simulateValidation trace")
+	}
+	return checkValidationRules(trace, op, entryPoint, res)
+}
+
+// userOpValidationGas returns the gas that "simulateValidation" can use for
+// the user operation: the verificationGasLimit for the account creation and
+// validation, the verificationGasLimit again for the paymaster validation,
+// and the overhead of the EntryPoint. validateUserOp caps the
+// verificationGasLimit, so the sum cannot overflow.
+func userOpValidationGas(op UserOperation) uint64 {
+	gas := bigOrZero(op.VerificationGasLimit).Uint64()
+	if len(op.PaymasterAndData) > 0 {
+		gas *= 2
+	}
+	return gas + userOpValidationOverheadGas
+}
+
+// EstimateGas estimates the gas limits of the user operation. Its signature
+// must have the size and shape of a real one so that the account validation
+// uses a representative amount of gas. The account must be deployed, or the
+// user operation must deploy it with its initCode.
+func (p *UserOpPool) EstimateGas(
+	op UserOperation, entryPoint gethcommon.Address,
+) (*UserOperationGasEstimate, error) {
+	if !p.isSupported(entryPoint) {
+		return nil, fmt.Errorf("unsupported entry point %s", entryPoint.Hex())
+	}
+
+	// Zero fees so that the account does not need a deposit for the
+	// simulation, and no call gas since only the validation is simulated.
+	sim := op
+	sim.Nonce = (*hexutil.Big)(bigOrZero(op.Nonce))
+	sim.CallGasLimit = (*hexutil.Big)(new(big.Int))
+	sim.VerificationGasLimit = (*hexutil.Big)(big.NewInt(userOpEstimationGasLimit))
+	sim.PreVerificationGas = (*hexutil.Big)(new(big.Int))
+	sim.MaxFeePerGas = (*hexutil.Big)(new(big.Int))
+	sim.MaxPriorityFeePerGas = (*hexutil.Big)(new(big.Int))
+	res, err := p.simulateValidation(sim, entryPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var callGasLimit hexutil.Uint64
+	if len(op.CallData) > 0 {
+		if len(op.InitCode) > 0 {
+			if err := p.checkDeployed(op.Sender); err != nil {
+				return nil, fmt.Errorf("cannot estimate callGasLimit before the sender is deployed: %w", err)
+			}
+		}
+		blockNr := rpc.EthLatestBlockNumber
+		callGasLimit, err = p.backend.EstimateGas(evm.JsonTxArgs{
+			From:  &entryPoint,
+			To:    &op.Sender,
+			Input: &op.CallData,
+		}, &blockNr)
+		if err != nil {
+			return nil, pkgerrors.Wrap(err, "failed to estimate callGasLimit")
+		}
+	}
+
+	return &UserOperationGasEstimate{
+		PreVerificationGas:   hexutil.Uint64(calcPreVerificationGas(op)),
+		VerificationGasLimit: hexutil.Uint64(res.ReturnInfo.PreOpGas.Uint64()),
+		CallGasLimit:         callGasLimit,
+	}, nil
+}
+
+// Receipt returns the receipt of a submitted user operation, or nil if it is
+// unknown or its bundle is not in a block yet.
+func (p *UserOpPool) Receipt(hash gethcommon.Hash) (*UserOperationReceipt, error) {
+	p.mu.Lock()
+	pooled, found := p.submitted[hash]
+	p.mu.Unlock()
+	if !found {
+		return nil, nil
+	}
+
+	txReceipt, err := p.backend.GetTransactionReceipt(pooled.txHash)
+	if err != nil || txReceipt == nil {
+		// the bundle is not in a block yet
+		return nil, nil
+	}
+	return userOpReceiptFromTx(hash, pooled.entryPoint, txReceipt)
+}
+
+// userOpReceiptFromTx builds the receipt of a user operation from the receipt
+// of its "handleOps" transaction. The logs of the user operation are the ones
+// emitted after the "UserOperationEvent" of the previous user operation of
+// the bundle. It returns nil if the transaction has no "UserOperationEvent"
+// for the user operation, which happens when the whole bundle reverted.
+func userOpReceiptFromTx(
+	hash gethcommon.Hash, entryPoint gethcommon.Address, txReceipt *TransactionReceipt,
+) (*UserOperationReceipt, error) {
+	opEvent := entryPointABI.Events["UserOperationEvent"]
+	revertEvent := entryPointABI.Events["UserOperationRevertReason"]
+
+	start := 0
+	reason := ""
+	for i, txLog := range txReceipt.Logs {
+		if txLog.Address != entryPoint || len(txLog.Topics) < 3 {
+			continue
+		}
+		switch txLog.Topics[0] {
+		case revertEvent.ID:
+			if txLog.Topics[1] != hash {
+				continue
+			}
+			values, err := revertEvent.Inputs.NonIndexed().Unpack(txLog.Data)
+			if err != nil || len(values) != 2 {
+				continue
+			}
+			revertData, _ := values[1].([]byte)
+			if revertReason, err := abi.UnpackRevert(revertData); err == nil {
+				reason = revertReason
+			} else {
+				reason = hexutil.Encode(revertData)
+			}
+		case opEvent.ID:
+			if txLog.Topics[1] != hash || len(txLog.Topics) < 4 {
+				start = i + 1
+				continue
+			}
+			var event struct {
+				Nonce         *big.Int
+				Success       bool
+				ActualGasCost *big.Int
+				ActualGasUsed *big.Int
+			}
+			if err := entryPointABI.UnpackIntoInterface(&event, "UserOperationEvent", txLog.Data); err != nil {
+				return nil, pkgerrors.Wrap(err, "failed to decode UserOperationEvent")
+			}
+			return &UserOperationReceipt{
+				UserOpHash:    hash,
+				EntryPoint:    entryPoint,
+				Sender:        gethcommon.BytesToAddress(txLog.Topics[2].Bytes()),
+				Nonce:         (*hexutil.Big)(event.Nonce),
+				Paymaster:     gethcommon.BytesToAddress(txLog.Topics[3].Bytes()),
+				ActualGasCost: (*hexutil.Big)(event.ActualGasCost),
+				ActualGasUsed: (*hexutil.Big)(event.ActualGasUsed),
+				Success:       event.Success,
+				Reason:        reason,
+				Logs:          txReceipt.Logs[start:i],
+				Receipt:       txReceipt,
+			}, nil
+		}
+	}
+	return nil, nil
+}
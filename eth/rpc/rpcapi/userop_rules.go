@@ -0,0 +1,179 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package rpcapi
+
+import (
+	"fmt"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/v2/evm"
+)
+
+// associatedSlotRange is the number of storage slots after a "keccak256(A||x)"
+// slot that are still associated with the address A, which covers the fields
+// of a struct stored in a mapping.
+const associatedSlotRange = 128
+
+// validationFrame is a call frame of the validation trace.
+type validationFrame struct {
+	// code is the address of the executing code and storage the address of
+	// the storage it accesses, which differ in delegate calls.
+	code    gethcommon.Address
+	storage gethcommon.Address
+	// entity is the factory, sender, or paymaster the frame runs on behalf
+	// of, or the zero address for the frames of the EntryPoint itself.
+	entity gethcommon.Address
+}
+
+// checkValidationRules checks that the validation of the user operation
+// follows the opcode and storage access rules of ERC-7562:
+//   - The entities do not use the banned opcodes, and only use GAS right
+//     before a call.
+//   - Only the factory creates a contract, the sender, with CREATE2.
+//   - The entities only access the storage of the sender, the storage
+//     associated with the sender in other contracts, and, if staked, their
+//     own storage.
+//
+// This is a subset of the rules: the bundler does not keep the reputation of
+// the entities nor check the rules that span several user operations.
+func checkValidationRules(
+	trace []evm.ERC7562Step,
+	op UserOperation,
+	entryPoint gethcommon.Address,
+	res *validationResult,
+) error {
+	sender := op.Sender
+	var factory, paymaster gethcommon.Address
+	if len(op.InitCode) >= gethcommon.AddressLength {
+		factory = gethcommon.BytesToAddress(op.InitCode[:gethcommon.AddressLength])
+	}
+	if len(op.PaymasterAndData) >= gethcommon.AddressLength {
+		paymaster = gethcommon.BytesToAddress(op.PaymasterAndData[:gethcommon.AddressLength])
+	}
+	entityName := map[gethcommon.Address]string{sender: "account"}
+	staked := map[gethcommon.Address]bool{}
+	if factory != (gethcommon.Address{}) {
+		entityName[factory] = "factory"
+		staked[factory] = isStaked(res.FactoryInfo)
+	}
+	if paymaster != (gethcommon.Address{}) {
+		entityName[paymaster] = "paymaster"
+		staked[paymaster] = isStaked(res.PaymasterInfo)
+	}
+
+	// associated holds the "keccak256(A||x)" hashes computed during the
+	// validation for each entity A, the base slots of its associated storage.
+	associated := map[gethcommon.Address][]*big.Int{}
+	isAssociated := func(addr gethcommon.Address, slot gethcommon.Hash) bool {
+		if slot == gethcommon.BytesToHash(addr.Bytes()) {
+			return true
+		}
+		for _, base := range associated[addr] {
+			offset := new(big.Int).Sub(slot.Big(), base)
+			if offset.Sign() >= 0 && offset.Cmp(big.NewInt(associatedSlotRange)) <= 0 {
+				return true
+			}
+		}
+		return false
+	}
+
+	var frames []validationFrame
+	created := false
+	for _, step := range trace {
+		switch {
+		case step.Enter:
+			if step.To == nil {
+				return fmt.Errorf("call frame without a callee tracing the validation")
+			}
+			callee := *step.To
+			next := validationFrame{code: callee, storage: callee}
+			if len(frames) > 0 {
+				parent := frames[len(frames)-1]
+				next.entity = parent.entity
+				if op := vm.StringToOp(step.Op); op == vm.CALLCODE || op == vm.DELEGATECALL {
+					next.storage = parent.storage
+				}
+			}
+			if _, isEntity := entityName[callee]; isEntity {
+				next.entity = callee
+			}
+			frames = append(frames, next)
+			continue
+		case step.Exit:
+			if len(frames) == 0 {
+				return fmt.Errorf("unexpected return from a call frame tracing the validation")
+			}
+			frames = frames[:len(frames)-1]
+			continue
+		case len(frames) == 0:
+			return fmt.Errorf("%s outside of a call frame tracing the validation", step.Op)
+		}
+		frame := frames[len(frames)-1]
+
+		opcode := vm.StringToOp(step.Op)
+		if opcode == vm.KECCAK256 {
+			recordAssociatedSlot(step.KeccakInput, entityName, associated)
+			continue
+		}
+		if frame.entity == (gethcommon.Address{}) || frame.code == entryPoint {
+			continue
+		}
+		name := entityName[frame.entity]
+
+		switch {
+		case opcode == vm.GAS:
+			// The tracer only records GAS when it is not used for a call.
+			return fmt.Errorf("%s uses the banned opcode GAS during validation", name)
+		case opcode == vm.CREATE2:
+			if frame.entity != factory || created {
+				return fmt.Errorf("%s uses the banned opcode CREATE2 during validation", name)
+			}
+			created = true
+		case evm.ERC7562BannedOpcodes[opcode]:
+			return fmt.Errorf("%s uses the banned opcode %s during validation", name, step.Op)
+		case opcode == vm.SLOAD || opcode == vm.SSTORE:
+			if step.Slot == nil {
+				return fmt.Errorf("%s without a storage slot tracing the validation", step.Op)
+			}
+			slot := *step.Slot
+			if frame.storage == sender || isAssociated(sender, slot) {
+				continue
+			}
+			if staked[frame.entity] && (frame.storage == frame.entity || isAssociated(frame.entity, slot)) {
+				continue
+			}
+			return fmt.Errorf(
+				"%s accesses the storage slot %s of %s during validation, which is not associated with the sender",
+				name, slot.Hex(), frame.storage.Hex(),
+			)
+		}
+	}
+	return nil
+}
+
+// recordAssociatedSlot records the hash of a KECCAK256 input that starts with
+// the address of an entity, which is the base slot of a mapping value keyed by
+// the entity.
+func recordAssociatedSlot(
+	input []byte,
+	entities map[gethcommon.Address]string,
+	associated map[gethcommon.Address][]*big.Int,
+) {
+	if len(input) < gethcommon.HashLength {
+		return
+	}
+	key := gethcommon.BytesToHash(input[:gethcommon.HashLength])
+	addr := gethcommon.BytesToAddress(key.Bytes())
+	if _, isEntity := entities[addr]; !isEntity || key != gethcommon.BytesToHash(addr.Bytes()) {
+		return
+	}
+	associated[addr] = append(associated[addr], gethcrypto.Keccak256Hash(input).Big())
+}
+
+func isStaked(info stakeInfo) bool {
+	return info.Stake != nil && info.Stake.Sign() > 0 &&
+		info.UnstakeDelaySec != nil && info.UnstakeDelaySec.Sign() > 0
+}
@@ -0,0 +1,266 @@
+package rpcapi
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/app/server/config"
+	"github.com/NibiruChain/nibiru/v2/evm"
+)
+
+var testEntryPoint = gethcommon.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+
+func testUserOp(sender gethcommon.Address, nonce, priorityFee int64) UserOperation {
+	return UserOperation{
+		Sender:               sender,
+		Nonce:                (*hexutil.Big)(big.NewInt(nonce)),
+		CallData:             hexutil.MustDecode("0xdeadbeef"),
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100_000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(200_000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50_000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(2_000_000_000)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(priorityFee)),
+	}
+}
+
+func TestUserOperationHash(t *testing.T) {
+	op := testUserOp(gethcommon.HexToAddress("0x1111111111111111111111111111111111111111"), 1, 1_000_000_000)
+	require.Equal(t,
+		"0x997237c137494812b204f8a93e83645fc2b225c07ec0cc5e1cb8a96092770a06",
+		op.Hash(testEntryPoint, big.NewInt(6900)).Hex(),
+	)
+
+	// The signature is not part of the hash, the chain ID is.
+	signed := op
+	signed.Signature = hexutil.MustDecode("0x01")
+	require.Equal(t, op.Hash(testEntryPoint, big.NewInt(6900)), signed.Hash(testEntryPoint, big.NewInt(6900)))
+	require.NotEqual(t, op.Hash(testEntryPoint, big.NewInt(6900)), op.Hash(testEntryPoint, big.NewInt(1)))
+
+	// An empty signature is priced as an ECDSA signature.
+	require.EqualValues(t, 43_176, calcPreVerificationGas(op))
+	signed.Signature = make([]byte, preVerificationGasSigSize)
+	require.Less(t, calcPreVerificationGas(signed), calcPreVerificationGas(op))
+}
+
+func withSelector(abiErr abi.Error, data []byte) []byte {
+	return append(append([]byte{}, abiErr.ID[:4]...), data...)
+}
+
+func TestUnpackValidationResult(t *testing.T) {
+	failedOp := entryPointABI.Errors["FailedOp"]
+	data, err := failedOp.Inputs.Pack(big.NewInt(0), "AA21 didn't pay prefund")
+	require.NoError(t, err)
+	_, err = unpackValidationResult(withSelector(failedOp, data))
+	require.ErrorContains(t, err, "AA21 didn't pay prefund")
+
+	stake := stakeInfo{Stake: big.NewInt(0), UnstakeDelaySec: big.NewInt(0)}
+	validation := entryPointABI.Errors["ValidationResult"]
+	data, err = validation.Inputs.Pack(
+		struct {
+			PreOpGas         *big.Int
+			Prefund          *big.Int
+			SigFailed        bool
+			ValidAfter       *big.Int
+			ValidUntil       *big.Int
+			PaymasterContext []byte
+		}{big.NewInt(60_000), big.NewInt(1), true, big.NewInt(0), big.NewInt(0), []byte{}},
+		stake, stake, stake,
+	)
+	require.NoError(t, err)
+	res, err := unpackValidationResult(withSelector(validation, data))
+	require.NoError(t, err)
+	require.EqualValues(t, 60_000, res.ReturnInfo.PreOpGas.Int64())
+	require.True(t, res.ReturnInfo.SigFailed)
+
+	_, err = unpackValidationResult(nil)
+	require.ErrorContains(t, err, "did not revert with a result")
+}
+
+func TestUserOpPool(t *testing.T) {
+	pool := &UserOpPool{
+		logger:      log.NewNopLogger(),
+		cfg:         config.BundlerConfig{MaxBundleSize: 2, MaxPoolSize: 3},
+		entryPoints: []gethcommon.Address{testEntryPoint},
+		pending:     make(map[gethcommon.Hash]*pooledUserOp),
+		submitted:   make(map[gethcommon.Hash]*pooledUserOp),
+	}
+	chainID := big.NewInt(6900)
+	add := func(op UserOperation) error {
+		return pool.insert(&pooledUserOp{
+			UserOperation: op,
+			hash:          op.Hash(testEntryPoint, chainID),
+			entryPoint:    testEntryPoint,
+			receivedAt:    time.Now(),
+		})
+	}
+	alice := gethcommon.BytesToAddress([]byte{0xa})
+	bob := gethcommon.BytesToAddress([]byte{0xb})
+	carol := gethcommon.BytesToAddress([]byte{0xc})
+
+	require.NoError(t, add(testUserOp(alice, 0, 100)))
+	require.ErrorContains(t, add(testUserOp(alice, 0, 100)), "already in the pool")
+
+	// Replacements must raise both fees by 10%.
+	require.ErrorContains(t, add(testUserOp(alice, 0, 105)), "must raise")
+	bumped := testUserOp(alice, 0, 110)
+	bumped.MaxFeePerGas = (*hexutil.Big)(big.NewInt(2_200_000_000))
+	require.NoError(t, add(bumped))
+	require.Len(t, pool.pending, 1)
+
+	require.NoError(t, add(testUserOp(alice, 1, 500)))
+	require.NoError(t, add(testUserOp(bob, 0, 200)))
+	require.ErrorContains(t, add(testUserOp(carol, 0, 300)), "pool is full")
+
+	// Highest priority fee first, one user operation per sender.
+	bundle := pool.nextBundle(testEntryPoint)
+	require.Len(t, bundle, 2)
+	require.Equal(t, alice, bundle[0].Sender)
+	require.EqualValues(t, 1, bundle[0].Nonce.ToInt().Int64())
+	require.Equal(t, bob, bundle[1].Sender)
+	require.Empty(t, pool.nextBundle(gethcommon.Address{}))
+}
+
+func TestUserOpReceiptFromTx(t *testing.T) {
+	opHash := gethcommon.HexToHash("0x01")
+	otherHash := gethcommon.HexToHash("0x02")
+	sender := gethcommon.BytesToAddress([]byte{0xa})
+	opEvent := entryPointABI.Events["UserOperationEvent"]
+	eventLog := func(hash gethcommon.Hash, success bool) *gethcore.Log {
+		data, err := opEvent.Inputs.NonIndexed().Pack(big.NewInt(7), success, big.NewInt(1000), big.NewInt(50_000))
+		require.NoError(t, err)
+		return &gethcore.Log{
+			Address: testEntryPoint,
+			Topics:  []gethcommon.Hash{opEvent.ID, hash, sender.Hash(), {}},
+			Data:    data,
+		}
+	}
+	revertEvent := entryPointABI.Events["UserOperationRevertReason"]
+	revertData, err := revertEvent.Inputs.NonIndexed().Pack(big.NewInt(7), []byte{0xab})
+	require.NoError(t, err)
+	callLog := &gethcore.Log{Address: sender}
+
+	txReceipt := &TransactionReceipt{Receipt: gethcore.Receipt{Logs: []*gethcore.Log{
+		{Address: sender},
+		eventLog(otherHash, true),
+		callLog,
+		{
+			Address: testEntryPoint,
+			Topics:  []gethcommon.Hash{revertEvent.ID, opHash, sender.Hash()},
+			Data:    revertData,
+		},
+		eventLog(opHash, false),
+	}}}
+
+	receipt, err := userOpReceiptFromTx(opHash, testEntryPoint, txReceipt)
+	require.NoError(t, err)
+	require.NotNil(t, receipt)
+	require.Equal(t, sender, receipt.Sender)
+	require.False(t, receipt.Success)
+	require.Equal(t, "0xab", receipt.Reason)
+	require.EqualValues(t, 7, receipt.Nonce.ToInt().Int64())
+	require.EqualValues(t, 50_000, receipt.ActualGasUsed.ToInt().Int64())
+	require.Equal(t, txReceipt.Logs[2:4], receipt.Logs)
+
+	receipt, err = userOpReceiptFromTx(gethcommon.HexToHash("0x03"), testEntryPoint, txReceipt)
+	require.NoError(t, err)
+	require.Nil(t, receipt)
+}
+
+func TestUnpackFailedOp(t *testing.T) {
+	failedOp := entryPointABI.Errors["FailedOp"]
+	data, err := failedOp.Inputs.Pack(big.NewInt(2), "AA25 invalid account nonce")
+	require.NoError(t, err)
+	opIndex, reason, ok := unpackFailedOp(withSelector(failedOp, data))
+	require.True(t, ok)
+	require.EqualValues(t, 2, opIndex)
+	require.Equal(t, "AA25 invalid account nonce", reason)
+
+	_, _, ok = unpackFailedOp(withSelector(entryPointABI.Errors["ValidationResult"], data))
+	require.False(t, ok)
+	_, _, ok = unpackFailedOp(nil)
+	require.False(t, ok)
+}
+
+func TestCheckValidationRules(t *testing.T) {
+	sender := gethcommon.BytesToAddress([]byte{0xa})
+	paymaster := gethcommon.BytesToAddress([]byte{0xb})
+	token := gethcommon.BytesToAddress([]byte{0xc})
+	op := testUserOp(sender, 0, 100)
+	op.PaymasterAndData = paymaster.Bytes()
+
+	unstaked := stakeInfo{Stake: big.NewInt(0), UnstakeDelaySec: big.NewInt(0)}
+	staked := stakeInfo{Stake: big.NewInt(1), UnstakeDelaySec: big.NewInt(86400)}
+	res := &validationResult{FactoryInfo: unstaked, PaymasterInfo: unstaked}
+
+	enter := func(to gethcommon.Address) evm.ERC7562Step {
+		return evm.ERC7562Step{Op: "CALL", Enter: true, To: &to}
+	}
+	exit := evm.ERC7562Step{Exit: true}
+	step := func(op string) evm.ERC7562Step {
+		return evm.ERC7562Step{Op: op}
+	}
+	storage := func(op string, slot gethcommon.Hash) evm.ERC7562Step {
+		return evm.ERC7562Step{Op: op, Slot: &slot}
+	}
+	check := func(res *validationResult, steps ...evm.ERC7562Step) error {
+		return checkValidationRules(append([]evm.ERC7562Step{enter(testEntryPoint)}, steps...), op, testEntryPoint, res)
+	}
+	one := gethcommon.BigToHash(big.NewInt(1))
+
+	// The EntryPoint itself is not restricted.
+	require.NoError(t, check(res, step("TIMESTAMP"), storage("SLOAD", one)))
+
+	// Banned opcodes, in the sender and in the contracts it calls.
+	require.ErrorContains(t, check(res, enter(sender), step("TIMESTAMP")), "account uses the banned opcode TIMESTAMP")
+	require.ErrorContains(t, check(res, enter(sender), enter(token), step("NUMBER")), "account uses the banned opcode NUMBER")
+	require.ErrorContains(t, check(res, enter(paymaster), step("GAS")), "paymaster uses the banned opcode GAS")
+	require.ErrorContains(t, check(res, enter(sender), step("CREATE2")), "banned opcode CREATE2")
+	require.NoError(t, check(res, enter(sender), exit, step("TIMESTAMP")), "back in the EntryPoint")
+
+	// Storage of the sender, and storage associated with the sender.
+	require.NoError(t, check(res, enter(sender), storage("SSTORE", gethcommon.BigToHash(big.NewInt(5)))))
+	require.NoError(t, check(res, enter(paymaster), enter(token), storage("SLOAD", gethcommon.BytesToHash(sender.Bytes()))))
+	mappingKey := append(gethcommon.LeftPadBytes(sender.Bytes(), 32), make([]byte, 32)...)
+	balanceSlot := gethcrypto.Keccak256Hash(mappingKey)
+	keccak := evm.ERC7562Step{Op: "KECCAK256", KeccakInput: mappingKey}
+	require.NoError(t, check(res, enter(paymaster), enter(token), keccak, storage("SLOAD", balanceSlot)))
+
+	// Storage of the paymaster, only if it is staked.
+	require.ErrorContains(t, check(res, enter(paymaster), storage("SLOAD", one)), "not associated with the sender")
+	require.ErrorContains(t, check(res, enter(sender), enter(token), storage("SLOAD", one)), "not associated with the sender")
+	stakedRes := &validationResult{FactoryInfo: unstaked, PaymasterInfo: staked}
+	require.NoError(t, check(stakedRes, enter(paymaster), storage("SLOAD", one)))
+
+	// A delegate call runs on the storage of the caller.
+	delegate := evm.ERC7562Step{Op: "DELEGATECALL", Enter: true, To: &token}
+	require.NoError(t, check(res, enter(sender), delegate, storage("SLOAD", one)))
+}
+
+func TestUserOpPoolRequeue(t *testing.T) {
+	pool := &UserOpPool{
+		logger:    log.NewNopLogger(),
+		pending:   make(map[gethcommon.Hash]*pooledUserOp),
+		submitted: make(map[gethcommon.Hash]*pooledUserOp),
+	}
+	included := &pooledUserOp{hash: gethcommon.HexToHash("0x01"), txHash: gethcommon.HexToHash("0xaa")}
+	reverted := &pooledUserOp{hash: gethcommon.HexToHash("0x02"), txHash: gethcommon.HexToHash("0xbb")}
+	pool.submitted[included.hash] = included
+	pool.submitted[reverted.hash] = reverted
+
+	pool.markBundle(included.txHash, false)
+	pool.markBundle(reverted.txHash, true)
+	require.True(t, included.included)
+	require.Contains(t, pool.submitted, included.hash)
+	require.NotContains(t, pool.submitted, reverted.hash)
+	require.Contains(t, pool.pending, reverted.hash)
+	require.Equal(t, gethcommon.Hash{}, reverted.txHash)
+}
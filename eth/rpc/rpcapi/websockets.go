@@ -10,8 +10,10 @@ import (
 	"math/big"
 	"net"
 	"net/http"
+	"slices"
 	"strconv"
 	"sync"
+	"sync/atomic"
 
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
@@ -20,11 +22,13 @@ import (
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/client"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/cometbft/cometbft/libs/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
 
@@ -68,6 +72,10 @@ type ErrorMessageJSON struct {
 	Message string   `json:"message"`
 }
 
+// maxTraceChainSubscriptions is the max number of "traceChain" subscriptions
+// that stream traces at the same time on a WebSocket connection.
+const maxTraceChainSubscriptions = 1
+
 type websocketsServer struct {
 	rpcAddr  string // listen address of rest-server
 	wsAddr   string // listen address of ws server
@@ -75,6 +83,11 @@ type websocketsServer struct {
 	keyFile  string
 	api      *pubSubAPI
 	logger   log.Logger
+	// debugEnabled is true if the "debug" namespace is enabled, which
+	// "debug_subscribe" needs.
+	debugEnabled bool
+	// blockRangeCap is the "block-range-cap" of the JSON-RPC config.
+	blockRangeCap int32
 }
 
 func NewWebsocketsServer(
@@ -93,6 +106,9 @@ func NewWebsocketsServer(
 		keyFile:  cfg.TLS.KeyPath,
 		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
 		logger:   logger,
+
+		debugEnabled:  slices.Contains(cfg.JSONRPC.API, NamespaceDebug),
+		blockRangeCap: cfg.JSONRPC.BlockRangeCap,
 	}
 }
 
@@ -155,6 +171,9 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+	// traceChains is the number of "traceChain" subscriptions that are
+	// streaming traces on the connection.
+	traceChains atomic.Int32
 }
 
 func (w *wsConn) WriteJSON(v any) error {
@@ -238,14 +257,19 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 		}
 
 		switch method {
-		case "eth_subscribe":
+		case "eth_subscribe", "debug_subscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
 				continue
 			}
 
 			subID := gethrpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
+			var unsubFn pubsub.UnsubscribeFunc
+			if method == "eth_subscribe" {
+				unsubFn, err = s.api.subscribe(wsConn, subID, params)
+			} else {
+				unsubFn, err = s.subscribeDebug(wsConn, subID, params)
+			}
 			if err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
@@ -261,7 +285,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			if err := wsConn.WriteJSON(res); err != nil {
 				break
 			}
-		case "eth_unsubscribe":
+		case "eth_unsubscribe", "debug_unsubscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
 				continue
@@ -345,6 +369,172 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	return wsConn.WriteJSON(wsSend)
 }
 
+// tcpCall connects to the rest-server over tcp, posts a JSON-RPC request, and
+// returns its result.
+func (s *websocketsServer) tcpCall(method string, params ...any) (json.RawMessage, error) {
+	reqBz, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "POST", "http://"+s.rpcAddr, bytes.NewBuffer(reqBz))
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Could not build request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "Could not perform request")
+	}
+
+	defer resp.Body.Close()
+
+	var rpcResp struct {
+		Result json.RawMessage   `json:"result"`
+		Error  *ErrorMessageJSON `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to unmarshal rest-server response")
+	}
+	if rpcResp.Error != nil {
+		return nil, pkgerrors.New(rpcResp.Error.Message)
+	}
+	return rpcResp.Result, nil
+}
+
+// subscribeDebug handles the subscriptions of the debug namespace, which only
+// has "traceChain". It fails if the debug namespace is not enabled.
+func (s *websocketsServer) subscribeDebug(
+	wsConn *wsConn, subID gethrpc.ID, params []any,
+) (pubsub.UnsubscribeFunc, error) {
+	if !s.debugEnabled {
+		return nil, pkgerrors.Errorf("the %s namespace is not enabled", NamespaceDebug)
+	}
+	method, ok := params[0].(string)
+	if !ok {
+		return nil, pkgerrors.New("invalid parameters")
+	}
+
+	switch method {
+	case "traceChain":
+		return s.subscribeTraceChain(wsConn, subID, params[1:])
+	default:
+		return nil, pkgerrors.Errorf("unsupported method %s", method)
+	}
+}
+
+// subscribeTraceChain streams the traces of the blocks from "start" to "end",
+// excluding "start" and including "end", with one "debug_subscription"
+// notification per block. Each block is traced with a "debug_traceChain"
+// request for that block alone. Like over HTTP, the range may span at most
+// the "block-range-cap" of the JSON-RPC config, and a connection streams at
+// most [maxTraceChainSubscriptions] ranges at a time.
+func (s *websocketsServer) subscribeTraceChain(
+	wsConn *wsConn, subID gethrpc.ID, params []any,
+) (pubsub.UnsubscribeFunc, error) {
+	if len(params) < 2 {
+		return nil, pkgerrors.New("traceChain requires a start and an end block")
+	}
+	start, err := s.resolveBlockNumber(params[0])
+	if err != nil {
+		return nil, err
+	}
+	end, err := s.resolveBlockNumber(params[1])
+	if err != nil {
+		return nil, err
+	}
+	if start >= end {
+		return nil, pkgerrors.Errorf("end block #%d needs to come after start block #%d", end, start)
+	}
+	if blockRangeCap := uint64(max(s.blockRangeCap, 0)); end-start > blockRangeCap {
+		return nil, pkgerrors.Errorf("block range %d exceeds the block range cap %d", end-start, blockRangeCap)
+	}
+	var traceConfig any
+	if len(params) > 2 {
+		traceConfig = params[2]
+	}
+	if wsConn.traceChains.Add(1) > maxTraceChainSubscriptions {
+		wsConn.traceChains.Add(-1)
+		return nil, pkgerrors.Errorf(
+			"at most %d traceChain subscriptions can stream at a time", maxTraceChainSubscriptions)
+	}
+
+	done := make(chan struct{})
+	var once sync.Once
+	go func() {
+		defer wsConn.traceChains.Add(-1)
+		for height := start + 1; height <= end; height++ {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			resultBz, err := s.tcpCall(
+				"debug_traceChain", hexutil.Uint64(height-1), hexutil.Uint64(height), traceConfig,
+			)
+			if err != nil {
+				s.logger.Debug("dropping traceChain WebSocket subscription", "subscription-id", subID, "error", err.Error())
+				return
+			}
+			var blockTraces []json.RawMessage
+			if err := json.Unmarshal(resultBz, &blockTraces); err != nil {
+				s.logger.Debug("dropping traceChain WebSocket subscription", "subscription-id", subID, "error", err.Error())
+				return
+			}
+
+			for _, blockTrace := range blockTraces {
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "debug_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       blockTrace,
+					},
+				}
+				if err := wsConn.WriteJSON(res); err != nil {
+					s.logger.Debug("error writing block trace, will drop peer", "error", err.Error())
+					return
+				}
+			}
+		}
+	}()
+
+	return func() { once.Do(func() { close(done) }) }, nil
+}
+
+// resolveBlockNumber parses a block number parameter, resolving the "latest"
+// and "pending" tags to the latest block.
+func (s *websocketsServer) resolveBlockNumber(param any) (uint64, error) {
+	paramBz, err := json.Marshal(param)
+	if err != nil {
+		return 0, err
+	}
+	var blockNum rpc.BlockNumber
+	if err := blockNum.UnmarshalJSON(paramBz); err != nil {
+		return 0, pkgerrors.Wrapf(err, "invalid block number %s", paramBz)
+	}
+	if blockNum >= 0 {
+		return uint64(blockNum), nil
+	}
+
+	resultBz, err := s.tcpCall("eth_blockNumber")
+	if err != nil {
+		return 0, err
+	}
+	var latest hexutil.Uint64
+	if err := json.Unmarshal(resultBz, &latest); err != nil {
+		return 0, err
+	}
+	return uint64(latest), nil
+}
+
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
 	events    *EventSubscriber
@@ -693,8 +883,85 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID gethrpc
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ gethrpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, pkgerrors.New("syncing subscription is not implemented")
+// subscribeSyncing notifies the subscriber whenever the node starts or stops
+// syncing, following the catching-up status of CometBFT. The status is checked
+// on every new block header, since CometBFT has no event for it.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID gethrpc.ID) (pubsub.UnsubscribeFunc, error) {
+	status, err := querySyncingResult(context.Background(), api.clientCtx.Client)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error querying the node status")
+	}
+	sub, unsubFn, err := api.events.SubscribeNewHeads()
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error creating block filter")
+	}
+
+	query := func() (SyncingResult, error) {
+		return querySyncingResult(context.Background(), api.clientCtx.Client)
+	}
+	notify := func(result SyncingResult) {
+		// write to ws conn
+		res := &SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "eth_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       result,
+			},
+		}
+
+		err := wsConn.WriteJSON(res)
+		if err != nil {
+			api.logger.Error("error writing sync status, will drop peer", "error", err.Error())
+
+			try(func() {
+				if err != websocket.ErrCloseSent {
+					_ = wsConn.Close() // #nosec G703
+				}
+			}, api.logger, "closing websocket peer sub")
+		}
+	}
+	go streamSyncing(sub.EventCh, sub.Error(), status.Syncing, query, notify, api.logger.With("subscription-id", subID))
+
+	return unsubFn, nil
+}
+
+// streamSyncing queries the sync status with "query" on every new block header
+// and passes it to "notify" when the node started or stopped syncing since the
+// last status, which is initially "syncing". It returns when the header
+// channel or the error channel closes.
+func streamSyncing(
+	headersCh <-chan coretypes.ResultEvent,
+	errCh <-chan error,
+	syncing bool,
+	query func() (SyncingResult, error),
+	notify func(SyncingResult),
+	logger log.Logger,
+) {
+	for {
+		select {
+		case _, ok := <-headersCh:
+			if !ok {
+				return
+			}
+
+			result, err := query()
+			if err != nil {
+				logger.Debug("failed to query the node status", "error", err.Error())
+				continue
+			}
+			if result.Syncing == syncing {
+				continue
+			}
+			syncing = result.Syncing
+			notify(result)
+		case err, ok := <-errCh:
+			if !ok {
+				return
+			}
+			logger.Debug("dropping Syncing WebSocket subscription", "error", err.Error())
+		}
+	}
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
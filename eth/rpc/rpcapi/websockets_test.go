@@ -0,0 +1,69 @@
+package rpcapi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribeTraceChainLimits(t *testing.T) {
+	s := &websocketsServer{logger: log.NewNopLogger(), blockRangeCap: 10}
+	conn := &wsConn{}
+	subscribe := func(start, end string) error {
+		_, err := s.subscribeDebug(conn, gethrpc.NewID(), []any{"traceChain", start, end})
+		return err
+	}
+
+	require.ErrorContains(t, subscribe("0x1", "0x2"), "debug namespace is not enabled")
+
+	s.debugEnabled = true
+	require.ErrorContains(t, subscribe("0x2", "0x2"), "needs to come after start block")
+	require.ErrorContains(t, subscribe("0x1", "0x20"), "block range 31 exceeds the block range cap 10")
+
+	// A connection streams one range at a time.
+	conn.traceChains.Add(maxTraceChainSubscriptions)
+	require.ErrorContains(t, subscribe("0x1", "0x2"), "traceChain subscriptions can stream at a time")
+	require.EqualValues(t, maxTraceChainSubscriptions, conn.traceChains.Load())
+}
+
+func TestStreamSyncing(t *testing.T) {
+	headersCh := make(chan coretypes.ResultEvent)
+	errCh := make(chan error)
+	statuses := []SyncingResult{
+		{Syncing: true, Status: SyncProgress{CurrentBlock: 10, HighestBlock: 100}},
+		{Syncing: true, Status: SyncProgress{CurrentBlock: 50, HighestBlock: 100}},
+		{Syncing: false, Status: SyncProgress{CurrentBlock: 100, HighestBlock: 100}},
+		{Syncing: false, Status: SyncProgress{CurrentBlock: 101, HighestBlock: 101}},
+	}
+	query := func() (SyncingResult, error) {
+		result := statuses[0]
+		statuses = statuses[1:]
+		return result, nil
+	}
+	var notified []SyncingResult
+	notify := func(result SyncingResult) {
+		notified = append(notified, result)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		streamSyncing(headersCh, errCh, false, query, notify, log.NewNopLogger())
+		close(done)
+	}()
+	for range 4 {
+		headersCh <- coretypes.ResultEvent{}
+	}
+	errCh <- errors.New("subscription error")
+	close(headersCh)
+	<-done
+
+	// Only the changes of the syncing status are notified.
+	require.Equal(t, []SyncingResult{
+		{Syncing: true, Status: SyncProgress{CurrentBlock: 10, HighestBlock: 100}},
+		{Syncing: false, Status: SyncProgress{CurrentBlock: 100, HighestBlock: 100}},
+	}, notified)
+}
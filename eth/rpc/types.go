@@ -9,6 +9,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/NibiruChain/nibiru/v2/evm"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -55,21 +57,115 @@ type EthTxJsonRPC struct {
 	S                *hexutil.Big         `json:"s"`
 }
 
-// StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
-
-// OverrideAccount indicates the overriding fields of account during the execution of
-// a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+// StateOverride is the collection of overridden accounts. See
+// [evm.StateOverride].
+type StateOverride = evm.StateOverride
+
+// OverrideAccount indicates the overriding fields of account during the
+// execution of a message call. See [evm.OverrideAccount].
+type OverrideAccount = evm.OverrideAccount
+
+// BlockOverrides is the set of block header fields to override during the
+// execution of a message call. See [evm.BlockOverrides].
+type BlockOverrides = evm.BlockOverrides
+
+// AccessListResult is the result of "eth_createAccessList": the access list
+// of a call, the gas it uses with that access list, and its execution error,
+// if any.
+type AccessListResult struct {
+	AccessList *gethcore.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
+// ParityTrace is a call frame in the flat format of the Parity/OpenEthereum
+// "trace" namespace. It matches the output of geth's "flatCallTracer": one
+// element per call, with "traceAddress" giving its position in the call tree.
+type ParityTrace struct {
+	Action              ParityTraceAction  `json:"action"`
+	BlockHash           *common.Hash       `json:"blockHash"`
+	BlockNumber         uint64             `json:"blockNumber"`
+	Error               string             `json:"error,omitempty"`
+	Result              *ParityTraceResult `json:"result,omitempty"`
+	Subtraces           int                `json:"subtraces"`
+	TraceAddress        []int              `json:"traceAddress"`
+	TransactionHash     *common.Hash       `json:"transactionHash"`
+	TransactionPosition uint64             `json:"transactionPosition"`
+	Type                string             `json:"type"`
+}
+
+// ParityTraceAction is the "action" of a [ParityTrace]. The fields set depend
+// on the trace type: "call", "create" or "suicide".
+type ParityTraceAction struct {
+	Author         *common.Address `json:"author,omitempty"`
+	RewardType     string          `json:"rewardType,omitempty"`
+	SelfDestructed *common.Address `json:"address,omitempty"`
+	Balance        *hexutil.Big    `json:"balance,omitempty"`
+	CallType       string          `json:"callType,omitempty"`
+	CreationMethod string          `json:"creationMethod,omitempty"`
+	From           *common.Address `json:"from,omitempty"`
+	Gas            *hexutil.Uint64 `json:"gas,omitempty"`
+	Init           *hexutil.Bytes  `json:"init,omitempty"`
+	Input          *hexutil.Bytes  `json:"input,omitempty"`
+	RefundAddress  *common.Address `json:"refundAddress,omitempty"`
+	To             *common.Address `json:"to,omitempty"`
+	Value          *hexutil.Big    `json:"value,omitempty"`
+}
+
+// ParityTraceResult is the "result" of a successful [ParityTrace].
+type ParityTraceResult struct {
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+}
+
+// FromAddress returns the address a trace originates from, as matched by the
+// "fromAddress" filter of "trace_filter": the caller of calls and creations,
+// or the destroyed contract of a "suicide".
+func (t ParityTrace) FromAddress() *common.Address {
+	if t.Action.SelfDestructed != nil {
+		return t.Action.SelfDestructed
+	}
+	return t.Action.From
+}
+
+// ToAddress returns the address a trace is sent to, as matched by the
+// "toAddress" filter of "trace_filter": the callee of calls, the created
+// contract of creations, or the beneficiary of a "suicide".
+func (t ParityTrace) ToAddress() *common.Address {
+	switch {
+	case t.Action.RefundAddress != nil:
+		return t.Action.RefundAddress
+	case t.Action.To != nil:
+		return t.Action.To
+	case t.Result != nil:
+		return t.Result.Address
+	}
+	return nil
+}
+
+// ParityTraceResults is the result of "trace_call" and
+// "trace_replayTransaction". Only the "trace" trace type is supported, so
+// "stateDiff" and "vmTrace" are always null.
+type ParityTraceResults struct {
+	Output    hexutil.Bytes `json:"output"`
+	StateDiff any           `json:"stateDiff"`
+	Trace     []ParityTrace `json:"trace"`
+	VmTrace   any           `json:"vmTrace"`
+}
+
+// TraceFilterArgs are the arguments of "trace_filter". Traces match if they
+// come from one of "fromAddress" and go to one of "toAddress", where an empty
+// list matches any address. "after" skips the first matching traces and
+// "count" limits the number of traces returned.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
 }
 
 type FeeHistoryResult struct {
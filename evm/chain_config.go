@@ -32,7 +32,8 @@ func EthereumConfig(chainID *big.Int) *params.ChainConfig {
 		// Shanghai switch time (nil = no fork, 0 => already on shanghai)
 		ShanghaiTime: ptrU64(0),
 		// CancunTime switch time (nil = no fork, 0 => already on cancun)
-		CancunTime:              nil, // nil => disable "blobs"
+		// Gated by the "cancun_time" EVM param. See [Params.EthereumConfig].
+		CancunTime:              nil,
 		PragueTime:              nil, // nil => disable EIP-7702, blob improvements, and increased CALL gas costs
 		VerkleTime:              nil, // nil => disable stateless verification
 		TerminalTotalDifficulty: nil,
@@ -41,6 +42,23 @@ func EthereumConfig(chainID *big.Int) *params.ChainConfig {
 	}
 }
 
+// EthereumConfig returns the Ethereum ChainConfig of [EthereumConfig] with the
+// forks that are gated by the EVM module params activated.
+//
+// Cancun activates at [Params.CancunTime] when it is non-zero. Nibiru has no
+// blob transactions, so only the execution changes of Cancun apply: transient
+// storage (EIP-1153), MCOPY (EIP-5656), the point evaluation precompile
+// (EIP-4844), BLOBBASEFEE (EIP-7516), and SELFDESTRUCT only in the same
+// transaction (EIP-6780).
+func (p Params) EthereumConfig(chainID *big.Int) *params.ChainConfig {
+	cfg := EthereumConfig(chainID)
+	if p.CancunTime != 0 {
+		cancunTime := p.CancunTime
+		cfg.CancunTime = &cancunTime
+	}
+	return cfg
+}
+
 func ptrU64(n uint) *uint64 {
 	u64 := uint64(n)
 	return &u64
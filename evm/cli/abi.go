@@ -0,0 +1,326 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+)
+
+// ParseMethodSig parses a human-readable function signature like
+// "transfer(address,uint256)" or "balanceOf(address)(uint256)" into an ABI
+// method. The optional second parenthesized list declares the return types,
+// which are only needed to decode the result of a call.
+func ParseMethodSig(sig string) (gethabi.Method, error) {
+	sig = strings.TrimSpace(sig)
+	open := strings.Index(sig, "(")
+	if open < 0 {
+		return gethabi.Method{}, fmt.Errorf("invalid function signature \"%s\": missing \"(\"", sig)
+	}
+	name := strings.TrimSpace(sig[:open])
+
+	inputList, rest, err := cutParens(sig[open:])
+	if err != nil {
+		return gethabi.Method{}, fmt.Errorf("invalid function signature \"%s\": %w", sig, err)
+	}
+	inputs, err := parseArgTypes(inputList)
+	if err != nil {
+		return gethabi.Method{}, fmt.Errorf("invalid function signature \"%s\": %w", sig, err)
+	}
+
+	var outputs gethabi.Arguments
+	if rest = strings.TrimSpace(rest); rest != "" {
+		outputList, trailing, err := cutParens(rest)
+		if err != nil || strings.TrimSpace(trailing) != "" {
+			return gethabi.Method{}, fmt.Errorf("invalid function signature \"%s\": malformed return types", sig)
+		}
+		if outputs, err = parseArgTypes(outputList); err != nil {
+			return gethabi.Method{}, fmt.Errorf("invalid function signature \"%s\": %w", sig, err)
+		}
+	}
+
+	kind := gethabi.Function
+	if name == "" || name == "constructor" {
+		kind = gethabi.Constructor
+		name = ""
+	}
+	return gethabi.NewMethod(name, name, kind, "", false, false, inputs, outputs), nil
+}
+
+// cutParens returns the contents of the parenthesized list at the start of s
+// and whatever follows its closing parenthesis.
+func cutParens(s string) (inner, rest string, err error) {
+	if !strings.HasPrefix(s, "(") {
+		return "", "", fmt.Errorf("expected \"(\"")
+	}
+	depth := 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return s[1:i], s[i+1:], nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("unbalanced parentheses")
+}
+
+// splitTopLevel splits s on the commas that are not nested inside brackets
+// or parentheses.
+func splitTopLevel(s string) []string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	var (
+		parts []string
+		depth int
+		start int
+	)
+	for i, r := range s {
+		switch r {
+		case '(', '[':
+			depth++
+		case ')', ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, strings.TrimSpace(s[start:]))
+}
+
+func parseArgTypes(list string) (gethabi.Arguments, error) {
+	var args gethabi.Arguments
+	for _, field := range splitTopLevel(list) {
+		// Allow parameter names, as in "transfer(address to, uint256 amount)".
+		typeStr, _, _ := strings.Cut(field, " ")
+		if strings.HasPrefix(typeStr, "(") {
+			return nil, fmt.Errorf("tuple types are not supported")
+		}
+		typ, err := gethabi.NewType(typeStr, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, gethabi.Argument{Type: typ})
+	}
+	return args, nil
+}
+
+// PackArgs converts the string arguments to the Go values expected by the
+// ABI encoder, in the same notation accepted by "cast": hex or bech32
+// addresses, decimal or 0x-prefixed integers, "true"/"false", 0x-prefixed
+// bytes, and arrays written as "[a,b,c]".
+func PackArgs(inputs gethabi.Arguments, args []string) ([]byte, error) {
+	if len(args) != len(inputs) {
+		return nil, fmt.Errorf("expected %d argument(s), received %d", len(inputs), len(args))
+	}
+	values := make([]any, len(args))
+	for i, arg := range args {
+		value, err := parseArgValue(inputs[i].Type, arg)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d (%s): %w", i, inputs[i].Type, err)
+		}
+		values[i] = value.Interface()
+	}
+	return inputs.Pack(values...)
+}
+
+func parseArgValue(typ gethabi.Type, s string) (reflect.Value, error) {
+	s = strings.TrimSpace(s)
+	switch typ.T {
+	case gethabi.AddressTy:
+		addr, err := parseAddr(s)
+		return reflect.ValueOf(addr), err
+
+	case gethabi.IntTy, gethabi.UintTy:
+		n, ok := new(big.Int).SetString(s, 0)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("invalid integer \"%s\"", s)
+		}
+		if typ.T == gethabi.UintTy && n.Sign() < 0 {
+			return reflect.Value{}, fmt.Errorf("negative value for unsigned integer")
+		}
+		maxBits := typ.Size
+		if typ.T == gethabi.IntTy {
+			maxBits-- // sign bit
+			if n.Sign() < 0 {
+				// -2^(size-1) is the smallest value, so compare |n|-1.
+				n2 := new(big.Int).Neg(n)
+				if n2.Sub(n2, big.NewInt(1)).BitLen() > maxBits {
+					return reflect.Value{}, fmt.Errorf("%s overflows %s", s, typ)
+				}
+				maxBits = n.BitLen()
+			}
+		}
+		if n.BitLen() > maxBits {
+			return reflect.Value{}, fmt.Errorf("%s overflows %s", s, typ)
+		}
+		value := reflect.New(typ.GetType()).Elem()
+		switch {
+		case typ.Size > 64:
+			value.Set(reflect.ValueOf(n))
+		case typ.T == gethabi.UintTy:
+			value.SetUint(n.Uint64())
+		default:
+			value.SetInt(n.Int64())
+		}
+		return value, nil
+
+	case gethabi.BoolTy:
+		b, err := strconv.ParseBool(s)
+		return reflect.ValueOf(b), err
+
+	case gethabi.StringTy:
+		return reflect.ValueOf(s), nil
+
+	case gethabi.BytesTy:
+		bz, err := hexutil.Decode(s)
+		return reflect.ValueOf(bz), err
+
+	case gethabi.FixedBytesTy:
+		bz, err := hexutil.Decode(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if len(bz) != typ.Size {
+			return reflect.Value{}, fmt.Errorf("expected %d bytes, got %d", typ.Size, len(bz))
+		}
+		value := reflect.New(typ.GetType()).Elem()
+		reflect.Copy(value, reflect.ValueOf(bz))
+		return value, nil
+
+	case gethabi.SliceTy, gethabi.ArrayTy:
+		if !strings.HasPrefix(s, "[") || !strings.HasSuffix(s, "]") {
+			return reflect.Value{}, fmt.Errorf("expected an array like \"[a,b]\", got \"%s\"", s)
+		}
+		elems := splitTopLevel(s[1 : len(s)-1])
+		var value reflect.Value
+		if typ.T == gethabi.ArrayTy {
+			if len(elems) != typ.Size {
+				return reflect.Value{}, fmt.Errorf("expected %d elements, got %d", typ.Size, len(elems))
+			}
+			value = reflect.New(typ.GetType()).Elem()
+		} else {
+			value = reflect.MakeSlice(typ.GetType(), len(elems), len(elems))
+		}
+		for i, elem := range elems {
+			elemValue, err := parseArgValue(*typ.Elem, elem)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			value.Index(i).Set(elemValue)
+		}
+		return value, nil
+	}
+	return reflect.Value{}, fmt.Errorf("unsupported type %s", typ)
+}
+
+// parseAddr accepts either a hex address or a bech32 "nibi1..." address.
+func parseAddr(s string) (gethcommon.Address, error) {
+	if gethcommon.IsHexAddress(s) {
+		return gethcommon.HexToAddress(s), nil
+	}
+	addrBech32, err := sdk.AccAddressFromBech32(s)
+	if err != nil {
+		return gethcommon.Address{}, fmt.Errorf("invalid address \"%s\": not a hex or bech32 address", s)
+	}
+	return eth.NibiruAddrToEthAddr(addrBech32), nil
+}
+
+// FormatValue renders a value decoded by the ABI package the way "cast"
+// prints it: byte strings in hex and numbers in decimal.
+func FormatValue(v any) string {
+	switch v := v.(type) {
+	case []byte:
+		return hexutil.Encode(v)
+	case gethcommon.Address:
+		return v.Hex()
+	case *big.Int:
+		return v.String()
+	case string:
+		return strconv.Quote(v)
+	}
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, value.Len())
+			reflect.Copy(reflect.ValueOf(bz), value)
+			return hexutil.Encode(bz)
+		}
+		fallthrough
+	case reflect.Slice:
+		elems := make([]string, value.Len())
+		for i := range elems {
+			elems[i] = FormatValue(value.Index(i).Interface())
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	}
+	return fmt.Sprint(v)
+}
+
+// loadDeployCode returns the creation bytecode and constructor ABI for the
+// "deploy" command. The input is either 0x-prefixed bytecode or the path to a
+// Hardhat or Foundry artifact JSON file.
+func loadDeployCode(input string) (bytecode []byte, constructor *gethabi.Method, err error) {
+	if strings.HasPrefix(input, "0x") {
+		bytecode, err = hexutil.Decode(input)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid bytecode: %w", err)
+		}
+		return bytecode, nil, nil
+	}
+
+	artifactBz, err := os.ReadFile(input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("expected 0x-prefixed bytecode or an artifact file: %w", err)
+	}
+	var artifact struct {
+		ABI      json.RawMessage `json:"abi"`
+		Bytecode json.RawMessage `json:"bytecode"`
+	}
+	if err := json.Unmarshal(artifactBz, &artifact); err != nil {
+		return nil, nil, fmt.Errorf("invalid artifact %s: %w", input, err)
+	}
+
+	// Hardhat stores the bytecode as a string, Foundry as {"object": "0x..."}.
+	var bytecodeStr string
+	if err := json.Unmarshal(artifact.Bytecode, &bytecodeStr); err != nil {
+		var foundry struct {
+			Object string `json:"object"`
+		}
+		if err := json.Unmarshal(artifact.Bytecode, &foundry); err != nil {
+			return nil, nil, fmt.Errorf("artifact %s has no bytecode", input)
+		}
+		bytecodeStr = foundry.Object
+	}
+	if bytecode, err = hexutil.Decode(bytecodeStr); err != nil || len(bytecode) == 0 {
+		return nil, nil, fmt.Errorf("artifact %s has no valid bytecode", input)
+	}
+
+	if len(artifact.ABI) > 0 {
+		contractABI := new(gethabi.ABI)
+		if err := contractABI.UnmarshalJSON(artifact.ABI); err != nil {
+			return nil, nil, fmt.Errorf("invalid ABI in artifact %s: %w", input, err)
+		}
+		constructor = &contractABI.Constructor
+	}
+	return bytecode, constructor, nil
+}
@@ -0,0 +1,97 @@
+package cli_test
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/evm/cli"
+)
+
+func TestParseMethodSig(t *testing.T) {
+	method, err := cli.ParseMethodSig("transfer(address to, uint256 amount)")
+	require.NoError(t, err)
+	require.Equal(t, "transfer(address,uint256)", method.Sig)
+	require.Equal(t, "0xa9059cbb", hexutil.Encode(method.ID))
+	require.Empty(t, method.Outputs)
+
+	method, err = cli.ParseMethodSig("getReserves()(uint112,uint112,uint32)")
+	require.NoError(t, err)
+	require.Empty(t, method.Inputs)
+	require.Len(t, method.Outputs, 3)
+
+	method, err = cli.ParseMethodSig("constructor(string,uint8[2])")
+	require.NoError(t, err)
+	require.Len(t, method.Inputs, 2)
+
+	for sig, wantErr := range map[string]string{
+		"transfer":              "missing \"(\"",
+		"transfer(address":      "unbalanced parentheses",
+		"f(foo)":                "unsupported arg type",
+		"f((uint256,address))":  "tuple types are not supported",
+		"f()(uint256) trailing": "malformed return types",
+	} {
+		_, err := cli.ParseMethodSig(sig)
+		require.ErrorContains(t, err, wantErr, sig)
+	}
+}
+
+func TestPackArgs(t *testing.T) {
+	addr := gethcommon.BytesToAddress([]byte{0xab})
+	method, err := cli.ParseMethodSig("f(address,address,uint8,int256,bool,string,bytes,bytes4,uint256[])")
+	require.NoError(t, err)
+	packed, err := cli.PackArgs(method.Inputs, []string{
+		addr.Hex(),
+		eth.EthAddrToNibiruAddr(addr).String(),
+		"0xff",
+		"-5",
+		"true",
+		"hello",
+		"0xdeadbeef",
+		"0x01020304",
+		"[1, 2, 3]",
+	})
+	require.NoError(t, err)
+
+	values, err := method.Inputs.Unpack(packed)
+	require.NoError(t, err)
+	require.Equal(t, addr, values[0])
+	require.Equal(t, addr, values[1], "bech32 address")
+	require.EqualValues(t, 255, values[2])
+	require.Equal(t, big.NewInt(-5), values[3])
+	require.Equal(t, true, values[4])
+	require.Equal(t, "hello", values[5])
+	require.Equal(t, "0xdeadbeef", cli.FormatValue(values[6]))
+	require.Equal(t, "0x01020304", cli.FormatValue(values[7]))
+	require.Equal(t, "[1, 2, 3]", cli.FormatValue(values[8]))
+
+	for _, tc := range []struct {
+		sig     string
+		arg     string
+		wantErr string
+	}{
+		{sig: "f(uint8)", arg: "256", wantErr: "overflows uint8"},
+		{sig: "f(int8)", arg: "128", wantErr: "overflows int8"},
+		{sig: "f(int8)", arg: "-129", wantErr: "overflows int8"},
+		{sig: "f(uint256)", arg: "-1", wantErr: "negative value"},
+		{sig: "f(bytes4)", arg: "0x01", wantErr: "expected 4 bytes"},
+		{sig: "f(address)", arg: "nibi1xyz", wantErr: "invalid address"},
+		{sig: "f(uint256[2])", arg: "[1]", wantErr: "expected 2 elements"},
+		{sig: "f(uint256[])", arg: "1,2", wantErr: "expected an array"},
+	} {
+		method, err := cli.ParseMethodSig(tc.sig)
+		require.NoError(t, err)
+		_, err = cli.PackArgs(method.Inputs, []string{tc.arg})
+		require.ErrorContains(t, err, tc.wantErr, tc.sig)
+	}
+
+	// int8 bounds are inclusive
+	method, err = cli.ParseMethodSig("f(int8,int8)")
+	require.NoError(t, err)
+	_, err = cli.PackArgs(method.Inputs, []string{"-128", "127"})
+	require.NoError(t, err)
+}
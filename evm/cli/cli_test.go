@@ -284,3 +284,119 @@ func (s *Suite) TestCmdQueryBalance_LocalnetNibi() {
 	s.Require().NotNil(resp.BankBalanceHuman)
 	s.Require().NotEmpty(*resp.BankBalanceHuman)
 }
+
+func (s *Suite) TestCmdDeploy() {
+	testCases := []TestCase{
+		{
+			name:      "sad: invalid bytecode",
+			args:      []string{"deploy", "0x60zz"},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "invalid bytecode",
+		},
+		{
+			name:      "sad: missing artifact",
+			args:      []string{"deploy", "does-not-exist.json"},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "expected 0x-prefixed bytecode or an artifact file",
+		},
+		{
+			name:      "sad: constructor args without signature",
+			args:      []string{"deploy", "0x6080", "--args=1"},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "constructor arguments require",
+		},
+		{
+			name:      "sad: constructor arg count",
+			args:      []string{"deploy", "0x6080", "--sig=constructor(uint256,address)", "--args=1"},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "expected 2 argument(s), received 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.RunTxCmd(s)
+	}
+}
+
+func (s *Suite) TestCmdCall() {
+	testCases := []TestCase{
+		{
+			name:      "sad: invalid contract address",
+			args:      []string{"call", "not-an-address", "transfer(address,uint256)", dummyEthAddr, "1"},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "invalid address",
+		},
+		{
+			name:      "sad: invalid function signature",
+			args:      []string{"call", dummyEthAddr, "transfer", dummyEthAddr, "1"},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "invalid function signature",
+		},
+		{
+			name:      "sad: invalid argument",
+			args:      []string{"call", dummyEthAddr, "transfer(address,uint256)", dummyEthAddr, "-1"},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "negative value for unsigned integer",
+		},
+		{
+			name:      "sad: too few args",
+			args:      []string{"call", dummyEthAddr},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "requires at least 2 arg(s), only received 1",
+		},
+		{
+			name:      "sad: send invalid amount",
+			args:      []string{"send", dummyEthAddr, "1unibi"},
+			extraArgs: []string{fmt.Sprintf("--from=%s", s.testAcc.Address)},
+			wantErr:   "invalid --value",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.RunTxCmd(s)
+	}
+}
+
+func (s *Suite) TestCmdQueryContract() {
+	testCases := []TestCase{
+		{
+			name:    "happy: query call (raw calldata)",
+			args:    []string{"call", dummyEthAddr, "0x313ce567"},
+			wantErr: "",
+		},
+		{
+			name:    "sad: query call arguments",
+			args:    []string{"call", dummyEthAddr, "balanceOf(address)(uint256)"},
+			wantErr: "expected 1 argument(s), received 0",
+		},
+		{
+			name:    "happy: query storage",
+			args:    []string{"storage", dummyEthAddr, "0x1"},
+			wantErr: "",
+		},
+		{
+			name:    "happy: query storage (bech32)",
+			args:    []string{"storage", dummyAccs[0].NibiruAddr.String(), "7"},
+			wantErr: "",
+		},
+		{
+			name:    "sad: query storage slot",
+			args:    []string{"storage", dummyEthAddr, "slot"},
+			wantErr: "invalid storage slot",
+		},
+		{
+			name:    "happy: query code",
+			args:    []string{"code", dummyEthAddr},
+			wantErr: "",
+		},
+		{
+			name:    "sad: query trace-tx hash",
+			args:    []string{"trace-tx", "0x1234"},
+			wantErr: "invalid transaction hash",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.RunQueryCmd(s)
+	}
+}
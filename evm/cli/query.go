@@ -1,16 +1,24 @@
 package cli
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"strings"
 
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/spf13/cobra"
 
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/client"
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/version"
 
 	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	srvconfig "github.com/NibiruChain/nibiru/v2/app/server/config"
 	"github.com/NibiruChain/nibiru/v2/x/nutil/flags"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
@@ -57,6 +65,10 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryFunToken(),
 		CmdQueryAccount(),
 		CmdQueryBalance(),
+		CmdQueryCall(),
+		CmdQueryStorage(),
+		CmdQueryCode(),
+		CmdQueryTraceTx(),
 	}
 	for _, cmd := range cmds {
 		moduleQueryCmd.AddCommand(cmd)
@@ -238,3 +250,321 @@ func CmdQueryAccount() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+const (
+	FlagCaller           = "caller"
+	FlagTracer           = "tracer"
+	FlagDisableStack     = "disable-stack"
+	FlagDisableStorage   = "disable-storage"
+	FlagEnableMemory     = "enable-memory"
+	FlagEnableReturnData = "enable-return-data"
+)
+
+// CmdQueryCall simulates a contract call with the EthCall query (eth_call)
+func CmdQueryCall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call [contract-addr] [function-sig] [args...]",
+		Short: "Call an EVM contract function without sending a transaction (eth_call)",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Call an EVM contract function without sending a transaction (eth_call).
+
+The calldata is ABI-encoded from the function signature. If the signature
+declares return types, the result is decoded and printed one value per line.
+Otherwise, the raw return data is printed in hex.
+
+Examples:
+  %s query %s call 0x0CaCF669f8446BeCA826913a3c6B96aCD4b02a97 "balanceOf(address)(uint256)" 0x1234...abcd
+  %s query %s call 0x0CaCF669f8446BeCA826913a3c6B96aCD4b02a97 0x313ce567
+`,
+				version.AppName, evm.ModuleName,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := parseAddr(args[0])
+			if err != nil {
+				return err
+			}
+			input, method, err := encodeCalldata(args[1], args[2:])
+			if err != nil {
+				return err
+			}
+			callArgs := evm.JsonTxArgs{
+				To:    &contract,
+				Input: (*hexutil.Bytes)(&input),
+			}
+			if callerStr, _ := cmd.Flags().GetString(FlagCaller); callerStr != "" {
+				caller, err := parseAddr(callerStr)
+				if err != nil {
+					return err
+				}
+				callArgs.From = &caller
+			}
+			valueStr, _ := cmd.Flags().GetString(FlagValue)
+			value, ok := new(big.Int).SetString(valueStr, 10)
+			if !ok || value.Sign() < 0 {
+				return fmt.Errorf("invalid --%s: %s", FlagValue, valueStr)
+			}
+			callArgs.Value = (*hexutil.Big)(value)
+
+			argsBz, err := json.Marshal(callArgs)
+			if err != nil {
+				return err
+			}
+			res, err := evm.NewQueryClient(clientCtx).EthCall(cmd.Context(), &evm.EthCallRequest{
+				Args:    argsBz,
+				GasCap:  srvconfig.DefaultEthCallGasLimit,
+				ChainId: eth.ParseEthChainID(clientCtx.ChainID).Int64(),
+			})
+			if err != nil {
+				return err
+			}
+			if res.Failed() {
+				if res.VmError == vm.ErrExecutionReverted.Error() {
+					return evm.NewRevertError(res.Ret)
+				}
+				return fmt.Errorf("%s", res.VmError)
+			}
+
+			if method == nil || len(method.Outputs) == 0 {
+				return clientCtx.PrintString(hexutil.Encode(res.Ret) + "\n")
+			}
+			values, err := method.Outputs.Unpack(res.Ret)
+			if err != nil {
+				return fmt.Errorf("failed to decode return data %s: %w", hexutil.Encode(res.Ret), err)
+			}
+			lines := make([]string, len(values))
+			for i, value := range values {
+				lines[i] = FormatValue(value)
+			}
+			return clientCtx.PrintString(strings.Join(lines, "\n") + "\n")
+		},
+	}
+	cmd.Flags().String(FlagCaller, "", "Address to call from (hex or bech32)")
+	cmd.Flags().String(FlagValue, "0", "Amount of NIBI to send with the call in wei")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryStorage returns the value of a contract storage slot
+func CmdQueryStorage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage [contract-addr] [slot]",
+		Short: "Query the value of a contract storage slot",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the value of a contract storage slot. The slot is a decimal or
+0x-prefixed number.
+
+Examples:
+  %s query %s storage 0x0CaCF669f8446BeCA826913a3c6B96aCD4b02a97 0
+`,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := parseAddr(args[0])
+			if err != nil {
+				return err
+			}
+			slot, ok := new(big.Int).SetString(args[1], 0)
+			if !ok || slot.Sign() < 0 || slot.BitLen() > 256 {
+				return fmt.Errorf("invalid storage slot: %s", args[1])
+			}
+
+			res, err := evm.NewQueryClient(clientCtx).Storage(cmd.Context(), &evm.QueryStorageRequest{
+				Address: contract.Hex(),
+				Key:     gethcommon.BigToHash(slot).Hex(),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryCode returns the runtime bytecode of a contract
+func CmdQueryCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code [contract-addr]",
+		Short: "Query the runtime bytecode of a contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the runtime bytecode of a contract in hex. Accounts without
+code return "0x".
+
+Examples:
+  %s query %s code 0x0CaCF669f8446BeCA826913a3c6B96aCD4b02a97
+`,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := parseAddr(args[0])
+			if err != nil {
+				return err
+			}
+			res, err := evm.NewQueryClient(clientCtx).Code(cmd.Context(), &evm.QueryCodeRequest{
+				Address: contract.Hex(),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(hexutil.Encode(res.Code) + "\n")
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryTraceTx replays an Ethereum transaction with the TraceTx query
+// (debug_traceTransaction)
+func CmdQueryTraceTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace-tx [eth-tx-hash]",
+		Short: "Trace the execution of an Ethereum transaction (debug_traceTransaction)",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Trace the execution of an Ethereum transaction (debug_traceTransaction).
+
+The transaction is replayed on top of the transactions that precede it in
+its block. Without --tracer, the opcode-level struct logger is used.
+
+Examples:
+  %s query %s trace-tx 0xabc...def
+  %s query %s trace-tx 0xabc...def --tracer callTracer
+`,
+				version.AppName, evm.ModuleName,
+				version.AppName, evm.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hashBz, err := hexutil.Decode(args[0])
+			if err != nil || len(hashBz) != gethcommon.HashLength {
+				return fmt.Errorf("invalid transaction hash: %s", args[0])
+			}
+
+			traceConfig := &evm.TraceConfig{}
+			traceConfig.Tracer, _ = cmd.Flags().GetString(FlagTracer)
+			traceConfig.DisableStack, _ = cmd.Flags().GetBool(FlagDisableStack)
+			traceConfig.DisableStorage, _ = cmd.Flags().GetBool(FlagDisableStorage)
+			traceConfig.EnableMemory, _ = cmd.Flags().GetBool(FlagEnableMemory)
+			traceConfig.EnableReturnData, _ = cmd.Flags().GetBool(FlagEnableReturnData)
+
+			req, err := traceTxRequest(cmd.Context(), clientCtx, gethcommon.BytesToHash(hashBz))
+			if err != nil {
+				return err
+			}
+			req.TraceConfig = traceConfig
+
+			// Trace on the state at the start of the block. Height 0 is the
+			// latest state, so the min height is 1.
+			queryClient := evm.NewQueryClient(clientCtx.WithHeight(max(req.BlockNumber-1, 1)))
+			res, err := queryClient.TraceTx(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(res.Data)
+		},
+	}
+	cmd.Flags().String(FlagTracer, "", "Tracer to use, e.g. \"callTracer\" or \"prestateTracer\"")
+	cmd.Flags().Bool(FlagDisableStack, false, "Disable stack capture in the struct logger")
+	cmd.Flags().Bool(FlagDisableStorage, false, "Disable storage capture in the struct logger")
+	cmd.Flags().Bool(FlagEnableMemory, false, "Enable memory capture in the struct logger")
+	cmd.Flags().Bool(FlagEnableReturnData, false, "Enable return data capture in the struct logger")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// traceTxRequest finds the Ethereum tx with the given hash and its block over
+// the Tendermint RPC, and returns the TraceTx request that replays it on top of
+// the Ethereum txs that precede it in the block.
+func traceTxRequest(
+	ctx context.Context, clientCtx client.Context, hash gethcommon.Hash,
+) (*evm.QueryTraceTxRequest, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf("%s.%s='%s'",
+		evm.PendingEthereumTxEvent, evm.PendingEthereumTxEventAttrEthHash, hash.Hex(),
+	)
+	resTxs, err := node.TxSearch(ctx, query, false, nil, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(resTxs.Txs) == 0 {
+		return nil, fmt.Errorf("ethereum tx not found: %s", hash.Hex())
+	}
+	resTx := resTxs.Txs[0]
+	if resTx.Height == 0 {
+		return nil, fmt.Errorf("genesis is not traceable")
+	}
+
+	blk, err := node.Block(ctx, &resTx.Height)
+	if err != nil {
+		return nil, err
+	}
+	if int(resTx.Index) >= len(blk.Block.Txs) {
+		return nil, fmt.Errorf("transaction not included in block %d", blk.Block.Height)
+	}
+	nc, ok := node.(cmtrpcclient.NetworkClient)
+	if !ok {
+		return nil, fmt.Errorf("invalid rpc client")
+	}
+	cp, err := nc.ConsensusParams(ctx, &blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evm.QueryTraceTxRequest{
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       gethcommon.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         eth.ParseEthChainID(clientCtx.ChainID).Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+	for _, txBz := range blk.Block.Txs[:resTx.Index+1] {
+		tx, err := clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			continue
+		}
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evm.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			if ethMsg.AsTransaction().Hash() == hash {
+				req.Msg = ethMsg
+				return req, nil
+			}
+			req.Predecessors = append(req.Predecessors, ethMsg)
+		}
+	}
+	return nil, fmt.Errorf("ethereum tx %s not found in block %d", hash.Hex(), blk.Block.Height)
+}
@@ -1,14 +1,22 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 
 	"github.com/MakeNowJust/heredoc/v2"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/client"
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/client/tx"
 	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
 
+	srvconfig "github.com/NibiruChain/nibiru/v2/app/server/config"
 	"github.com/NibiruChain/nibiru/v2/x/nutil/flags"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
@@ -31,6 +39,9 @@ func GetTxCmd() *cobra.Command {
 		CmdCreateFunToken(),
 		CmdConvertCoinToEvm(),
 		CmdConvertEvmToCoin(),
+		CmdDeploy(),
+		CmdCall(),
+		CmdSend(),
 	}
 	for _, cmd := range cmds {
 		txCmd.AddCommand(cmd)
@@ -172,3 +183,269 @@ func CmdConvertEvmToCoin() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+const (
+	FlagArgs     = "args"
+	FlagSig      = "sig"
+	FlagValue    = "value"
+	FlagGasLimit = "gas-limit"
+)
+
+// CmdDeploy broadcasts a contract creation MsgEthereumTx
+func CmdDeploy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy [bytecode-or-artifact] [flags]",
+		Short: "Deploy an EVM contract from bytecode or a Hardhat/Foundry artifact JSON",
+		Long: heredoc.Doc(`
+	Deploy an EVM contract. The constructor arguments are ABI-encoded from
+	the "abi" of the artifact, or from the --sig flag for raw bytecode.
+
+	Example: Deploying from a Hardhat or Foundry artifact.
+
+	deploy ./artifacts/Counter.json --args 42 --from mykey
+
+	Example: Deploying raw bytecode with constructor arguments.
+
+	deploy 0x6080... --sig "constructor(string,uint8)" --args "Token" --args 18 --from mykey
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bytecode, constructor, err := loadDeployCode(args[0])
+			if err != nil {
+				return err
+			}
+			if sig, _ := cmd.Flags().GetString(FlagSig); sig != "" {
+				method, err := ParseMethodSig(sig)
+				if err != nil {
+					return err
+				}
+				constructor = &method
+			}
+
+			ctorArgs, _ := cmd.Flags().GetStringArray(FlagArgs)
+			if len(ctorArgs) > 0 || constructor != nil {
+				if constructor == nil {
+					return fmt.Errorf("constructor arguments require an artifact with an ABI or the --%s flag", FlagSig)
+				}
+				packedArgs, err := PackArgs(constructor.Inputs, ctorArgs)
+				if err != nil {
+					return err
+				}
+				bytecode = append(bytecode, packedArgs...)
+			}
+
+			return broadcastEvmTx(cmd, clientCtx, nil, bytecode)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addEvmTxFlags(cmd)
+	cmd.Flags().StringArray(FlagArgs, nil, "Constructor argument, repeated once per argument in order")
+	cmd.Flags().String(FlagSig, "", `Constructor signature for raw bytecode, e.g. "constructor(address,uint256)"`)
+	return cmd
+}
+
+// CmdCall broadcasts a MsgEthereumTx calling a contract function
+func CmdCall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call [contract-addr] [function-sig] [args...] [flags]",
+		Short: "Send a transaction calling an EVM contract function",
+		Long: heredoc.Doc(`
+	Send a transaction calling an EVM contract function. The calldata is
+	ABI-encoded from the function signature, so no ABI file is needed.
+
+	Example:
+	call 0x7D4B...DfBe6 "transfer(address,uint256)" 0x1234...abcd 1000 --from mykey
+
+	Example: Passing raw calldata instead of a signature.
+	call 0x7D4B...DfBe6 0xa9059cbb000000... --from mykey
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := parseAddr(args[0])
+			if err != nil {
+				return err
+			}
+			input, _, err := encodeCalldata(args[1], args[2:])
+			if err != nil {
+				return err
+			}
+			return broadcastEvmTx(cmd, clientCtx, &contract, input)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addEvmTxFlags(cmd)
+	return cmd
+}
+
+// CmdSend broadcasts a MsgEthereumTx transferring NIBI to an EVM address
+func CmdSend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send [to-addr] [amount-wei] [flags]",
+		Short: "Send NIBI to an account with an Ethereum transaction",
+		Long: heredoc.Doc(`
+	Send NIBI to an account with an Ethereum transaction. The amount is in
+	wei, where 1 NIBI is 10^18 wei.
+
+	Example:
+	send 0x1234...abcd 1000000000000000000 --from mykey
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			to, err := parseAddr(args[0])
+			if err != nil {
+				return err
+			}
+			if err := cmd.Flags().Set(FlagValue, args[1]); err != nil {
+				return err
+			}
+			return broadcastEvmTx(cmd, clientCtx, &to, nil)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addEvmTxFlags(cmd)
+	return cmd
+}
+
+func addEvmTxFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagValue, "0", "Amount of NIBI to send with the transaction in wei")
+	cmd.Flags().Uint64(FlagGasLimit, 0, "EVM gas limit of the transaction (default: estimated with eth_estimateGas)")
+}
+
+// encodeCalldata returns the calldata for a function signature and its
+// arguments. A 0x-prefixed signature is taken as raw calldata.
+func encodeCalldata(sig string, args []string) ([]byte, *gethabi.Method, error) {
+	if hexutil.Has0xPrefix(sig) {
+		if len(args) > 0 {
+			return nil, nil, fmt.Errorf("unexpected arguments after raw calldata")
+		}
+		input, err := hexutil.Decode(sig)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid calldata: %w", err)
+		}
+		return input, nil, nil
+	}
+
+	method, err := ParseMethodSig(sig)
+	if err != nil {
+		return nil, nil, err
+	}
+	packedArgs, err := PackArgs(method.Inputs, args)
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(method.ID, packedArgs...), &method, nil
+}
+
+// broadcastEvmTx signs a MsgEthereumTx from the "--from" key and broadcasts
+// it. The nonce, gas limit, and fee cap are filled in from the
+// x/evm queries (EthAccount, EstimateGas, BaseFee) unless set by flags.
+func broadcastEvmTx(
+	cmd *cobra.Command, clientCtx client.Context, to *gethcommon.Address, input []byte,
+) error {
+	from := clientCtx.GetFromAddress()
+	if from.Empty() {
+		return fmt.Errorf("the --from flag is required to sign EVM transactions")
+	}
+	fromEth := eth.NibiruAddrToEthAddr(from)
+
+	valueStr, _ := cmd.Flags().GetString(FlagValue)
+	value, ok := new(big.Int).SetString(valueStr, 10)
+	if !ok || value.Sign() < 0 {
+		return fmt.Errorf("invalid --%s: %s", FlagValue, valueStr)
+	}
+
+	chainID := eth.ParseEthChainID(clientCtx.ChainID)
+	queryClient := evm.NewQueryClient(clientCtx)
+
+	accResp, err := queryClient.EthAccount(cmd.Context(), &evm.QueryEthAccountRequest{
+		Address: fromEth.Hex(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to query the nonce of %s: %w", fromEth.Hex(), err)
+	}
+
+	gasLimit, _ := cmd.Flags().GetUint64(FlagGasLimit)
+	if gasLimit == 0 {
+		callArgs, err := json.Marshal(evm.JsonTxArgs{
+			From:  &fromEth,
+			To:    to,
+			Value: (*hexutil.Big)(value),
+			Input: (*hexutil.Bytes)(&input),
+		})
+		if err != nil {
+			return err
+		}
+		gasResp, err := queryClient.EstimateGas(cmd.Context(), &evm.EthCallRequest{
+			Args:    callArgs,
+			GasCap:  srvconfig.DefaultEthCallGasLimit,
+			ChainId: chainID.Int64(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to estimate gas, consider setting --%s: %w", FlagGasLimit, err)
+		}
+		gasLimit = gasResp.Gas
+	}
+
+	gasFeeCap := evm.BASE_FEE_WEI
+	if feeResp, err := queryClient.BaseFee(cmd.Context(), &evm.QueryBaseFeeRequest{}); err == nil &&
+		feeResp.BaseFee != nil && feeResp.BaseFee.IsPositive() {
+		gasFeeCap = feeResp.BaseFee.BigInt()
+	}
+
+	msg := evm.NewTx(&evm.EvmTxArgs{
+		ChainID:   chainID,
+		Nonce:     accResp.Nonce,
+		To:        to,
+		Amount:    value,
+		GasLimit:  gasLimit,
+		GasFeeCap: gasFeeCap,
+		GasTipCap: big.NewInt(0),
+		Input:     input,
+	})
+	msg.From = fromEth.Hex()
+	if err := msg.Sign(gethcore.LatestSignerForChainID(chainID), clientCtx.Keyring); err != nil {
+		return err
+	}
+	ethTxHash := msg.AsTransaction().Hash()
+
+	cosmosTx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), evm.EVMBankDenom)
+	if err != nil {
+		return err
+	}
+	if clientCtx.GenerateOnly {
+		txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(cosmosTx)
+		if err != nil {
+			return err
+		}
+		return clientCtx.PrintString(fmt.Sprintf("%s\n", txJSON))
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(cosmosTx)
+	if err != nil {
+		return err
+	}
+
+	cmd.PrintErrf("eth tx hash: %s\n", ethTxHash.Hex())
+	if to == nil {
+		cmd.PrintErrf("contract address: %s\n", crypto.CreateAddress(fromEth, accResp.Nonce).Hex())
+	}
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintProto(res)
+}
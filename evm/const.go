@@ -49,8 +49,11 @@ const (
 	CtxKeyEvmSimulation            contextKey = "evm_simulation"
 	CtxKeyGasEstimateZeroTolerance contextKey = "gas_estimate_zero_tolerance"
 	CtxKeyZeroGasMeta              contextKey = "zero_gas_meta"
+	CtxKeyGasSponsorMeta           contextKey = "gas_sponsor_meta"
+	CtxKeyFeeTokenMeta             contextKey = "fee_token_meta"
 	CtxKeyEvmEventTruncationMark   contextKey = "evm_event_truncation_mark"
 	CtxKeyVMSenderGuard            contextKey = "evm_vm_sender_guard"
+	CtxKeyPrecompileRun            contextKey = "evm_precompile_run"
 )
 
 // GetZeroGasMeta returns the ZeroGasMeta stored under CtxKeyZeroGasMeta, or nil if not set or type assertion fails.
@@ -64,6 +67,32 @@ func IsZeroGasEthTx(ctx sdk.Context) bool {
 	return GetZeroGasMeta(ctx) != nil
 }
 
+// GetGasSponsorMeta returns the GasSponsorMeta stored under
+// CtxKeyGasSponsorMeta, or nil if not set or type assertion fails.
+func GetGasSponsorMeta(ctx sdk.Context) *GasSponsorMeta {
+	meta, _ := ctx.Value(CtxKeyGasSponsorMeta).(*GasSponsorMeta)
+	return meta
+}
+
+// IsGasSponsoredEthTx returns true if the context has GasSponsorMeta set (i.e.,
+// the fees of this EVM tx are paid by an x/sudo gas sponsor).
+func IsGasSponsoredEthTx(ctx sdk.Context) bool {
+	return GetGasSponsorMeta(ctx) != nil
+}
+
+// GetFeeTokenMeta returns the FeeTokenMeta stored under CtxKeyFeeTokenMeta, or
+// nil if not set or type assertion fails.
+func GetFeeTokenMeta(ctx sdk.Context) *FeeTokenMeta {
+	meta, _ := ctx.Value(CtxKeyFeeTokenMeta).(*FeeTokenMeta)
+	return meta
+}
+
+// IsFeeTokenEthTx returns true if the context has FeeTokenMeta set (i.e., the
+// fees of this EVM tx are paid in a fee token instead of NIBI).
+func IsFeeTokenEthTx(ctx sdk.Context) bool {
+	return GetFeeTokenMeta(ctx) != nil
+}
+
 // GetEvmEventTruncationMark returns the mark to use for truncating events.
 func GetEvmEventTruncationMark(ctx sdk.Context) (mark int, ok bool) {
 	mark, ok = ctx.Value(CtxKeyEvmEventTruncationMark).(int)
@@ -110,6 +139,14 @@ func IsVMSenderCtx(ctx sdk.Context) bool {
 	return false
 }
 
+// IsPrecompileRunCtx returns true if the context belongs to the execution of a
+// Nibiru custom precompile, meaning the EVM state of the caller is not yet
+// committed.
+func IsPrecompileRunCtx(ctx sdk.Context) bool {
+	isTrue, ok := ctx.Value(CtxKeyPrecompileRun).(bool)
+	return ok && isTrue
+}
+
 var PRECOMPILE_ADDRS []gethcommon.Address =
 // Using a set cleanly removes potential duplicates
 set.New[gethcommon.Address](
@@ -120,11 +157,29 @@ set.New[gethcommon.Address](
 		gethcommon.HexToAddress("0x0000000000000000000000000000000000000801"),
 		// Wasm 0x...802
 		gethcommon.HexToAddress("0x0000000000000000000000000000000000000802"),
+		// Staking 0x...803
+		gethcommon.HexToAddress("0x0000000000000000000000000000000000000803"),
+		// IbcTransfer 0x...804
+		gethcommon.HexToAddress("0x0000000000000000000000000000000000000804"),
 		// P-256 verification precompile 0x...100
 		gethcommon.HexToAddress("0x0000000000000000000000000000000000000100"),
 	}...)...,
 ).ToSlice()
 
+// PRECOMPILE_ADDRS_CANCUN: [PRECOMPILE_ADDRS] plus the point evaluation
+// precompile (0x...0a) from EIP-4844, which exists once Cancun is active.
+var PRECOMPILE_ADDRS_CANCUN []gethcommon.Address = append(
+	append([]gethcommon.Address{}, PRECOMPILE_ADDRS...),
+	gethcommon.BytesToAddress([]byte{0x0a}),
+)
+
+// BlobBaseFeeWei is the value of the BLOBBASEFEE opcode (EIP-7516) once Cancun
+// is active. Nibiru has no blob transactions, so the excess blob gas is always
+// zero and the blob base fee stays at its EIP-4844 minimum of 1 wei.
+func BlobBaseFeeWei() *big.Int {
+	return big.NewInt(1)
+}
+
 const (
 	// ModuleName string name of module
 	ModuleName = "evm"
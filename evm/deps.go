@@ -3,6 +3,9 @@ package evm
 // Copyright (c) 2023-2024 Nibi, Inc.
 
 import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
@@ -50,4 +53,38 @@ type SudoKeeper interface {
 	// EVM contracts as a set (map) for O(1) lookup. This method avoids
 	// exposing x/sudo-specific types in the EVM dependency interface.
 	GetZeroGasEvmContracts(ctx sdk.Context) map[gethcommon.Address]struct{}
+
+	// GetGasSponsor returns the x/sudo gas sponsor paying for transactions from
+	// sender to contract, if any.
+	GetGasSponsor(ctx sdk.Context, sender, contract sdk.AccAddress) (sponsor sdk.AccAddress, ok bool)
+
+	// ChargeGasSponsor debits a fee in unibi from the sponsor's escrowed
+	// balance, enforcing the sponsor's daily cap for sender. Funds are moved by
+	// the caller.
+	ChargeGasSponsor(ctx sdk.Context, sponsor, sender sdk.AccAddress, fee sdkmath.Int) error
+
+	// RefundGasSponsor credits unused fees in unibi back to the sponsor's
+	// escrowed balance. Funds are moved by the caller.
+	RefundGasSponsor(ctx sdk.Context, sponsor, sender sdk.AccAddress, refund sdkmath.Int)
+
+	// GasSponsorEscrowAddr returns the account holding the funds of every gas
+	// sponsor.
+	GasSponsorEscrowAddr() sdk.AccAddress
+}
+
+// DevGasKeeper pays developers a share of the gas fees spent on the contracts
+// they registered in the x/devgas module.
+type DevGasKeeper interface {
+	// PayoutEvmFeeShare pays the developer share of "feesWei", the gas fees
+	// settled by an Ethereum tx that called "contract", from the fee collector to
+	// the withdrawer registered for the contract, if any.
+	PayoutEvmFeeShare(ctx sdk.Context, contract gethcommon.Address, feesWei *big.Int) error
+}
+
+// OracleKeeper prices the fee tokens that can pay for gas in place of NIBI.
+type OracleKeeper interface {
+	// GetAdapterPrice returns the positive price of an adapter symbol such as
+	// "unibi:uusd" from the "x-oracle" Wasm plugin. Prices updated more than
+	// maxAgeSeconds before the block time are errors.
+	GetAdapterPrice(ctx sdk.Context, symbol string, maxAgeSeconds uint64) (sdkmath.LegacyDec, error)
 }
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+address constant IBC_TRANSFER_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
+
+IIbcTransfer constant IBC_TRANSFER_PRECOMPILE = IIbcTransfer(
+    IBC_TRANSFER_PRECOMPILE_ADDRESS
+);
+
+import "./NibiruEvmUtils.sol";
+
+/// @notice IBC transfer precompile. Sends tokens held by the caller to
+/// another chain over an ICS-20 "transfer" channel.
+/// @dev If "denom" is the hex address of an ERC20 with a FunToken mapping, the
+/// ERC20 is first converted to its Bank Coin on the caller's account, so
+/// ERC20 tokens can leave Nibiru without a separate "sendToBank" call.
+interface IIbcTransfer is INibiruEvm {
+    /// @notice Emitted when an ICS-20 packet is sent through the precompile.
+    /// @param sender Address of the caller that sent the tokens
+    /// @param sourceChannel Channel ID the packet was sent on
+    /// @param sequence Sequence number of the IBC packet
+    /// @param receiver Recipient address on the counterparty chain
+    /// @param denom Bank denomination of the coin that was sent
+    /// @param amount Amount sent in units of "denom"
+    event IbcTransfer(
+        address indexed sender,
+        string indexed sourceChannel,
+        uint64 indexed sequence,
+        string receiver,
+        string denom,
+        uint256 amount
+    );
+
+    /// @notice Sends tokens from the caller over an ICS-20 channel.
+    /// @param sourceChannel Channel ID on Nibiru, e.g. "channel-0"
+    /// @param receiver Recipient address on the counterparty chain
+    /// @param denom Bank Coin denomination, or the hex address of an ERC20
+    /// with a FunToken mapping
+    /// @param amount Amount to send in the smallest units of the token
+    /// @param timeoutTimestamp Packet timeout as Unix time in nanoseconds. If
+    /// zero, a timeout 10 minutes after the current block time is used.
+    /// @param memo Optional memo attached to the packet
+    /// @return sequence Sequence number of the sent packet
+    function transfer(
+        string calldata sourceChannel,
+        string calldata receiver,
+        string calldata denom,
+        uint256 amount,
+        uint64 timeoutTimestamp,
+        string calldata memo
+    ) external returns (uint64 sequence);
+}
@@ -18,6 +18,20 @@ interface IOracle {
         view
         returns (uint256 price, uint64 blockTimeMs, uint64 blockHeight);
 
+    /// @notice Queries the time-weighted average price (TWAP) of a pair over
+    /// a lookback window that ends at the current block time.
+    /// @param pair The asset pair to query. For example, "ubtc:uusd".
+    /// @param lookbackSeconds Length of the window in seconds. Must be
+    /// positive and at most 86400 (24 hours).
+    /// @return price The TWAP with 18 decimals.
+    /// @dev The TWAP is computed from the x-oracle adapter price sampled by
+    /// the x/oracle module at the end of each block. Reverts if the pair has
+    /// no samples in the window.
+    function queryTwap(
+        string memory pair,
+        uint64 lookbackSeconds
+    ) external view returns (uint256 price);
+
     function chainLinkLatestRoundData(
         string memory pair
     )
@@ -30,6 +44,41 @@ interface IOracle {
             uint256 updatedAt,
             uint80 answeredInRound
         );
+
+    /// @notice Returns a past price round for a given pair, like
+    /// "AggregatorV3Interface.getRoundData" for a single Chainlink feed.
+    /// @param pair The asset pair to query. For example, "ubtc:uusd".
+    /// @param _roundId The round to query. Round IDs start at 1 and increase
+    /// by one each time the x-oracle adapter reports a new price for the pair.
+    /// @dev Only the most recent 1000 rounds of each pair are kept. Reverts if
+    /// the round was never recorded or has been pruned.
+    function chainLinkGetRoundData(
+        string memory pair,
+        uint80 _roundId
+    )
+        external
+        view
+        returns (
+            uint80 roundId,
+            int256 answer,
+            uint256 startedAt,
+            uint256 updatedAt,
+            uint80 answeredInRound
+        );
+
+    /// @notice Returns the price (18 decimals) of a past price round, like
+    /// Chainlink's "getAnswer". Reverts if the round is not available.
+    function chainLinkGetAnswer(
+        string memory pair,
+        uint256 roundId
+    ) external view returns (int256);
+
+    /// @notice Returns the update time (unix seconds) of a past price round,
+    /// like Chainlink's "getTimestamp". Reverts if the round is not available.
+    function chainLinkGetTimestamp(
+        string memory pair,
+        uint256 roundId
+    ) external view returns (uint256);
 }
 
 address constant ORACLE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000801;
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.19;
+
+address constant STAKING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000803;
+
+IStaking constant STAKING_PRECOMPILE = IStaking(STAKING_PRECOMPILE_ADDRESS);
+
+import "./NibiruEvmUtils.sol";
+
+/// @notice Staking and distribution precompile. Lets EVM contracts and EOAs
+/// delegate NIBI to validators and manage rewards as their own Nibiru account.
+/// @dev Amounts are in units of the staking bond denom ("unibi", 6 decimals),
+/// not wei. The delegator is always the caller of the precompile.
+interface IStaking is INibiruEvm {
+    /// @notice Validator info as reported by the staking module.
+    /// @param operatorAddress Bech32 "nibivaloper" address of the validator
+    /// @param moniker Human-readable validator name
+    /// @param jailed True if the validator is jailed
+    /// @param status Bond status: 1 = unbonded, 2 = unbonding, 3 = bonded
+    /// @param tokens Bonded tokens in units of the bond denom
+    /// @param delegatorShares Total delegator shares with 18 decimals
+    /// @param commissionRate Commission rate with 18 decimals (1e18 = 100%)
+    struct Validator {
+        string operatorAddress;
+        string moniker;
+        bool jailed;
+        uint8 status;
+        uint256 tokens;
+        uint256 delegatorShares;
+        uint256 commissionRate;
+    }
+
+    /// @notice A delegation from one account to one validator.
+    /// @param validatorAddr Bech32 "nibivaloper" address of the validator
+    /// @param shares Delegation shares with 18 decimals
+    /// @param balance Tokens the shares are currently worth
+    struct Delegation {
+        string validatorAddr;
+        uint256 shares;
+        INibiruEvm.BankCoin balance;
+    }
+
+    /// @notice Delegates bond denom tokens from the caller to a validator.
+    /// @param validatorAddr Bech32 "nibivaloper" address of the validator
+    /// @param amount Amount of bond denom tokens to delegate
+    /// @return success True if the delegation succeeded
+    function delegate(
+        string calldata validatorAddr,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @notice Begins unbonding tokens the caller delegated to a validator.
+    /// @param validatorAddr Bech32 "nibivaloper" address of the validator
+    /// @param amount Amount of bond denom tokens to undelegate
+    /// @return completionTime Unix time (seconds) when unbonding completes
+    function undelegate(
+        string calldata validatorAddr,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @notice Moves a delegation of the caller from one validator to another
+    /// without unbonding.
+    /// @param srcValidatorAddr Bech32 address of the current validator
+    /// @param dstValidatorAddr Bech32 address of the new validator
+    /// @param amount Amount of bond denom tokens to redelegate
+    /// @return completionTime Unix time (seconds) when the redelegation matures
+    function redelegate(
+        string calldata srcValidatorAddr,
+        string calldata dstValidatorAddr,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @notice Withdraws the caller's staking rewards from a validator to the
+    /// caller's distribution withdraw address.
+    /// @param validatorAddr Bech32 "nibivaloper" address of the validator
+    /// @return amount Coins withdrawn
+    function withdrawRewards(
+        string calldata validatorAddr
+    ) external returns (INibiruEvm.BankCoin[] memory amount);
+
+    /// @notice Returns the delegation of an account to a validator. Returns
+    /// zero shares and a zero balance if no delegation exists.
+    /// @param delegator Address of the delegator
+    /// @param validatorAddr Bech32 "nibivaloper" address of the validator
+    function delegation(
+        address delegator,
+        string calldata validatorAddr
+    ) external view returns (Delegation memory result);
+
+    /// @notice Returns every delegation of an account.
+    /// @param delegator Address of the delegator
+    function delegations(
+        address delegator
+    ) external view returns (Delegation[] memory result);
+
+    /// @notice Returns the pending rewards of a delegation, truncated to
+    /// whole coins.
+    /// @param delegator Address of the delegator
+    /// @param validatorAddr Bech32 "nibivaloper" address of the validator
+    function rewards(
+        address delegator,
+        string calldata validatorAddr
+    ) external view returns (INibiruEvm.BankCoin[] memory result);
+
+    /// @notice Returns a single validator. Reverts if the validator does not
+    /// exist.
+    /// @param validatorAddr Bech32 "nibivaloper" address of the validator
+    function validator(
+        string calldata validatorAddr
+    ) external view returns (Validator memory result);
+
+    /// @notice Returns the bonded validator set ordered by voting power.
+    function validators() external view returns (Validator[] memory result);
+}
@@ -66,4 +66,43 @@ interface IWasm is INibiruEvm {
         string memory label,
         INibiruEvm.BankCoin[] memory funds
     ) external payable returns (string memory contractAddr, bytes memory data);
+
+    /// @notice A Wasm contract registered by governance under a stable name in
+    /// the EVM module params ("evm.Params.wasm_plugins").
+    /// @param name Name of the plugin, like "x-oracle"
+    /// @param contractAddr nibi-prefixed Bech32 address of the wasm contract
+    /// @param isMutable Whether the plugin can be called with "pluginExecute".
+    struct WasmPlugin {
+        string name;
+        string contractAddr;
+        bool isMutable;
+    }
+
+    /// @notice Identical to "query", except that the wasm contract is resolved
+    /// from the name of a registered Wasm plugin.
+    /// @param name Name of the Wasm plugin
+    /// @param req JSON encoded query request
+    function pluginQuery(
+        string memory name,
+        bytes memory req
+    ) external view returns (bytes memory response);
+
+    /// @notice Identical to "execute", except that the wasm contract is
+    /// resolved from the name of a registered Wasm plugin. Fails if the plugin
+    /// is not mutable.
+    /// @param name Name of the Wasm plugin
+    /// @param msgArgs JSON encoded wasm execute invocation
+    /// @param funds Optional funds to supply during the execute call.
+    function pluginExecute(
+        string memory name,
+        bytes memory msgArgs,
+        INibiruEvm.BankCoin[] memory funds
+    ) external payable returns (bytes memory response);
+
+    /// @notice Lists the registered Wasm plugins in the order of the EVM
+    /// module params.
+    function plugins()
+        external
+        view
+        returns (WasmPlugin[] memory wasmPlugins);
 }
@@ -25,6 +25,10 @@ var (
 	wasmPrecompileJSON []byte
 	//go:embed artifacts/contracts/IOracle.sol/IOracle.json
 	oraclePrecompileJSON []byte
+	//go:embed artifacts/contracts/IStaking.sol/IStaking.json
+	stakingPrecompileJSON []byte
+	//go:embed artifacts/contracts/IIbcTransfer.sol/IIbcTransfer.json
+	ibcTransferPrecompileJSON []byte
 	//go:embed artifacts/contracts/WNIBI.sol/WNIBI.json
 	wnibiContractJSON []byte
 
@@ -98,6 +102,20 @@ var (
 		Name:      "IOracle.sol",
 		EmbedJSON: oraclePrecompileJSON,
 	}
+	// SmartContract_Staking: Precompile contract interface for "IStaking.sol".
+	// This precompile enables staking and distribution actions from EVM
+	// accounts. Only the ABI is used.
+	SmartContract_Staking = CompiledEvmContract{
+		Name:      "IStaking.sol",
+		EmbedJSON: stakingPrecompileJSON,
+	}
+	// SmartContract_IbcTransfer: Precompile contract interface for
+	// "IIbcTransfer.sol". This precompile sends bank coins and FunToken ERC20s
+	// over ICS-20 channels. Only the ABI is used.
+	SmartContract_IbcTransfer = CompiledEvmContract{
+		Name:      "IIbcTransfer.sol",
+		EmbedJSON: ibcTransferPrecompileJSON,
+	}
 	// SmartContract_Funtoken: Wrapped NIBI contract ERC20.
 	SmartContract_WNIBI = CompiledEvmContract{
 		Name:      "WNIBI.sol",
@@ -212,6 +230,8 @@ func init() {
 	SmartContract_FunToken.MustLoad()
 	SmartContract_Wasm.MustLoad()
 	SmartContract_Oracle.MustLoad()
+	SmartContract_Staking.MustLoad()
+	SmartContract_IbcTransfer.MustLoad()
 	SmartContract_WNIBI.MustLoad()
 
 	SmartContract_TestERC20.MustLoad()
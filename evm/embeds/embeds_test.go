@@ -13,6 +13,8 @@ func TestLoadContracts(t *testing.T) {
 	require.NotPanics(t, func() {
 		embeds.SmartContract_ERC20MinterWithMetadataUpdates.MustLoad()
 		embeds.SmartContract_FunToken.MustLoad()
+		embeds.SmartContract_Staking.MustLoad()
+		embeds.SmartContract_IbcTransfer.MustLoad()
 		embeds.SmartContract_TestERC20.MustLoad()
 		embeds.SmartContract_TestERC20MaliciousName.MustLoad()
 		embeds.SmartContract_TestERC20MaliciousTransfer.MustLoad()
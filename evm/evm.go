@@ -18,6 +18,32 @@ import (
 // deduction; msg_server skips refund. CanTransfer still runs. Empty struct; only presence (non-nil) matters.
 type ZeroGasMeta struct{}
 
+// GasSponsorMeta is the context payload for EVM transactions whose fees are paid
+// by an x/sudo gas sponsor. Stored under CtxKeyGasSponsorMeta. When present, the
+// sender's balance only needs to cover the tx value; AnteStepDeductGas charges
+// the sponsor and the msg_server refunds leftover gas to the sponsor's escrow.
+type GasSponsorMeta struct {
+	// Sponsor: Account that owns the gas sponsorship.
+	Sponsor sdk.AccAddress
+	// Escrow: EVM address of the account holding the sponsor's funds.
+	Escrow gethcommon.Address
+}
+
+// FeeTokenMeta is the context payload for EVM transactions whose fees are paid
+// in a fee token from the EVM params instead of NIBI. Stored under
+// CtxKeyFeeTokenMeta. When present, the sender's NIBI balance only needs to
+// cover the tx value; AnteStepDeductGas sends Fee to the fee collector and the
+// msg_server refunds the share of Fee for leftover gas.
+type FeeTokenMeta struct {
+	// Fee: Amount charged for the full gas limit of the tx.
+	Fee sdk.Coin
+}
+
+// WithFeeTokenMeta stores meta on ctx. Passing nil clears the marker.
+func WithFeeTokenMeta(ctx sdk.Context, meta *FeeTokenMeta) sdk.Context {
+	return ctx.WithValue(CtxKeyFeeTokenMeta, meta)
+}
+
 // FIXME: Explore problems arrising from ERC1155 creating multiple fungible
 // tokens that are valid ERC20s with the same address.
 // https://github.com/NibiruChain/nibiru/issues/1933
@@ -99,6 +99,14 @@ type Params struct {
 	// Hexadecimal address of the canonical WNIBI contract on Nibiru mainnet
 	CanonicalWnibi github_com_NibiruChain_nibiru_v2_eth.EIP55Addr `protobuf:"bytes,10,opt,name=canonical_wnibi,json=canonicalWnibi,proto3,customtype=github.com/NibiruChain/nibiru/v2/eth.EIP55Addr" json:"canonical_wnibi"`
 	WasmPlugins    []WasmPlugin                                   `protobuf:"bytes,11,rep,name=wasm_plugins,json=wasmPlugins,proto3" json:"wasm_plugins"`
+	// cancun_time is the block time in unix seconds at and after which the EVM
+	// applies the Cancun fork rules, except for blob transactions, which Nibiru
+	// does not support. Zero leaves Cancun disabled.
+	CancunTime uint64 `protobuf:"varint,12,opt,name=cancun_time,json=cancunTime,proto3" json:"cancun_time,omitempty"`
+	// fee_tokens is the list of tokens other than NIBI that can pay for gas.
+	// Their amounts are converted to NIBI with prices from the "x-oracle" Wasm
+	// plugin.
+	FeeTokens []FeeToken `protobuf:"bytes,13,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -155,6 +163,111 @@ func (m *Params) GetWasmPlugins() []WasmPlugin {
 	return nil
 }
 
+func (m *Params) GetCancunTime() uint64 {
+	if m != nil {
+		return m.CancunTime
+	}
+	return 0
+}
+
+func (m *Params) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+// FeeToken is a token other than NIBI that can pay for Cosmos and EVM gas.
+// Fees paid in a fee token go to the fee collector in that token.
+type FeeToken struct {
+	// Denom is the bank denomination of the token. Leave it empty to use the
+	// bank coin of the FunToken mapping for "erc20".
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Erc20 is the hex address of a FunToken-mapped ERC20. EVM tx fees are taken
+	// from the ERC20 balance, converted to the bank coin of the mapping, and
+	// refunds are converted back. Cosmos tx fees are paid in the bank coin.
+	Erc20 string `protobuf:"bytes,2,opt,name=erc20,proto3" json:"erc20,omitempty"`
+	// PricePair is the "x-oracle" adapter symbol that prices the token, for
+	// example "uusdc:uusd". NIBI is priced with the symbol "unibi:{quote}" for
+	// the same quote.
+	PricePair string `protobuf:"bytes,3,opt,name=price_pair,json=pricePair,proto3" json:"price_pair,omitempty"`
+	// Decimals is the number of decimals of the token's bank denomination, for
+	// example 6 for "uusdc".
+	Decimals uint32 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// MaxPriceAgeSeconds is the maximum age, compared with the block time, of
+	// the adapter prices used to convert fees in the token. Fees cannot be paid
+	// in the token while either price is older. Must be positive.
+	MaxPriceAgeSeconds uint64 `protobuf:"varint,5,opt,name=max_price_age_seconds,json=maxPriceAgeSeconds,proto3" json:"max_price_age_seconds,omitempty"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98abbdadb327b7d0, []int{2}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeToken) GetErc20() string {
+	if m != nil {
+		return m.Erc20
+	}
+	return ""
+}
+
+func (m *FeeToken) GetPricePair() string {
+	if m != nil {
+		return m.PricePair
+	}
+	return ""
+}
+
+func (m *FeeToken) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *FeeToken) GetMaxPriceAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxPriceAgeSeconds
+	}
+	return 0
+}
+
 // WasmPlugin binds a stable plugin name to a Wasm contract address.
 //
 // EVM code should look up plugins by name instead of hard-coding Wasm contract
@@ -166,13 +279,16 @@ type WasmPlugin struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// Addr is the Wasm smart contract address that defines the plugin
 	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	// Mutable allows EVM code to execute the plugin through the Wasm precompile
+	// with "pluginExecute". Plugins that are not mutable can only be queried.
+	Mutable bool `protobuf:"varint,3,opt,name=mutable,proto3" json:"mutable,omitempty"`
 }
 
 func (m *WasmPlugin) Reset()         { *m = WasmPlugin{} }
 func (m *WasmPlugin) String() string { return proto.CompactTextString(m) }
 func (*WasmPlugin) ProtoMessage()    {}
 func (*WasmPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{2}
+	return fileDescriptor_98abbdadb327b7d0, []int{3}
 }
 func (m *WasmPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -215,6 +331,13 @@ func (m *WasmPlugin) GetAddr() string {
 	return ""
 }
 
+func (m *WasmPlugin) GetMutable() bool {
+	if m != nil {
+		return m.Mutable
+	}
+	return false
+}
+
 // State represents a single Storage key value pair item.
 type State struct {
 	// key is the stored key
@@ -227,7 +350,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{3}
+	return fileDescriptor_98abbdadb327b7d0, []int{4}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,7 +426,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{4}
+	return fileDescriptor_98abbdadb327b7d0, []int{5}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,7 +536,7 @@ func (m *LogLite) Reset()         { *m = LogLite{} }
 func (m *LogLite) String() string { return proto.CompactTextString(m) }
 func (*LogLite) ProtoMessage()    {}
 func (*LogLite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{5}
+	return fileDescriptor_98abbdadb327b7d0, []int{6}
 }
 func (m *LogLite) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -475,7 +598,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{6}
+	return fileDescriptor_98abbdadb327b7d0, []int{7}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -504,17 +627,20 @@ func (m *AccessTuple) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AccessTuple proto.InternalMessageInfo
 
-// TracerConfig stores additional tracer args. For geth it's only one attr:
-// onlyTopCall
+// TracerConfig stores additional tracer args of the geth "callTracer":
+// onlyTopCall and withLog
 type TracerConfig struct {
 	OnlyTopCall bool `protobuf:"varint,1,opt,name=only_top_call,json=onlyTopCall,proto3" json:"onlyTopCall"`
+	// with_log includes the logs emitted by each call frame, including the
+	// ABCI events of the cross-VM frames reported by Nibiru precompiles.
+	WithLog bool `protobuf:"varint,2,opt,name=with_log,json=withLog,proto3" json:"withLog"`
 }
 
 func (m *TracerConfig) Reset()         { *m = TracerConfig{} }
 func (m *TracerConfig) String() string { return proto.CompactTextString(m) }
 func (*TracerConfig) ProtoMessage()    {}
 func (*TracerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{7}
+	return fileDescriptor_98abbdadb327b7d0, []int{8}
 }
 func (m *TracerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,6 +676,13 @@ func (m *TracerConfig) GetOnlyTopCall() bool {
 	return false
 }
 
+func (m *TracerConfig) GetWithLog() bool {
+	if m != nil {
+		return m.WithLog
+	}
+	return false
+}
+
 // TraceConfig holds extra parameters to trace functions.
 type TraceConfig struct {
 	// tracer is a custom javascript tracer
@@ -579,7 +712,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98abbdadb327b7d0, []int{8}
+	return fileDescriptor_98abbdadb327b7d0, []int{9}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -681,6 +814,7 @@ func (m *TraceConfig) GetTracerConfig() *TracerConfig {
 func init() {
 	proto.RegisterType((*FunToken)(nil), "eth.evm.v1.FunToken")
 	proto.RegisterType((*Params)(nil), "eth.evm.v1.Params")
+	proto.RegisterType((*FeeToken)(nil), "eth.evm.v1.FeeToken")
 	proto.RegisterType((*WasmPlugin)(nil), "eth.evm.v1.WasmPlugin")
 	proto.RegisterType((*State)(nil), "eth.evm.v1.State")
 	proto.RegisterType((*Log)(nil), "eth.evm.v1.Log")
@@ -693,73 +827,83 @@ func init() {
 func init() { proto.RegisterFile("eth/evm/v1/evm.proto", fileDescriptor_98abbdadb327b7d0) }
 
 var fileDescriptor_98abbdadb327b7d0 = []byte{
-	// 1049 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0x23, 0x35,
-	0x14, 0x6e, 0x9a, 0x49, 0x32, 0x71, 0xd2, 0x6d, 0xd6, 0x5b, 0x56, 0x23, 0xa4, 0xcd, 0x44, 0x23,
-	0x0e, 0x41, 0x5a, 0x25, 0x6c, 0x56, 0xe5, 0x50, 0x24, 0x50, 0x93, 0x6d, 0x45, 0x43, 0xbb, 0x5b,
-	0x79, 0x0b, 0x2b, 0x71, 0x19, 0x39, 0x33, 0x6e, 0x62, 0x65, 0x6c, 0x47, 0x63, 0x4f, 0x9a, 0xfc,
-	0x03, 0x8e, 0x9c, 0x39, 0xed, 0x9d, 0x3f, 0xb2, 0xe2, 0xb4, 0x47, 0xc4, 0x61, 0x84, 0xda, 0x0b,
-	0xca, 0x91, 0x23, 0x27, 0x64, 0xcf, 0xb4, 0x49, 0x41, 0x82, 0x03, 0x9c, 0xe6, 0x7d, 0xdf, 0xf3,
-	0xfb, 0xfc, 0xfc, 0xfc, 0xc5, 0x01, 0x7b, 0x44, 0x4d, 0xba, 0x64, 0xce, 0xba, 0xf3, 0x67, 0xfa,
-	0xd3, 0x99, 0xc5, 0x42, 0x09, 0x08, 0x88, 0x9a, 0x74, 0x34, 0x9c, 0x3f, 0xfb, 0x70, 0x6f, 0x2c,
-	0xc6, 0xc2, 0xd0, 0x5d, 0x1d, 0x65, 0x2b, 0xbc, 0x1f, 0x0b, 0xc0, 0x3e, 0x4e, 0xf8, 0x85, 0x98,
-	0x12, 0x0e, 0xbf, 0x06, 0x80, 0xc4, 0x41, 0xef, 0x13, 0x1f, 0x87, 0x61, 0xec, 0x14, 0x5a, 0x85,
-	0x76, 0xb5, 0xff, 0xe9, 0xbb, 0xd4, 0xdd, 0xfa, 0x25, 0x75, 0x3b, 0x63, 0xaa, 0x26, 0xc9, 0xa8,
-	0x13, 0x08, 0xd6, 0x7d, 0x49, 0x47, 0x34, 0x4e, 0x06, 0x13, 0x4c, 0x79, 0x97, 0x9b, 0xb8, 0x3b,
-	0xef, 0x75, 0xf5, 0x5e, 0x47, 0x27, 0xe7, 0xfb, 0xfb, 0x87, 0x61, 0x18, 0xa3, 0xaa, 0x51, 0xd2,
-	0x21, 0x7c, 0x02, 0xc0, 0x08, 0xf3, 0xa9, 0x1f, 0x12, 0x2e, 0x98, 0xb3, 0xad, 0x65, 0x51, 0x55,
-	0x33, 0x2f, 0x34, 0x01, 0x3f, 0x06, 0x0f, 0xa9, 0xf4, 0x19, 0x0e, 0x89, 0x7f, 0x19, 0x0b, 0xe6,
-	0x07, 0x82, 0x72, 0xa7, 0xd8, 0x2a, 0xb4, 0x6d, 0xf4, 0x80, 0xca, 0x33, 0x1c, 0x92, 0xe3, 0x58,
-	0xb0, 0x81, 0xa0, 0xdc, 0xfb, 0xa1, 0x08, 0xca, 0xe7, 0x38, 0xc6, 0x4c, 0xc2, 0x43, 0x00, 0xc8,
-	0x42, 0xc5, 0xd8, 0x27, 0x74, 0x26, 0x1d, 0xab, 0x55, 0x6c, 0x17, 0xfb, 0xde, 0x75, 0xea, 0x56,
-	0x8f, 0x34, 0x7b, 0x74, 0x72, 0x2e, 0x7f, 0x4f, 0xdd, 0x87, 0x4b, 0xcc, 0xa2, 0x03, 0x6f, 0xbd,
-	0xd0, 0x43, 0x55, 0x03, 0x8e, 0xe8, 0x4c, 0xc2, 0x1e, 0xa8, 0x93, 0x39, 0xf3, 0x83, 0x09, 0xe6,
-	0x9c, 0x44, 0xd2, 0xb1, 0x5b, 0xc5, 0x76, 0xb5, 0xbf, 0x7b, 0x9d, 0xba, 0xb5, 0xa3, 0x6f, 0xce,
-	0x06, 0x39, 0x8d, 0x6a, 0x64, 0xce, 0x6e, 0x01, 0x3c, 0x03, 0x8f, 0x82, 0x98, 0x60, 0x45, 0xfc,
-	0xcb, 0x84, 0x2b, 0x3d, 0x35, 0xff, 0x92, 0x10, 0xa7, 0x6a, 0x66, 0xf5, 0x24, 0x9f, 0xd5, 0x07,
-	0x81, 0x90, 0x4c, 0x48, 0x19, 0x4e, 0x3b, 0x54, 0x74, 0x19, 0x56, 0x93, 0xce, 0x09, 0x57, 0xe8,
-	0x61, 0x56, 0x79, 0x9c, 0x17, 0x1e, 0x13, 0x02, 0x7d, 0xb0, 0x1b, 0x60, 0x2e, 0x38, 0x0d, 0x70,
-	0xe4, 0x5f, 0xe9, 0x59, 0x3a, 0xe0, 0x3f, 0x8d, 0xfd, 0xc1, 0x9d, 0xdc, 0x1b, 0xbd, 0x04, 0x7e,
-	0x01, 0xea, 0x57, 0x58, 0x32, 0x7f, 0x16, 0x25, 0x63, 0xca, 0xa5, 0x53, 0x6b, 0x15, 0xdb, 0xb5,
-	0xde, 0xe3, 0xce, 0xda, 0x18, 0x9d, 0x37, 0x58, 0xb2, 0x73, 0x93, 0xee, 0x5b, 0x7a, 0x57, 0x54,
-	0xbb, 0xba, 0x63, 0xe4, 0x81, 0xf5, 0xdb, 0x5b, 0xb7, 0x30, 0xb4, 0xec, 0x42, 0x63, 0x7b, 0x68,
-	0xd9, 0xdb, 0x8d, 0xe2, 0xd0, 0xb2, 0x8b, 0x0d, 0x6b, 0x68, 0xd9, 0xa5, 0x46, 0x79, 0x68, 0xd9,
-	0xe5, 0x46, 0x65, 0x68, 0xd9, 0x95, 0x86, 0xed, 0x1d, 0x00, 0xb0, 0x96, 0x82, 0x10, 0x58, 0x1c,
-	0x33, 0x92, 0xb9, 0x08, 0x99, 0x58, 0x73, 0xc6, 0x59, 0x99, 0x05, 0x4c, 0x9c, 0xe9, 0x7b, 0x5d,
-	0x50, 0x7a, 0xad, 0xb0, 0x22, 0xb0, 0x01, 0x8a, 0x53, 0xb2, 0xcc, 0xab, 0x74, 0x08, 0xf7, 0x40,
-	0x69, 0x8e, 0xa3, 0x84, 0xe4, 0x55, 0x19, 0xf0, 0x7e, 0xda, 0x06, 0xc5, 0x53, 0x31, 0x86, 0x0e,
-	0xa8, 0x68, 0x19, 0x22, 0x65, 0x5e, 0x73, 0x0b, 0xe1, 0x63, 0x50, 0x56, 0x62, 0x46, 0x03, 0xe9,
-	0x6c, 0xeb, 0x7b, 0x45, 0x39, 0xd2, 0x4d, 0x84, 0x58, 0x61, 0xe3, 0xb0, 0x3a, 0x32, 0xb1, 0x76,
-	0xc2, 0x28, 0x12, 0xc1, 0xd4, 0xe7, 0x09, 0x1b, 0x91, 0xd8, 0xb1, 0x5a, 0x85, 0xb6, 0xd5, 0xdf,
-	0x5d, 0xa5, 0x6e, 0xcd, 0xf0, 0x2f, 0x0d, 0x8d, 0x36, 0x01, 0x7c, 0x0a, 0x2a, 0x6a, 0xe1, 0x4f,
-	0xb0, 0x9c, 0x38, 0x25, 0x73, 0x65, 0x8f, 0x56, 0xa9, 0xbb, 0xab, 0x62, 0xcc, 0x25, 0x0e, 0x14,
-	0x15, 0xfc, 0x4b, 0x2c, 0x27, 0xa8, 0xac, 0x16, 0xfa, 0x0b, 0xbb, 0xc0, 0x56, 0x0b, 0x9f, 0xf2,
-	0x90, 0x2c, 0x9c, 0xb2, 0x51, 0xdf, 0x5b, 0xa5, 0x6e, 0x63, 0x63, 0xf9, 0x89, 0xce, 0xa1, 0x8a,
-	0x5a, 0x98, 0x00, 0x3e, 0x05, 0x20, 0x6b, 0xc9, 0xec, 0x50, 0x31, 0x3b, 0xec, 0xac, 0x52, 0xb7,
-	0x6a, 0x58, 0xa3, 0xbd, 0x0e, 0xa1, 0x07, 0x4a, 0x99, 0xb6, 0x6d, 0xb4, 0xeb, 0xab, 0xd4, 0xb5,
-	0x23, 0x31, 0xce, 0x34, 0xb3, 0x94, 0x1e, 0x55, 0x4c, 0x98, 0x98, 0x93, 0xd0, 0xd8, 0xd5, 0x46,
-	0xb7, 0xd0, 0x7b, 0x05, 0x2a, 0xa7, 0x62, 0x7c, 0x4a, 0x15, 0xf9, 0x7f, 0xe6, 0xe9, 0x61, 0x50,
-	0x3b, 0x0c, 0x02, 0x22, 0xe5, 0x45, 0x32, 0x8b, 0xfe, 0x49, 0xb4, 0x07, 0xea, 0x52, 0x89, 0x18,
-	0x8f, 0x89, 0x3f, 0x25, 0xcb, 0x5c, 0x3a, 0x1b, 0x7c, 0xce, 0x7f, 0x45, 0x96, 0x12, 0x6d, 0x82,
-	0x03, 0xeb, 0xbb, 0xb7, 0xee, 0x96, 0x37, 0x00, 0xf5, 0x8b, 0x18, 0x07, 0x24, 0x1e, 0x08, 0x7e,
-	0x49, 0xc7, 0xf0, 0x39, 0xd8, 0x11, 0x3c, 0x5a, 0xfa, 0x4a, 0xcc, 0xfc, 0x00, 0x47, 0x91, 0xd9,
-	0xc9, 0xce, 0xa4, 0x74, 0xe2, 0x42, 0xcc, 0x06, 0x38, 0x8a, 0xd0, 0x26, 0xf0, 0xfe, 0x28, 0x82,
-	0x9a, 0x51, 0xc9, 0x45, 0xf4, 0x19, 0x8d, 0x68, 0xde, 0x67, 0x8e, 0xf4, 0x01, 0x14, 0x65, 0x44,
-	0x24, 0x2a, 0x77, 0xe1, 0x2d, 0xd4, 0x15, 0x31, 0x21, 0x0b, 0x12, 0x98, 0xf3, 0x5b, 0x28, 0x47,
-	0x70, 0x1f, 0xec, 0x84, 0x54, 0xe2, 0x51, 0x44, 0x7c, 0xa9, 0x70, 0x30, 0x35, 0x1e, 0xb1, 0xfb,
-	0x8d, 0x55, 0xea, 0xd6, 0xf3, 0xc4, 0x6b, 0xcd, 0xa3, 0x7b, 0x08, 0x7e, 0x06, 0x76, 0xd7, 0x65,
-	0xe6, 0xc8, 0xc6, 0x2d, 0x76, 0x1f, 0xae, 0x52, 0xf7, 0xc1, 0xdd, 0x52, 0x93, 0x41, 0x7f, 0xc1,
-	0xfa, 0x97, 0x12, 0x92, 0x51, 0x32, 0x36, 0x26, 0xb0, 0x51, 0x06, 0x34, 0x1b, 0x51, 0x46, 0x95,
-	0xb9, 0xf4, 0x12, 0xca, 0x80, 0xee, 0x8f, 0x70, 0xb3, 0x0f, 0x23, 0x4c, 0xc4, 0x4b, 0xa7, 0xb6,
-	0xee, 0x2f, 0x4b, 0x9c, 0x19, 0x1e, 0xdd, 0x43, 0xb0, 0x0f, 0x60, 0x5e, 0x16, 0x13, 0x95, 0xc4,
-	0xdc, 0x37, 0x57, 0x5f, 0x37, 0xb5, 0xc6, 0xd0, 0x59, 0x16, 0x99, 0xe4, 0x0b, 0xac, 0x30, 0xfa,
-	0x1b, 0x03, 0x5f, 0x81, 0x9d, 0x6c, 0xac, 0x7e, 0x60, 0xa6, 0xee, 0xec, 0xb4, 0x0a, 0xed, 0x5a,
-	0xcf, 0xd9, 0x7c, 0x93, 0x36, 0xaf, 0x36, 0x6b, 0x4a, 0x6d, 0x30, 0xe8, 0x1e, 0x1a, 0x5a, 0xb6,
-	0xd5, 0x28, 0x65, 0x8f, 0xd0, 0xd0, 0xb2, 0x41, 0xa3, 0x76, 0x37, 0x99, 0xfc, 0x70, 0xe8, 0xd1,
-	0x2d, 0xde, 0xe8, 0xba, 0xff, 0xf9, 0xbb, 0xeb, 0x66, 0xe1, 0xfd, 0x75, 0xb3, 0xf0, 0xeb, 0x75,
-	0xb3, 0xf0, 0xfd, 0x4d, 0x73, 0xeb, 0xfd, 0x4d, 0x73, 0xeb, 0xe7, 0x9b, 0xe6, 0xd6, 0xb7, 0x1f,
-	0xfd, 0xfb, 0xa3, 0x3b, 0x67, 0xa3, 0xb2, 0xf9, 0x07, 0x7d, 0xfe, 0x67, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xbe, 0x47, 0x22, 0x0d, 0x7b, 0x07, 0x00, 0x00,
+	// 1209 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6b, 0x1b, 0xc7,
+	0x1b, 0xb6, 0xac, 0x95, 0xb4, 0x1a, 0x49, 0xb1, 0x32, 0x71, 0xc2, 0x12, 0x88, 0x57, 0x88, 0x1f,
+	0x3f, 0x54, 0x08, 0x52, 0xe3, 0x90, 0x42, 0x53, 0x68, 0xb1, 0x1c, 0x9b, 0x5a, 0xb5, 0x13, 0x33,
+	0x71, 0x1b, 0xe8, 0x65, 0x18, 0xed, 0x8e, 0x57, 0x83, 0x76, 0x66, 0xc4, 0xce, 0xac, 0x2c, 0x7f,
+	0x83, 0x1e, 0xfb, 0x11, 0x72, 0x6e, 0xbf, 0x48, 0xe8, 0x29, 0xc7, 0xd2, 0xc3, 0x52, 0x9c, 0x4b,
+	0xd1, 0xb1, 0x87, 0x1e, 0x7a, 0x2a, 0x33, 0xbb, 0xb2, 0x95, 0x16, 0xda, 0x43, 0x7b, 0xd2, 0xfb,
+	0x3c, 0xef, 0xbc, 0x7f, 0xe6, 0xe1, 0xd9, 0xb1, 0xc1, 0x36, 0xd5, 0x93, 0x01, 0x9d, 0xf3, 0xc1,
+	0xfc, 0x91, 0xf9, 0xe9, 0xcf, 0x12, 0xa9, 0x25, 0x04, 0x54, 0x4f, 0xfa, 0x06, 0xce, 0x1f, 0xdd,
+	0xdf, 0x8e, 0x64, 0x24, 0x2d, 0x3d, 0x30, 0x51, 0x7e, 0xa2, 0xfb, 0x7d, 0x09, 0xb8, 0x87, 0xa9,
+	0x38, 0x93, 0x53, 0x2a, 0xe0, 0x97, 0x00, 0xd0, 0x24, 0xd8, 0xfd, 0x10, 0x93, 0x30, 0x4c, 0xbc,
+	0x52, 0xa7, 0xd4, 0xab, 0x0f, 0x3f, 0x7a, 0x93, 0xf9, 0x1b, 0x3f, 0x65, 0x7e, 0x3f, 0x62, 0x7a,
+	0x92, 0x8e, 0xfb, 0x81, 0xe4, 0x83, 0xe7, 0x6c, 0xcc, 0x92, 0x74, 0x7f, 0x42, 0x98, 0x18, 0x08,
+	0x1b, 0x0f, 0xe6, 0xbb, 0x03, 0x33, 0xeb, 0xe0, 0xe8, 0xf4, 0xc9, 0x93, 0xbd, 0x30, 0x4c, 0x50,
+	0xdd, 0x76, 0x32, 0x21, 0x7c, 0x00, 0xc0, 0x98, 0x88, 0x29, 0x0e, 0xa9, 0x90, 0xdc, 0xdb, 0x34,
+	0x6d, 0x51, 0xdd, 0x30, 0xcf, 0x0c, 0x01, 0x3f, 0x00, 0xb7, 0x99, 0xc2, 0x9c, 0x84, 0x14, 0x9f,
+	0x27, 0x92, 0xe3, 0x40, 0x32, 0xe1, 0x95, 0x3b, 0xa5, 0x9e, 0x8b, 0x6e, 0x31, 0x75, 0x42, 0x42,
+	0x7a, 0x98, 0x48, 0xbe, 0x2f, 0x99, 0xe8, 0xfe, 0x56, 0x06, 0xd5, 0x53, 0x92, 0x10, 0xae, 0xe0,
+	0x1e, 0x00, 0x74, 0xa1, 0x13, 0x82, 0x29, 0x9b, 0x29, 0xcf, 0xe9, 0x94, 0x7b, 0xe5, 0x61, 0xf7,
+	0x2a, 0xf3, 0xeb, 0x07, 0x86, 0x3d, 0x38, 0x3a, 0x55, 0xbf, 0x66, 0xfe, 0xed, 0x4b, 0xc2, 0xe3,
+	0xa7, 0xdd, 0x9b, 0x83, 0x5d, 0x54, 0xb7, 0xe0, 0x80, 0xcd, 0x14, 0xdc, 0x05, 0x4d, 0x3a, 0xe7,
+	0x38, 0x98, 0x10, 0x21, 0x68, 0xac, 0x3c, 0xb7, 0x53, 0xee, 0xd5, 0x87, 0x5b, 0x57, 0x99, 0xdf,
+	0x38, 0xf8, 0xea, 0x64, 0xbf, 0xa0, 0x51, 0x83, 0xce, 0xf9, 0x0a, 0xc0, 0x13, 0x70, 0x27, 0x48,
+	0x28, 0xd1, 0x14, 0x9f, 0xa7, 0x42, 0x1b, 0xd5, 0xf0, 0x39, 0xa5, 0x5e, 0xdd, 0x6a, 0xf5, 0xa0,
+	0xd0, 0xea, 0x6e, 0x20, 0x15, 0x97, 0x4a, 0x85, 0xd3, 0x3e, 0x93, 0x03, 0x4e, 0xf4, 0xa4, 0x7f,
+	0x24, 0x34, 0xba, 0x9d, 0x57, 0x1e, 0x16, 0x85, 0x87, 0x94, 0x42, 0x0c, 0xb6, 0x02, 0x22, 0xa4,
+	0x60, 0x01, 0x89, 0xf1, 0x85, 0xd1, 0xd2, 0x03, 0xff, 0x4a, 0xf6, 0x5b, 0xd7, 0xed, 0x5e, 0x99,
+	0x23, 0xf0, 0x33, 0xd0, 0xbc, 0x20, 0x8a, 0xe3, 0x59, 0x9c, 0x46, 0x4c, 0x28, 0xaf, 0xd1, 0x29,
+	0xf7, 0x1a, 0xbb, 0xf7, 0xfa, 0x37, 0xc6, 0xe8, 0xbf, 0x22, 0x8a, 0x9f, 0xda, 0xf4, 0xd0, 0x31,
+	0x53, 0x51, 0xe3, 0xe2, 0x9a, 0x51, 0xd0, 0x07, 0x8d, 0x80, 0x88, 0x20, 0x15, 0x58, 0x33, 0x4e,
+	0xbd, 0x66, 0xa7, 0xd4, 0x73, 0x10, 0xc8, 0xa9, 0x33, 0xc6, 0x29, 0xfc, 0x18, 0x80, 0x73, 0x4a,
+	0xb1, 0xbd, 0x92, 0xf2, 0x5a, 0xb6, 0xff, 0xf6, 0x7a, 0xff, 0x43, 0x4a, 0xad, 0xbd, 0x8a, 0xee,
+	0xf5, 0xf3, 0x02, 0xab, 0xa7, 0xce, 0x2f, 0xaf, 0xfd, 0xd2, 0xc8, 0x71, 0x4b, 0xed, 0xcd, 0x91,
+	0xe3, 0x6e, 0xb6, 0xcb, 0x23, 0xc7, 0x2d, 0xb7, 0x9d, 0x91, 0xe3, 0x56, 0xda, 0xd5, 0x91, 0xe3,
+	0x56, 0xdb, 0xb5, 0x91, 0xe3, 0xd6, 0xda, 0x6e, 0xf7, 0x3b, 0x63, 0xd3, 0xa2, 0x0e, 0x6e, 0x83,
+	0x4a, 0x6e, 0x25, 0xeb, 0x50, 0x94, 0x03, 0xc3, 0x5a, 0xcb, 0x15, 0x06, 0xcb, 0x81, 0xf1, 0xde,
+	0x2c, 0x61, 0x01, 0xc5, 0x33, 0xc2, 0x12, 0xeb, 0xaa, 0x3a, 0xaa, 0x5b, 0xe6, 0x94, 0xb0, 0x04,
+	0xde, 0x07, 0x6e, 0x48, 0x03, 0xc6, 0x49, 0x6c, 0x3c, 0x54, 0xea, 0xb5, 0xd0, 0x35, 0x86, 0x8f,
+	0xc0, 0x5d, 0x4e, 0x16, 0x38, 0x2f, 0x27, 0x11, 0xc5, 0x8a, 0x06, 0x52, 0x84, 0xca, 0xab, 0x58,
+	0x0d, 0x20, 0x27, 0x8b, 0x53, 0x93, 0xdb, 0x8b, 0xe8, 0xcb, 0x3c, 0x93, 0x5f, 0xa8, 0x7b, 0x06,
+	0xc0, 0x8d, 0xa6, 0x10, 0x02, 0x47, 0x10, 0x4e, 0x8b, 0x65, 0x6d, 0x6c, 0x38, 0xfb, 0x89, 0xe5,
+	0xab, 0xda, 0x18, 0x7a, 0xa0, 0xc6, 0x53, 0x4d, 0xc6, 0x31, 0x2d, 0xcc, 0xbf, 0x82, 0x45, 0xd7,
+	0x01, 0xa8, 0xbc, 0xd4, 0x44, 0x53, 0xd8, 0x06, 0xe5, 0x29, 0xbd, 0x2c, 0xfa, 0x99, 0xd0, 0x5c,
+	0x7d, 0x4e, 0xe2, 0x94, 0xae, 0xae, 0x6e, 0x41, 0xf7, 0x87, 0x4d, 0x50, 0x3e, 0x96, 0x91, 0x69,
+	0x6c, 0x06, 0x50, 0xa5, 0x8a, 0x9a, 0x15, 0x84, 0xf7, 0x40, 0x55, 0xcb, 0x19, 0x0b, 0x94, 0xb7,
+	0x69, 0xac, 0x8f, 0x0a, 0x64, 0xd6, 0x0b, 0x89, 0x26, 0x76, 0x8f, 0x26, 0xb2, 0xb1, 0xf9, 0x58,
+	0xc6, 0xb1, 0x0c, 0xa6, 0x58, 0xa4, 0x7c, 0x4c, 0x13, 0xab, 0x96, 0x33, 0xdc, 0x5a, 0x66, 0x7e,
+	0xc3, 0xf2, 0xcf, 0x2d, 0x8d, 0xd6, 0x01, 0x7c, 0x08, 0x6a, 0x7a, 0x81, 0x27, 0x44, 0x4d, 0xac,
+	0x66, 0xf5, 0xe1, 0x9d, 0x65, 0xe6, 0x6f, 0xe9, 0x84, 0x08, 0x45, 0x02, 0xcd, 0xa4, 0xf8, 0x9c,
+	0xa8, 0x09, 0xaa, 0xea, 0x85, 0xf9, 0x85, 0x03, 0xe0, 0xea, 0x05, 0x66, 0x22, 0xa4, 0x0b, 0xaf,
+	0x6a, 0xbb, 0x6f, 0x2f, 0x33, 0xbf, 0xbd, 0x76, 0xfc, 0xc8, 0xe4, 0x50, 0x4d, 0x2f, 0x6c, 0x00,
+	0x1f, 0x02, 0x90, 0xaf, 0x64, 0x27, 0xd4, 0xec, 0x84, 0xd6, 0x32, 0xf3, 0xeb, 0x96, 0xb5, 0xbd,
+	0x6f, 0x42, 0xd8, 0x05, 0x95, 0xbc, 0xb7, 0x6b, 0x7b, 0x37, 0x97, 0x99, 0xef, 0xc6, 0x32, 0xca,
+	0x7b, 0xe6, 0x29, 0x23, 0x55, 0x42, 0xb9, 0x9c, 0xd3, 0xd0, 0x7e, 0xd1, 0x2e, 0x5a, 0xc1, 0xee,
+	0x0b, 0x50, 0x3b, 0x96, 0xd1, 0x31, 0xd3, 0xf4, 0xbf, 0xd1, 0xb3, 0x4b, 0x40, 0x63, 0x2f, 0x08,
+	0xa8, 0x52, 0x67, 0xe9, 0x2c, 0xfe, 0xbb, 0xa6, 0xbb, 0xa0, 0xa9, 0xb4, 0x4c, 0x8c, 0x01, 0xa7,
+	0xf4, 0xb2, 0x68, 0x9d, 0x0b, 0x5f, 0xf0, 0x5f, 0xd0, 0x4b, 0x85, 0xd6, 0xc1, 0x53, 0xe7, 0x9b,
+	0xd7, 0xfe, 0x46, 0x77, 0x0a, 0x9a, 0x67, 0x09, 0x09, 0x68, 0xb2, 0x2f, 0xc5, 0x39, 0x8b, 0xe0,
+	0x63, 0xd0, 0x92, 0x22, 0xbe, 0xc4, 0x5a, 0xce, 0x70, 0x40, 0xe2, 0xd8, 0x4e, 0x72, 0xf3, 0x56,
+	0x26, 0x71, 0x26, 0x67, 0xfb, 0x24, 0x8e, 0xd1, 0x3a, 0x80, 0xff, 0x07, 0xee, 0x05, 0xd3, 0x13,
+	0x1c, 0xcb, 0xc8, 0xda, 0xcb, 0x1d, 0x36, 0x96, 0x99, 0x5f, 0x33, 0xdc, 0xb1, 0x8c, 0xd0, 0x2a,
+	0xe8, 0xfe, 0x5e, 0x06, 0x0d, 0x3b, 0xad, 0x18, 0x66, 0xb4, 0xb0, 0xc3, 0x8b, 0xfb, 0x14, 0xc8,
+	0x5c, 0xd4, 0x3c, 0x24, 0x32, 0xd5, 0x85, 0x5b, 0x57, 0xd0, 0x54, 0x24, 0x94, 0x2e, 0x68, 0x60,
+	0x75, 0x72, 0x50, 0x81, 0xe0, 0x13, 0xd0, 0x0a, 0x99, 0x32, 0x5f, 0x02, 0x56, 0x9a, 0x04, 0x53,
+	0xeb, 0x25, 0x77, 0xd8, 0x5e, 0x66, 0x7e, 0xb3, 0x48, 0xbc, 0x34, 0x3c, 0x7a, 0x0f, 0xc1, 0x4f,
+	0xc0, 0xd6, 0x4d, 0x99, 0x95, 0xc6, 0xba, 0xca, 0x1d, 0xc2, 0x65, 0xe6, 0xdf, 0xba, 0x3e, 0x6a,
+	0x33, 0xe8, 0x4f, 0x38, 0x7f, 0x62, 0xc6, 0x69, 0x64, 0xcd, 0xe2, 0xa2, 0x1c, 0x18, 0x36, 0x66,
+	0x9c, 0x69, 0x6b, 0x8e, 0x0a, 0xca, 0x81, 0xd9, 0x8f, 0x0a, 0x3b, 0x87, 0x53, 0x2e, 0x93, 0x4b,
+	0xaf, 0x71, 0xb3, 0x5f, 0x9e, 0x38, 0xb1, 0x3c, 0x7a, 0x0f, 0xc1, 0x21, 0x80, 0x45, 0x59, 0x42,
+	0x75, 0x9a, 0x08, 0x6c, 0x2d, 0xd2, 0xb4, 0xb5, 0xd6, 0xf8, 0x79, 0x16, 0xd9, 0xe4, 0x33, 0xa2,
+	0x09, 0xfa, 0x0b, 0x03, 0x5f, 0x80, 0x56, 0x2e, 0x2b, 0x0e, 0xac, 0xea, 0x5e, 0xab, 0x53, 0xea,
+	0x35, 0x76, 0xbd, 0xf5, 0xe7, 0x77, 0xdd, 0x02, 0xf9, 0x52, 0x7a, 0x8d, 0x41, 0xef, 0xa1, 0x91,
+	0xe3, 0x3a, 0xed, 0x4a, 0xfe, 0xe6, 0x8e, 0x1c, 0x17, 0xb4, 0x1b, 0xd7, 0xca, 0x14, 0x97, 0x43,
+	0x77, 0x56, 0x78, 0x6d, 0xeb, 0xe1, 0xa7, 0x6f, 0xae, 0x76, 0x4a, 0x6f, 0xaf, 0x76, 0x4a, 0x3f,
+	0x5f, 0xed, 0x94, 0xbe, 0x7d, 0xb7, 0xb3, 0xf1, 0xf6, 0xdd, 0xce, 0xc6, 0x8f, 0xef, 0x76, 0x36,
+	0xbe, 0xfe, 0xdf, 0x3f, 0xff, 0xfd, 0x9a, 0xf3, 0x71, 0xd5, 0xfe, 0x33, 0xf2, 0xf8, 0x8f, 0x01,
+	0x00, 0xc7, 0xb8, 0x0c, 0x69, 0xc6, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -811,6 +955,53 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.CancunTime != that1.CancunTime {
+		return false
+	}
+	if len(this.FeeTokens) != len(that1.FeeTokens) {
+		return false
+	}
+	for i := range this.FeeTokens {
+		if !this.FeeTokens[i].Equal(&that1.FeeTokens[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FeeToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeToken)
+	if !ok {
+		that2, ok := that.(FeeToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Erc20 != that1.Erc20 {
+		return false
+	}
+	if this.PricePair != that1.PricePair {
+		return false
+	}
+	if this.Decimals != that1.Decimals {
+		return false
+	}
+	if this.MaxPriceAgeSeconds != that1.MaxPriceAgeSeconds {
+		return false
+	}
 	return true
 }
 func (this *WasmPlugin) Equal(that interface{}) bool {
@@ -838,6 +1029,9 @@ func (this *WasmPlugin) Equal(that interface{}) bool {
 	if this.Addr != that1.Addr {
 		return false
 	}
+	if this.Mutable != that1.Mutable {
+		return false
+	}
 	return true
 }
 func (m *FunToken) Marshal() (dAtA []byte, err error) {
@@ -910,6 +1104,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.CancunTime != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.CancunTime))
+		i--
+		dAtA[i] = 0x60
+	}
 	if len(m.WasmPlugins) > 0 {
 		for iNdEx := len(m.WasmPlugins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -975,6 +1188,60 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPriceAgeSeconds != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxPriceAgeSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PricePair) > 0 {
+		i -= len(m.PricePair)
+		copy(dAtA[i:], m.PricePair)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.PricePair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WasmPlugin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -995,6 +1262,16 @@ func (m *WasmPlugin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Mutable {
+		i--
+		if m.Mutable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Addr) > 0 {
 		i -= len(m.Addr)
 		copy(dAtA[i:], m.Addr)
@@ -1239,6 +1516,16 @@ func (m *TracerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WithLog {
+		i--
+		if m.WithLog {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.OnlyTopCall {
 		i--
 		if m.OnlyTopCall {
@@ -1419,6 +1706,42 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	if m.CancunTime != 0 {
+		n += 1 + sovEvm(uint64(m.CancunTime))
+	}
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.PricePair)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovEvm(uint64(m.Decimals))
+	}
+	if m.MaxPriceAgeSeconds != 0 {
+		n += 1 + sovEvm(uint64(m.MaxPriceAgeSeconds))
+	}
 	return n
 }
 
@@ -1436,6 +1759,9 @@ func (m *WasmPlugin) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
+	if m.Mutable {
+		n += 2
+	}
 	return n
 }
 
@@ -1550,6 +1876,9 @@ func (m *TracerConfig) Size() (n int) {
 	if m.OnlyTopCall {
 		n += 2
 	}
+	if m.WithLog {
+		n += 2
+	}
 	return n
 }
 
@@ -1976,6 +2305,243 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancunTime", wireType)
+			}
+			m.CancunTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancunTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PricePair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAgeSeconds", wireType)
+			}
+			m.MaxPriceAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -2090,6 +2656,26 @@ func (m *WasmPlugin) Unmarshal(dAtA []byte) error {
 			}
 			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mutable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -2825,6 +3411,26 @@ func (m *TracerConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.OnlyTopCall = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithLog", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithLog = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
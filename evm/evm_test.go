@@ -85,6 +85,75 @@ func (s *TestSuite) TestParamsValidateWasmPlugins() {
 	}
 }
 
+func (s *TestSuite) TestParamsValidateFeeTokens() {
+	erc20 := evmtest.NewEthPrivAcc().EthAddr.Hex()
+	for _, tc := range []struct {
+		name        string
+		feeTokens   []evm.FeeToken
+		errContains string
+	}{
+		{
+			name: "valid",
+			feeTokens: []evm.FeeToken{
+				{Denom: "uusdc", PricePair: "uusdc:uusd", Decimals: 6, MaxPriceAgeSeconds: 60},
+				{Erc20: erc20, PricePair: "ueth:uusd", Decimals: 18, MaxPriceAgeSeconds: 60},
+			},
+		},
+		{
+			name:        "no denom or erc20",
+			feeTokens:   []evm.FeeToken{{PricePair: "uusdc:uusd", Decimals: 6, MaxPriceAgeSeconds: 60}},
+			errContains: "fee token must have a denom or an erc20 address",
+		},
+		{
+			name: "both denom and erc20",
+			feeTokens: []evm.FeeToken{
+				{Denom: "uusdc", Erc20: erc20, PricePair: "uusdc:uusd", Decimals: 6, MaxPriceAgeSeconds: 60},
+			},
+			errContains: "cannot have both a denom and an erc20 address",
+		},
+		{
+			name:        "EVM bank denom",
+			feeTokens:   []evm.FeeToken{{Denom: evm.EVMBankDenom, PricePair: "unibi:uusd", Decimals: 6, MaxPriceAgeSeconds: 60}},
+			errContains: "fee token cannot be the EVM bank denom",
+		},
+		{
+			name:        "invalid price pair",
+			feeTokens:   []evm.FeeToken{{Denom: "uusdc", PricePair: "uusdc", Decimals: 6}},
+			errContains: "invalid fee token price pair",
+		},
+		{
+			name:        "too many decimals",
+			feeTokens:   []evm.FeeToken{{Denom: "uusdc", PricePair: "uusdc:uusd", Decimals: 19, MaxPriceAgeSeconds: 60}},
+			errContains: "more than 18 decimals",
+		},
+		{
+			name:        "no max price age",
+			feeTokens:   []evm.FeeToken{{Denom: "uusdc", PricePair: "uusdc:uusd", Decimals: 6}},
+			errContains: "must have a positive max price age",
+		},
+		{
+			name: "duplicate",
+			feeTokens: []evm.FeeToken{
+				{Denom: "uusdc", PricePair: "uusdc:uusd", Decimals: 6, MaxPriceAgeSeconds: 60},
+				{Denom: "uusdc", PricePair: "uusdc:uusd", Decimals: 6, MaxPriceAgeSeconds: 60},
+			},
+			errContains: "duplicate fee token: uusdc",
+		},
+	} {
+		s.Run(tc.name, func() {
+			params := evm.DefaultParams()
+			params.FeeTokens = tc.feeTokens
+
+			err := params.Validate()
+			if tc.errContains == "" {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestFunToken() {
 	for idx, tc := range []struct {
 		bankDenom string
@@ -22,9 +22,14 @@ var _ AnteStep = AnteStepVerifyEthAcc
 // total transaction cost. For zero-gas txs we skip only the balance check and
 // fee-related rejection; account creation and from-address validation still run.
 //
+// Senders whose NIBI balance cannot cover the fees may pay them in a fee token
+// from the EVM params. Those txs get a FeeTokenMeta marker so that DeductGas
+// charges the fee token and the msg_server refunds leftover gas in it.
+//
 // This AnteHandler decorator will fail if:
 // - from address is empty
-// - (non-zero-gas only) account balance is lower than the transaction cost
+// - (non-zero-gas only) account balance is lower than the transaction cost and
+// no fee token can pay the fees
 func AnteStepVerifyEthAcc(
 	sdb *evmstate.SDB,
 	k *evmstate.Keeper,
@@ -65,19 +70,48 @@ func AnteStepVerifyEthAcc(
 	}
 
 	// Skip balance-vs-tx-cost check for zero-gas txs; we are not charging gas.
-	if evm.IsZeroGasEthTx(sdb.Ctx()) {
+	// Gas-sponsored txs charge the sponsor, and AnteStepCanTransfer still
+	// checks that the sender covers the tx value.
+	if evm.IsZeroGasEthTx(sdb.Ctx()) || evm.IsGasSponsoredEthTx(sdb.Ctx()) {
 		return nil
 	}
 
 	if err := CheckSenderBalance(
 		sdb.GetBalance(fromAddr), txData,
 	); err != nil {
-		return err
+		// Senders without enough NIBI for gas can pay in a fee token instead.
+		// AnteStepCanTransfer still checks that NIBI covers the tx value.
+		meta, ok := selectFeeToken(sdb, k, msgEthTx, txData)
+		if !ok {
+			return err
+		}
+		sdb.SetCtx(evm.WithFeeTokenMeta(sdb.Ctx(), meta))
 	}
 
 	return nil
 }
 
+// selectFeeToken returns the fee token payment for the full gas limit of the
+// tx from the first fee token that the sender holds enough of.
+func selectFeeToken(
+	sdb *evmstate.SDB,
+	k *evmstate.Keeper,
+	msgEthTx *evm.MsgEthereumTx,
+	txData evm.TxData,
+) (meta *evm.FeeTokenMeta, ok bool) {
+	fees, err := evmstate.VerifyFee(txData, k.BaseFeeMicronibiPerGas(sdb.Ctx()), sdb.Ctx())
+	if err != nil || fees.IsZero() {
+		return nil, false
+	}
+	fee, ok := k.SelectFeeToken(
+		sdb.Ctx(), msgEthTx.FromAddrBech32(), evm.WeiToNativeCeil(fees.ToBig()),
+	)
+	if !ok {
+		return nil, false
+	}
+	return &evm.FeeTokenMeta{Fee: fee}, true
+}
+
 var _ AnteStep = AnteStepCanTransfer
 
 func AnteStepCanTransfer(
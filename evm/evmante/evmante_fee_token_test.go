@@ -0,0 +1,235 @@
+package evmante_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	authtypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/auth/types"
+
+	wasmkeeper "github.com/NibiruChain/nibiru/v2/x/wasm/keeper"
+	wasm "github.com/NibiruChain/nibiru/v2/x/wasm/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/evm/evmante"
+	"github.com/NibiruChain/nibiru/v2/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/nutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/oracle"
+)
+
+// The x-oracle adapter fixture prices "ubtc:uusd" at 420 and "unibi:uusd" at
+// 138, so 1 ubtc is worth 420/138 unibi.
+const feeTokenDenom = "ubtc"
+
+func setupFeeToken(t *testing.T, deps *evmtest.TestDeps, feeToken evm.FeeToken) {
+	t.Helper()
+
+	wasmPermissionedKeeper := wasmkeeper.NewDefaultPermissionKeeper(deps.App.WasmKeeper)
+	codeID, _, err := wasmPermissionedKeeper.Create(
+		deps.Ctx(),
+		deps.Sender.NibiruAddr,
+		oracle.XOracleAdapterWasm,
+		&wasm.AccessConfig{Permission: wasm.AccessTypeEverybody},
+	)
+	require.NoError(t, err)
+
+	instantiateMsg, err := json.Marshal(oracle.XOracleAdapterInstantiateMsg{
+		Owner: deps.Sender.NibiruAddr.String(),
+		Mode:  oracle.XOracleAdapterFixtureMode(),
+		LegacyMappings: []oracle.XOracleAdapterLegacyMapping{
+			{Symbol: "ubtc:uusd", TokenIndex: 3},
+			{Symbol: "unibi:uusd", TokenIndex: 49},
+		},
+	})
+	require.NoError(t, err)
+	adapterAddr, _, err := wasmPermissionedKeeper.Instantiate(
+		deps.Ctx(),
+		codeID,
+		deps.Sender.NibiruAddr,
+		deps.Sender.NibiruAddr,
+		instantiateMsg,
+		"test x-oracle adapter",
+		sdk.Coins{},
+	)
+	require.NoError(t, err)
+
+	params := deps.EvmKeeper.GetParams(deps.Ctx())
+	params.WasmPlugins = []evm.WasmPlugin{
+		{Name: evm.WasmPluginNameXOracle, Addr: adapterAddr.String()},
+	}
+	params.FeeTokens = []evm.FeeToken{feeToken}
+	require.NoError(t, deps.EvmKeeper.SetParams(deps.Ctx(), params))
+}
+
+func TestFeeTokenConversion(t *testing.T) {
+	deps := evmtest.NewTestDeps()
+	setupFeeToken(t, &deps, evm.FeeToken{
+		Denom: feeTokenDenom, PricePair: "ubtc:uusd", Decimals: 6, MaxPriceAgeSeconds: 60,
+	})
+	k := deps.EvmKeeper
+
+	fee, err := k.NativeToFeeToken(deps.Ctx(), feeTokenDenom, sdkmath.NewInt(50_000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(feeTokenDenom, 16_429), fee, "rounds up")
+
+	feeNative, err := k.FeeTokenToNative(deps.Ctx(), fee)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(50_001), feeNative, "rounds down")
+
+	_, err = k.FeeTokenToNative(deps.Ctx(), sdk.NewInt64Coin("uatom", 1))
+	require.ErrorContains(t, err, "uatom is not a fee token")
+	_, ok := k.GetFeeToken(deps.Ctx(), evm.EVMBankDenom)
+	require.False(t, ok)
+}
+
+func TestAnteStepDeductGas_FeeToken(t *testing.T) {
+	deps := evmtest.NewTestDeps()
+	setupFeeToken(t, &deps, evm.FeeToken{
+		Denom: feeTokenDenom, PricePair: "ubtc:uusd", Decimals: 6, MaxPriceAgeSeconds: 60,
+	})
+	sender := evmtest.NewEthPrivAcc()
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	newTx := func() *evm.MsgEthereumTx {
+		to := addr4
+		tx := evm.NewTx(&evm.EvmTxArgs{
+			ChainID:  deps.App.EvmKeeper.EthChainID(deps.Ctx()),
+			Nonce:    0,
+			GasLimit: 50_000,
+			GasPrice: evm.NativeToWei(big.NewInt(1)),
+			To:       &to,
+			Amount:   big.NewInt(0),
+		})
+		tx.From = sender.EthAddr.Hex()
+		return tx
+	}
+
+	t.Run("no NIBI and no fee token", func(t *testing.T) {
+		sdb := deps.NewStateDB()
+		err := evmante.AnteStepVerifyEthAcc(sdb, sdb.Keeper(), newTx(), false, ANTE_OPTIONS_UNUSED)
+		require.ErrorContains(t, err, "sender balance < tx cost")
+		require.False(t, evm.IsFeeTokenEthTx(sdb.Ctx()))
+	})
+
+	require.NoError(t, testapp.FundAccount(
+		deps.App.BankKeeper, deps.Ctx(), sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(feeTokenDenom, 20_000)),
+	))
+	feesBefore := deps.App.BankKeeper.GetBalance(deps.Ctx(), feeCollector, feeTokenDenom)
+
+	t.Run("fee token pays the fees", func(t *testing.T) {
+		sdb := deps.NewStateDB()
+		tx := newTx()
+		for _, step := range []evmante.AnteStep{
+			evmante.AnteStepVerifyEthAcc,
+			evmante.AnteStepCanTransfer,
+			evmante.AnteStepDeductGas,
+		} {
+			require.NoError(t, step(sdb, sdb.Keeper(), tx, false, ANTE_OPTIONS_UNUSED))
+		}
+		meta := evm.GetFeeTokenMeta(sdb.Ctx())
+		require.NotNil(t, meta)
+		require.Equal(t, sdk.NewInt64Coin(feeTokenDenom, 16_429), meta.Fee)
+		sdb.Commit()
+
+		require.Equal(t,
+			sdk.NewInt64Coin(feeTokenDenom, 20_000-16_429),
+			deps.App.BankKeeper.GetBalance(deps.Ctx(), sender.NibiruAddr, feeTokenDenom),
+		)
+		require.Equal(t,
+			feesBefore.AddAmount(sdkmath.NewInt(16_429)),
+			deps.App.BankKeeper.GetBalance(deps.Ctx(), feeCollector, feeTokenDenom),
+		)
+		require.True(t, sdb.GetBalance(sender.EthAddr).IsZero(), "no NIBI was needed")
+
+		// Half of the gas is left over, so half of the fee comes back.
+		require.NoError(t, deps.EvmKeeper.RefundFeeToken(
+			deps.NewStateDB(), sender.EthAddr, meta.Fee, 25_000, 50_000,
+		))
+		require.Equal(t,
+			sdk.NewInt64Coin(feeTokenDenom, 20_000-16_429+8_214),
+			deps.App.BankKeeper.GetBalance(deps.Ctx(), sender.NibiruAddr, feeTokenDenom),
+		)
+	})
+}
+
+func (s *Suite) TestAnteStepDeductGas_FeeTokenERC20() {
+	deps := evmtest.NewTestDeps()
+	funtoken := evmtest.CreateFunTokenForBankCoin(deps, feeTokenDenom, &s.Suite)
+	setupFeeToken(s.T(), &deps, evm.FeeToken{
+		Erc20: funtoken.Erc20Addr.Hex(), PricePair: "ubtc:uusd", Decimals: 6, MaxPriceAgeSeconds: 60,
+	})
+	sender := evmtest.NewEthPrivAcc()
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	s.T().Log("Give the sender 20_000 of the fee token as ERC20 and no bank coins")
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper, deps.Ctx(), deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(feeTokenDenom, 20_000)),
+	))
+	_, err := deps.EvmKeeper.ConvertCoinToEvm(
+		sdk.WrapSDKContext(deps.Ctx()),
+		&evm.MsgConvertCoinToEvm{
+			Sender:    deps.Sender.NibiruAddr.String(),
+			BankCoin:  sdk.NewInt64Coin(feeTokenDenom, 20_000),
+			ToEthAddr: eth.EIP55Addr{Address: sender.EthAddr},
+		},
+	)
+	s.Require().NoError(err)
+	feesBefore := deps.App.BankKeeper.GetBalance(deps.Ctx(), feeCollector, feeTokenDenom)
+
+	to := addr4
+	tx := evm.NewTx(&evm.EvmTxArgs{
+		ChainID:  deps.App.EvmKeeper.EthChainID(deps.Ctx()),
+		Nonce:    0,
+		GasLimit: 50_000,
+		GasPrice: evm.NativeToWei(big.NewInt(1)),
+		To:       &to,
+		Amount:   big.NewInt(0),
+	})
+	tx.From = sender.EthAddr.Hex()
+
+	sdb := deps.NewStateDB()
+	for _, step := range []evmante.AnteStep{
+		evmante.AnteStepVerifyEthAcc,
+		evmante.AnteStepCanTransfer,
+		evmante.AnteStepDeductGas,
+	} {
+		s.Require().NoError(step(sdb, sdb.Keeper(), tx, false, ANTE_OPTIONS_UNUSED))
+	}
+	meta := evm.GetFeeTokenMeta(sdb.Ctx())
+	s.Require().NotNil(meta)
+	s.Require().Equal(sdk.NewInt64Coin(feeTokenDenom, 16_429), meta.Fee)
+	sdb.Commit()
+
+	evmObj, _ := deps.NewEVM()
+	evmtest.FunTokenBalanceAssert{
+		Account:      sender.EthAddr,
+		FunToken:     funtoken,
+		BalanceBank:  big.NewInt(0),
+		BalanceERC20: big.NewInt(20_000 - 16_429),
+		Description:  "fee is debited from the ERC20 balance",
+	}.Assert(s.T(), deps, evmObj)
+	s.Require().Equal(
+		feesBefore.AddAmount(sdkmath.NewInt(16_429)),
+		deps.App.BankKeeper.GetBalance(deps.Ctx(), feeCollector, feeTokenDenom),
+	)
+
+	s.T().Log("Half of the gas is left over, so half of the fee comes back as ERC20")
+	s.Require().NoError(deps.EvmKeeper.RefundFeeToken(
+		deps.NewStateDB(), sender.EthAddr, meta.Fee, 25_000, 50_000,
+	))
+	evmObj, _ = deps.NewEVM()
+	evmtest.FunTokenBalanceAssert{
+		Account:      sender.EthAddr,
+		FunToken:     funtoken,
+		BalanceBank:  big.NewInt(0),
+		BalanceERC20: big.NewInt(20_000 - 16_429 + 8_214),
+		Description:  "refund is credited to the ERC20 balance",
+	}.Assert(s.T(), deps, evmObj)
+}
@@ -6,6 +6,7 @@ package evmante
 // the deductio of fees and validation of tx and block gas limits.
 
 import (
+	"fmt"
 	"math"
 
 	sdkioerrors "cosmossdk.io/errors"
@@ -96,6 +97,12 @@ func AnteStepBlockGasMeter(
 //
 // The deducted fees are transferred to the fee collector account and a fee event
 // is emitted to the context event manager.
+//
+// For gas-sponsored txs, the fees are paid from the x/sudo gas sponsor escrow
+// instead. If the sponsor cannot pay, the sender pays as usual.
+//
+// For txs marked by AnteStepVerifyEthAcc to pay in a fee token, the sender
+// pays the fees in that token.
 func AnteStepDeductGas(
 	sdb *evmstate.SDB,
 	k *evmstate.Keeper,
@@ -122,6 +129,20 @@ func AnteStepDeductGas(
 		return sdkioerrors.Wrapf(err, "failed to verify the fees")
 	}
 
+	if meta := evm.GetGasSponsorMeta(sdb.Ctx()); meta != nil {
+		err = deductGasFromSponsor(sdb, k, msgEthTx, fees, meta)
+		if err == nil {
+			return nil
+		}
+		// The sponsor cannot pay (max fee per tx, escrow, or daily cap
+		// exceeded), so the sender pays as usual.
+		sdb.SetCtx(evm.WithGasSponsorMeta(sdb.Ctx(), nil))
+	}
+
+	if meta := evm.GetFeeTokenMeta(sdb.Ctx()); meta != nil {
+		return deductGasWithFeeToken(sdb, k, msgEthTx, meta)
+	}
+
 	err = func(sdb *evmstate.SDB, effFeeWei *uint256.Int, feePayer sdk.AccAddress) error {
 		if fees.IsZero() {
 			return nil
@@ -149,6 +170,71 @@ func AnteStepDeductGas(
 	return nil
 }
 
+// deductGasFromSponsor charges the fees of a gas-sponsored tx to the sponsor's
+// x/sudo balance and moves them from the sponsor escrow to the fee collector.
+// AnteStepDetectZeroGas rejects tips on sponsored txs, so fees are the gas limit
+// times the base fee.
+func deductGasFromSponsor(
+	sdb *evmstate.SDB,
+	k *evmstate.Keeper,
+	msgEthTx *evm.MsgEthereumTx,
+	fees *uint256.Int,
+	meta *evm.GasSponsorMeta,
+) error {
+	if !fees.IsZero() {
+		// Check the escrow before charging so that a failure leaves the
+		// sponsor's bookkeeping untouched.
+		if balWei := sdb.GetBalance(meta.Escrow); balWei.Cmp(fees) < 0 {
+			return fmt.Errorf(
+				"insufficient funds in gas sponsor escrow: balance=\"%s\", fee=\"%s\"", balWei, fees,
+			)
+		}
+		err := k.SudoKeeper.ChargeGasSponsor(
+			sdb.Ctx(), meta.Sponsor, msgEthTx.FromAddrBech32(), evm.WeiToNativeCeil(fees.ToBig()),
+		)
+		if err != nil {
+			return err
+		}
+		if err := DeductTxCostsFromUserBalance(sdb, fees, meta.Escrow); err != nil {
+			return sdkioerrors.Wrapf(err, "failed to deduct transaction costs from gas sponsor escrow")
+		}
+	}
+
+	sdb.Ctx().EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fees.String()),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, meta.Sponsor.String()),
+		evm.AttributeKeyFeePayerEvm(eth.NibiruAddrToEthAddr(meta.Sponsor)),
+	))
+	return nil
+}
+
+// deductGasWithFeeToken sends the fees of a fee token tx from the sender to the
+// fee collector in the fee token. ERC20 fee tokens are debited from the
+// sender's ERC20 balance.
+func deductGasWithFeeToken(
+	sdb *evmstate.SDB,
+	k *evmstate.Keeper,
+	msgEthTx *evm.MsgEthereumTx,
+	meta *evm.FeeTokenMeta,
+) error {
+	from := msgEthTx.FromAddrBech32()
+	if err := k.ChargeFeeToken(sdb, msgEthTx.FromAddr(), meta.Fee); err != nil {
+		return sdkioerrors.Wrapf(
+			sdkerrors.ErrInsufficientFunds,
+			"failed to deduct transaction costs in fee token %s: %s", meta.Fee.Denom, err,
+		)
+	}
+
+	sdb.Ctx().EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, meta.Fee.String()),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, from.String()),
+		evm.AttributeKeyFeePayerEvm(msgEthTx.FromAddr()),
+	))
+	return nil
+}
+
 // AnteStepGasWanted sets up the transaction gas meter with the appropriate gas
 // limit and priority for the Ethereum transaction. This handler manages gas
 // consumption tracking during CheckTx and ReCheckTx phases.
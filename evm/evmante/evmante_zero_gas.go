@@ -2,11 +2,14 @@ package evmante
 
 // Copyright (c) 2023-2024 Nibi, Inc.
 //
-// evmante_zero_gas.go: Ante step for detecting zero-gas EVM transactions.
+// evmante_zero_gas.go: Ante step for detecting zero-gas and gas-sponsored EVM
+// transactions.
 
 import (
 	sdkioerrors "cosmossdk.io/errors"
 
+	sdkerrors "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types/errors"
+
 	"github.com/NibiruChain/nibiru/v2/evm"
 	evmstate "github.com/NibiruChain/nibiru/v2/evm/evmstate"
 )
@@ -20,7 +23,15 @@ var _ AnteStep = AnteStepDetectZeroGas
 // Eligibility:
 //   - tx.To is in ZeroGasActors.AlwaysZeroGasContracts (EVM hex address list)
 //
-// No state mutations. Only sets ZeroGasMeta in context as a marker.
+// Txs that are not zero-gas may instead be paid by an x/sudo gas sponsor whose
+// policy covers the sender and tx.To. Those txs get a GasSponsorMeta marker so
+// that VerifyEthAcc skips the sender's fee check, DeductGas charges the
+// sponsor's escrow, and the msg_server refunds leftover gas to the sponsor.
+// Gas-sponsored txs must be priced at the base fee. A priority tip would let the
+// sender spend the sponsor's escrow on priority, so those txs are rejected.
+//
+// No state mutations. Only sets ZeroGasMeta or GasSponsorMeta in context as a
+// marker.
 func AnteStepDetectZeroGas(
 	sdb *evmstate.SDB,
 	k *evmstate.Keeper,
@@ -28,11 +39,23 @@ func AnteStepDetectZeroGas(
 	simulate bool,
 	opts AnteOptionsEVM,
 ) (err error) {
-	isZeroGas, _, err := evm.IsZeroGasMsgEthereumTx(sdb.Ctx(), k.SudoKeeper, msgEthTx)
+	isZeroGas, txData, err := evm.IsZeroGasMsgEthereumTx(sdb.Ctx(), k.SudoKeeper, msgEthTx)
 	if err != nil {
 		return sdkioerrors.Wrap(err, "AnteStepDetectZeroGas: failed to unpack tx data")
 	}
 	if !isZeroGas {
+		meta, ok := evm.GetGasSponsorTxData(sdb.Ctx(), k.SudoKeeper, msgEthTx.FromAddr(), txData)
+		if ok {
+			baseFeeWei := k.BaseFeeWeiPerGas(sdb.Ctx())
+			if gasPriceWei := txData.EffectiveGasPriceWeiPerGas(baseFeeWei); gasPriceWei.Cmp(baseFeeWei) > 0 {
+				return sdkioerrors.Wrapf(
+					sdkerrors.ErrInvalidRequest,
+					"gas-sponsored txs cannot pay a priority tip: gas price %s wei exceeds base fee %s wei",
+					gasPriceWei, baseFeeWei,
+				)
+			}
+			sdb.SetCtx(evm.WithGasSponsorMeta(sdb.Ctx(), meta))
+		}
 		return nil
 	}
 
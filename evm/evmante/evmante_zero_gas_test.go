@@ -4,12 +4,15 @@ import (
 	"math/big"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/v2/eth"
 	"github.com/NibiruChain/nibiru/v2/evm"
 	"github.com/NibiruChain/nibiru/v2/evm/evmante"
 	"github.com/NibiruChain/nibiru/v2/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/x/nutil/testutil"
 	"github.com/NibiruChain/nibiru/v2/x/sudo"
 )
 
@@ -330,3 +333,47 @@ func TestVerifyEthAcc_ZeroGas_CreatesAccountWhenMissing(t *testing.T) {
 	acc := sdb.Keeper().GetAccount(sdb.Ctx(), tx.FromAddr())
 	require.NotNil(t, acc, "VerifyEthAcc must create sender account when missing for zero-gas tx")
 }
+
+func TestAnteStepDetectZeroGas_GasSponsor_RejectsTip(t *testing.T) {
+	deps := evmtest.NewTestDeps()
+
+	targetAddr := addr4
+	sponsor := testutil.NewAccAddress()
+	deps.App.SudoKeeper.GasSponsors.Insert(deps.Ctx(), sponsor, sudo.GasSponsor{
+		Sponsor: sponsor.String(),
+		Balance: sdkmath.NewInt(1_000_000),
+		Policy: sudo.GasSponsorPolicy{
+			Contracts:         []string{targetAddr.Hex()},
+			DailyCapPerSender: sdkmath.ZeroInt(),
+			MaxFeePerTx:       sdkmath.NewInt(1_000_000),
+		},
+	})
+	deps.App.SudoKeeper.GasSponsorContracts.Insert(
+		deps.Ctx(), eth.EthAddrToNibiruAddr(targetAddr), sponsor,
+	)
+	baseFeeWei := deps.App.EvmKeeper.BaseFeeWeiPerGas(deps.Ctx())
+
+	newTx := func(gasTipCapWei *big.Int) *evm.MsgEthereumTx {
+		tx := evm.NewTx(&evm.EvmTxArgs{
+			ChainID:   deps.App.EvmKeeper.EthChainID(deps.Ctx()),
+			Nonce:     0,
+			GasLimit:  50_000,
+			GasFeeCap: new(big.Int).Mul(baseFeeWei, big.NewInt(2)),
+			GasTipCap: gasTipCapWei,
+			To:        &targetAddr,
+			Amount:    big.NewInt(0),
+		})
+		tx.From = deps.Sender.EthAddr.Hex()
+		return tx
+	}
+
+	sdb := deps.NewStateDB()
+	err := evmante.AnteStepDetectZeroGas(sdb, sdb.Keeper(), newTx(big.NewInt(1)), false, ANTE_OPTIONS_UNUSED)
+	require.ErrorContains(t, err, "gas-sponsored txs cannot pay a priority tip")
+	require.False(t, evm.IsGasSponsoredEthTx(sdb.Ctx()))
+
+	sdb = deps.NewStateDB()
+	err = evmante.AnteStepDetectZeroGas(sdb, sdb.Keeper(), newTx(big.NewInt(0)), false, ANTE_OPTIONS_UNUSED)
+	require.NoError(t, err)
+	require.True(t, evm.IsGasSponsoredEthTx(sdb.Ctx()))
+}
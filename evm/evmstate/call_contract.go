@@ -102,22 +102,79 @@ func (k Keeper) CallContract(
 		if lastEvmErr := sdb.Ctx().LastErrApplyEvmMsg(); lastEvmErr != nil {
 			evmResp.VmError += ": " + lastEvmErr.Error()
 		}
-		if strings.Contains(evmResp.VmError, vm.ErrOutOfGas.Error()) {
-			err = fmt.Errorf(
-				"VMError: %s: gas required exceeds gas limit (%d)",
-				evmResp.VmError, gasLimit,
-			)
-			return
-		}
-		if evmResp.VmError == vm.ErrExecutionReverted.Error() {
-			err = fmt.Errorf(
-				"VMError: %s",
-				evm.NewRevertError(evmResp.Ret),
-			)
-			return
-		}
-		err = fmt.Errorf("VMError: %s", evmResp.VmError)
+		err = vmErrorOfResp(evmResp, gasLimit)
 	}
 
 	return evmResp, err
 }
+
+// StaticCallContract runs a read-only call of "contract" from "fromAcc", as
+// done by the STATICCALL opcode. Opcodes that modify state, such as SSTORE,
+// LOG, and CALL with value, fail the call, and so do the mutating methods of
+// precompiles. The state of "evmObj" is never committed.
+//
+// Unlike [Keeper.CallContract], no intrinsic gas is charged and the nonce of
+// "fromAcc" is left untouched, since the call is not a transaction.
+func (k Keeper) StaticCallContract(
+	evmObj *vm.EVM,
+	fromAcc gethcommon.Address,
+	contract gethcommon.Address,
+	contractInput []byte,
+	gasLimit uint64,
+) (evmResp *evm.MsgEthereumTxResponse, err error) {
+	var (
+		sdb   = evmObj.StateDB.(*SDB)
+		rules = evmObj.ChainConfig().Rules(
+			big.NewInt(sdb.Ctx().BlockHeight()),
+			false,
+			evm.ParseBlockTimeUnixU64(sdb.Ctx()),
+		)
+	)
+	precompileAddrs := evm.PRECOMPILE_ADDRS
+	if rules.IsCancun {
+		precompileAddrs = evm.PRECOMPILE_ADDRS_CANCUN
+	}
+	sdb.Prepare(
+		rules,
+		fromAcc,
+		evmObj.Context.Coinbase,
+		&contract,
+		precompileAddrs,
+		gethcore.AccessList{},
+	)
+
+	returnBz, gasRemaining, vmErr := evmObj.StaticCall(
+		vm.AccountRef(fromAcc),
+		contract,
+		contractInput,
+		gasLimit,
+	)
+	evmResp = &evm.MsgEthereumTxResponse{
+		GasUsed: gasLimit - gasRemaining,
+		Ret:     returnBz,
+		Hash:    sdb.TxCfg().TxHash.Hex(),
+	}
+	if vmErr != nil {
+		evmResp.VmError = vmErr.Error()
+		return evmResp, vmErrorOfResp(evmResp, gasLimit)
+	}
+	return evmResp, nil
+}
+
+// vmErrorOfResp returns the error of a failed EVM call, with the revert reason
+// decoded if the call reverted.
+func vmErrorOfResp(evmResp *evm.MsgEthereumTxResponse, gasLimit uint64) error {
+	if strings.Contains(evmResp.VmError, vm.ErrOutOfGas.Error()) {
+		return fmt.Errorf(
+			"VMError: %s: gas required exceeds gas limit (%d)",
+			evmResp.VmError, gasLimit,
+		)
+	}
+	if evmResp.VmError == vm.ErrExecutionReverted.Error() {
+		return fmt.Errorf(
+			"VMError: %s",
+			evm.NewRevertError(evmResp.Ret),
+		)
+	}
+	return fmt.Errorf("VMError: %s", evmResp.VmError)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evmstate_test
+
+import (
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/evm/evmtest"
+)
+
+// cancunOpcodesRuntime is runtime bytecode that uses the opcodes added in
+// Cancun and returns three words: TLOAD of a key it just wrote with TSTORE, a
+// copy of that word made with MCOPY, and BLOBBASEFEE.
+var cancunOpcodesRuntime = gethcommon.FromHex(
+	"602a60015d" + // TSTORE(1, 42)
+		"60015c600052" + // MSTORE(0, TLOAD(1))
+		"6020600060205e" + // MCOPY(32, 0, 32)
+		"4a604052" + // MSTORE(64, BLOBBASEFEE)
+		"60606000f3", // RETURN(0, 96)
+)
+
+// TestCancun: The Cancun fork rules apply only once the "cancun_time" EVM param
+// has passed, and existing contracts keep working after activation.
+func (s *Suite) TestCancun() {
+	deps := evmtest.NewTestDeps()
+	contractAddr := evmtest.NewEthPrivAcc().EthAddr
+	sdb := deps.NewStateDB()
+	sdb.SetCode(contractAddr, cancunOpcodesRuntime)
+	sdb.Commit()
+
+	// Point evaluation precompile (EIP-4844)
+	pointEvalAddr := gethcommon.BytesToAddress([]byte{0x0a})
+
+	call := func(to gethcommon.Address) (*evm.MsgEthereumTxResponse, error) {
+		evmObj, _ := deps.NewEVM()
+		return deps.EvmKeeper.CallContract(
+			evmObj, deps.Sender.EthAddr, &to, nil, 100_000, evm.COMMIT_READONLY, nil,
+		)
+	}
+	setCancunTime := func(cancunTime uint64) {
+		params := deps.EvmKeeper.GetParams(deps.Ctx())
+		params.CancunTime = cancunTime
+		s.Require().NoError(deps.EvmKeeper.SetParams(deps.Ctx(), params))
+	}
+	blockTime := uint64(deps.Ctx().BlockTime().Unix())
+
+	s.Run("before activation", func() {
+		s.Nil(deps.EvmKeeper.GetEVMConfig(deps.Ctx()).ChainConfig.CancunTime)
+		_, err := call(contractAddr)
+		s.ErrorContains(err, "invalid opcode")
+
+		// Without Cancun, 0x...0a is an empty account.
+		resp, err := call(pointEvalAddr)
+		s.NoError(err)
+		s.Empty(resp.Ret)
+	})
+
+	s.Run("scheduled in the future", func() {
+		setCancunTime(blockTime + 60)
+		_, err := call(contractAddr)
+		s.ErrorContains(err, "invalid opcode")
+	})
+
+	setCancunTime(blockTime)
+
+	s.Run("TSTORE, TLOAD, MCOPY, and BLOBBASEFEE", func() {
+		resp, err := call(contractAddr)
+		s.Require().NoError(err)
+		s.Require().Len(resp.Ret, 96)
+		s.EqualValues(42, new(big.Int).SetBytes(resp.Ret[0:32]).Int64())
+		s.EqualValues(42, new(big.Int).SetBytes(resp.Ret[32:64]).Int64())
+		s.Equal(evm.BlobBaseFeeWei(), new(big.Int).SetBytes(resp.Ret[64:96]))
+	})
+
+	s.Run("point evaluation precompile", func() {
+		_, err := call(pointEvalAddr)
+		s.ErrorContains(err, "invalid input length")
+	})
+
+	s.Run("existing embeds", func() {
+		deployResp, err := evmtest.DeployContract(&deps, embeds.SmartContract_TestERC20)
+		s.Require().NoError(err)
+		erc20Addr := deployResp.ContractAddr
+
+		recipient := evmtest.NewEthPrivAcc().EthAddr
+		evmObj, _ := deps.NewEVM()
+		_, _, err = deps.EvmKeeper.ERC20().Transfer(
+			erc20Addr, deps.Sender.EthAddr, recipient, big.NewInt(420), deps.Ctx(), evmObj,
+		)
+		s.Require().NoError(err)
+		evmObj, _ = deps.NewEVM()
+		balance, err := deps.EvmKeeper.ERC20().BalanceOf(erc20Addr, recipient, deps.Ctx(), evmObj)
+		s.Require().NoError(err)
+		s.EqualValues(420, balance.Int64())
+
+		deployResp, err = evmtest.DeployContract(&deps, embeds.SmartContract_TestRandom)
+		s.Require().NoError(err)
+		evmObj, _ = deps.NewEVM()
+		random, err := deps.EvmKeeper.ERC20().LoadERC20BigInt(
+			deps.Ctx(), evmObj, embeds.SmartContract_TestRandom.ABI, deployResp.ContractAddr, "getRandom",
+		)
+		s.Require().NoError(err)
+		s.NotZero(random.Int64())
+	})
+}
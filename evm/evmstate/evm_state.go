@@ -151,6 +151,31 @@ func (k Keeper) SetParams(ctx sdk.Context, params evm.Params) (err error) {
 	return
 }
 
+// GetWasmPluginAddr returns the address of the Wasm plugin with the given name
+// in the EVM params, like [evm.WasmPluginNameXOracle].
+func (k Keeper) GetWasmPluginAddr(ctx sdk.Context, name string) (sdk.AccAddress, error) {
+	addr, err := k.EvmState.WasmPlugins.Get(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s wasm plugin is not configured: %w", name, err)
+	}
+	if addr.Empty() {
+		return nil, fmt.Errorf("%s wasm plugin address is empty", name)
+	}
+	return addr, nil
+}
+
+// GetWasmPlugin returns the Wasm plugin with the given name in the EVM params,
+// including flags like [evm.WasmPlugin.Mutable] that the derived
+// [EvmState.WasmPlugins] index does not store.
+func (k Keeper) GetWasmPlugin(ctx sdk.Context, name string) (evm.WasmPlugin, error) {
+	for _, plugin := range k.GetParams(ctx).WasmPlugins {
+		if plugin.Name == name {
+			return plugin, nil
+		}
+	}
+	return evm.WasmPlugin{}, fmt.Errorf("%s wasm plugin is not configured", name)
+}
+
 // SetState updates contract storage and deletes if the value is empty.
 func (state EvmState) SetAccState(
 	ctx sdk.Context, addr gethcommon.Address, stateKey gethcommon.Hash, stateValue []byte,
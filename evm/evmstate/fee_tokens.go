@@ -0,0 +1,255 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evmstate
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/holiman/uint256"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	authtypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/auth/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/evm"
+)
+
+// nativeDecimals is the number of decimals of "unibi", the EVM bank denom.
+const nativeDecimals = 6
+
+// FeeTokenDenom returns the bank denom charged for feeToken. ERC20 fee tokens
+// resolve to the bank coin of their FunToken mapping.
+func (k Keeper) FeeTokenDenom(ctx sdk.Context, feeToken evm.FeeToken) (string, error) {
+	if feeToken.Denom != "" {
+		return feeToken.Denom, nil
+	}
+	funtoken, err := k.feeTokenFunToken(ctx, feeToken)
+	if err != nil {
+		return "", err
+	}
+	return funtoken.BankDenom, nil
+}
+
+// GetFeeToken returns the fee token in the EVM params that is charged in the
+// bank denom "denom", if any.
+func (k Keeper) GetFeeToken(ctx sdk.Context, denom string) (feeToken evm.FeeToken, ok bool) {
+	if denom == evm.EVMBankDenom {
+		return feeToken, false
+	}
+	for _, feeToken := range k.GetParams(ctx).FeeTokens {
+		feeTokenDenom, err := k.FeeTokenDenom(ctx, feeToken)
+		if err == nil && feeTokenDenom == denom {
+			return feeToken, true
+		}
+	}
+	return feeToken, false
+}
+
+// FeeTokenToNative converts a fee paid in a fee token to its NIBI-equivalent
+// value in "unibi", rounding down.
+func (k Keeper) FeeTokenToNative(ctx sdk.Context, fee sdk.Coin) (sdkmath.Int, error) {
+	feeToken, ok := k.GetFeeToken(ctx, fee.Denom)
+	if !ok {
+		return sdkmath.Int{}, fmt.Errorf("%s is not a fee token", fee.Denom)
+	}
+	rate, err := k.feeTokenNativeRate(ctx, feeToken)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	return rate.MulInt(fee.Amount).TruncateInt(), nil
+}
+
+// NativeToFeeToken converts a fee in "unibi" to the amount of the fee token
+// charged in the bank denom "denom", rounding up.
+func (k Keeper) NativeToFeeToken(
+	ctx sdk.Context, denom string, feeNative sdkmath.Int,
+) (sdk.Coin, error) {
+	feeToken, ok := k.GetFeeToken(ctx, denom)
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("%s is not a fee token", denom)
+	}
+	rate, err := k.feeTokenNativeRate(ctx, feeToken)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(denom, sdkmath.LegacyNewDecFromInt(feeNative).Quo(rate).Ceil().TruncateInt()), nil
+}
+
+// SelectFeeToken returns the fee that payer would pay in the first fee token,
+// in the order of the EVM params, of which payer holds enough to cover
+// feeNative "unibi". Fee tokens without a price are skipped. ERC20 fee tokens
+// are paid from the ERC20 balance and bank denoms from the bank balance.
+func (k Keeper) SelectFeeToken(
+	ctx sdk.Context, payer sdk.AccAddress, feeNative sdkmath.Int,
+) (fee sdk.Coin, ok bool) {
+	for _, feeToken := range k.GetParams(ctx).FeeTokens {
+		denom, err := k.FeeTokenDenom(ctx, feeToken)
+		if err != nil {
+			continue
+		}
+		tokenFee, err := k.NativeToFeeToken(ctx, denom, feeNative)
+		if err != nil || !tokenFee.IsPositive() {
+			continue
+		}
+		if feeToken.Erc20 == "" {
+			if k.Bank.GetBalance(ctx, payer, denom).IsGTE(tokenFee) {
+				return tokenFee, true
+			}
+			continue
+		}
+		balance, err := k.erc20Balance(
+			ctx, gethcommon.HexToAddress(feeToken.Erc20), gethcommon.BytesToAddress(payer),
+		)
+		if err == nil && balance.Cmp(tokenFee.Amount.BigInt()) >= 0 {
+			return tokenFee, true
+		}
+	}
+	return sdk.Coin{}, false
+}
+
+// ChargeFeeToken sends fee, an amount of a fee token, from payer to the fee
+// collector. ERC20 fee tokens are debited from the ERC20 balance by converting
+// the fee to the bank coin of the FunToken mapping first.
+func (k *Keeper) ChargeFeeToken(sdb *SDB, payer gethcommon.Address, fee sdk.Coin) error {
+	feeToken, ok := k.GetFeeToken(sdb.Ctx(), fee.Denom)
+	if !ok {
+		return fmt.Errorf("%s is not a fee token", fee.Denom)
+	}
+	payerBech32 := eth.EthAddrToNibiruAddr(payer)
+	if feeToken.Erc20 != "" {
+		funtoken, err := k.feeTokenFunToken(sdb.Ctx(), feeToken)
+		if err != nil {
+			return err
+		}
+		sender := evm.Addrs{Eth: payer, Bech32: payerBech32}
+		amount := uint256.MustFromBig(fee.Amount.BigInt())
+		if funtoken.IsMadeFromCoin {
+			err = k.convertEvmToCoinForCoinOriginated(
+				sdb, sender, payerBech32, funtoken.Erc20Addr.Address, amount, funtoken.BankDenom,
+			)
+		} else {
+			err = k.convertEvmToCoinForERC20Originated(
+				sdb, sender, payerBech32, funtoken.Erc20Addr.Address, amount, funtoken.BankDenom,
+			)
+		}
+		if err != nil {
+			return sdkioerrors.Wrapf(err, "failed to convert fee token %s to %s", feeToken.ID(), fee.Denom)
+		}
+	}
+	return k.Bank.SendCoinsFromAccountToModule(
+		sdb.Ctx(), payerBech32, authtypes.FeeCollectorName, sdk.NewCoins(fee),
+	)
+}
+
+// RefundFeeToken refunds the share of fee, the fee token amount charged for
+// gasLimit, that paid for leftoverGas. The refund rounds down and comes from
+// the fee collector. ERC20 fee tokens are refunded to the ERC20 balance by
+// converting the refund back through the FunToken mapping.
+func (k *Keeper) RefundFeeToken(
+	sdb *SDB,
+	to gethcommon.Address,
+	fee sdk.Coin,
+	leftoverGas, gasLimit uint64,
+) error {
+	if leftoverGas == 0 || gasLimit == 0 {
+		return nil
+	}
+	refund := sdk.NewCoin(fee.Denom, fee.Amount.Mul(sdkmath.NewIntFromUint64(leftoverGas)).
+		Quo(sdkmath.NewIntFromUint64(gasLimit)))
+	if !refund.IsPositive() {
+		return nil
+	}
+	toBech32 := eth.EthAddrToNibiruAddr(to)
+	if err := k.Bank.SendCoinsFromModuleToAccount(
+		sdb.Ctx(), authtypes.FeeCollectorName, toBech32, sdk.NewCoins(refund),
+	); err != nil {
+		return err
+	}
+	if feeToken, ok := k.GetFeeToken(sdb.Ctx(), fee.Denom); ok && feeToken.Erc20 != "" {
+		funtoken, err := k.feeTokenFunToken(sdb.Ctx(), feeToken)
+		if err != nil {
+			return err
+		}
+		if funtoken.IsMadeFromCoin {
+			_, err = k.convertCoinToEvmBornCoin(sdb, toBech32, to, refund, funtoken)
+		} else {
+			_, err = k.convertCoinToEvmBornERC20(sdb, toBech32, to, refund, funtoken)
+		}
+		if err != nil {
+			return sdkioerrors.Wrapf(err, "failed to convert refund %s to fee token %s", refund, feeToken.ID())
+		}
+	}
+
+	// Commit so that the refund persists, as in [Keeper.RefundGas].
+	sdb.Commit()
+	return nil
+}
+
+// feeTokenFunToken returns the FunToken mapping of an ERC20 fee token.
+func (k Keeper) feeTokenFunToken(ctx sdk.Context, feeToken evm.FeeToken) (evm.FunToken, error) {
+	erc20 := gethcommon.HexToAddress(feeToken.Erc20)
+	funtokens := k.FunTokens.Collect(ctx, k.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20))
+	if len(funtokens) == 0 {
+		return evm.FunToken{}, fmt.Errorf("fee token %s has no FunToken mapping", erc20.Hex())
+	}
+	return funtokens[0], nil
+}
+
+// erc20Balance returns the balance of account in the erc20 contract. The
+// balance is read with a read-only EVM that is never committed.
+func (k Keeper) erc20Balance(
+	ctx sdk.Context, erc20, account gethcommon.Address,
+) (*big.Int, error) {
+	unusedBigInt := big.NewInt(0)
+	evmMsg := core.Message{
+		From:          evm.EVM_READONLY_ADDR,
+		Value:         unusedBigInt,
+		GasLimit:      evm.Erc20GasLimitQuery,
+		GasPrice:      unusedBigInt,
+		GasFeeCap:     unusedBigInt,
+		GasTipCap:     unusedBigInt,
+		BlobGasFeeCap: unusedBigInt,
+	}
+	sdb := NewSDB(ctx, &k, NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash())))
+	evmObj := k.NewEVM(ctx, evmMsg, k.GetEVMConfig(ctx), nil /*tracer*/, sdb)
+	return k.ERC20().BalanceOf(erc20, account, ctx, evmObj)
+}
+
+// feeTokenNativeRate returns the number of "unibi" that one unit of the fee
+// token's bank denom is worth. Both assets are priced by the x-oracle adapter
+// in the quote asset of the fee token's price pair, and both prices must be
+// newer than the fee token's max price age.
+func (k Keeper) feeTokenNativeRate(ctx sdk.Context, feeToken evm.FeeToken) (sdkmath.LegacyDec, error) {
+	if k.OracleKeeper == nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("oracle keeper is not set")
+	}
+	tokenPair, nibiPair, err := feeToken.PriceQuote()
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	tokenPrice, err := k.OracleKeeper.GetAdapterPrice(ctx, tokenPair, feeToken.MaxPriceAgeSeconds)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	nibiPrice, err := k.OracleKeeper.GetAdapterPrice(ctx, nibiPair, feeToken.MaxPriceAgeSeconds)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	// Prices are per whole token, so scale by the difference in decimals.
+	rate := tokenPrice.Quo(nibiPrice)
+	decimals := int64(nativeDecimals) - int64(feeToken.Decimals)
+	if decimals >= 0 {
+		rate = rate.MulInt(sdkmath.NewIntWithDecimal(1, int(decimals)))
+	} else {
+		rate = rate.QuoInt(sdkmath.NewIntWithDecimal(1, int(-decimals)))
+	}
+	if !rate.IsPositive() {
+		return sdkmath.LegacyDec{}, fmt.Errorf("fee token %s is worth less than 1e-18 unibi", feeToken.ID())
+	}
+	return rate, nil
+}
@@ -512,14 +512,25 @@ func (k *Keeper) EthCall(
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
 	}
-	evmCfg := k.GetEVMConfig(ctx)
+	stateOverride, blockOverrides, err := req.ParseOverrides()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	ctx, evmCfg := ApplyBlockOverrides(ctx, k.GetEVMConfig(ctx), blockOverrides)
 	isZeroGas := evm.IsZeroGasJsonTxArgs(ctx, k.SudoKeeper, args)
 	if isZeroGas {
 		ctx = evm.WithZeroGasMeta(ctx)
 	}
 
+	// pass false to not commit StateDB
+	txConfig := NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash()))
+	sdb := NewSDB(ctx, k, txConfig)
+	if err := sdb.ApplyStateOverride(stateOverride); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetAccNonce(ctx, args.GetFrom())
+	nonce := sdb.GetNonce(args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	var msg core.Message
@@ -532,9 +543,6 @@ func (k *Keeper) EthCall(
 		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
 	}
 
-	// pass false to not commit StateDB
-	txConfig := NewEmptyTxConfig(gethcommon.BytesToHash(ctx.HeaderHash()))
-	sdb := NewSDB(ctx, k, txConfig)
 	evm := k.NewEVM(ctx, msg, evmCfg, nil /*tracer*/, sdb)
 	res, err := k.ApplyEvmMsg(msg, evm, false /*commit*/)
 	if err != nil {
@@ -569,7 +577,6 @@ func (k Keeper) EstimateGas(
 
 	rootCtx := sdk.UnwrapSDKContext(goCtx).
 		WithValue(evm.CtxKeyEvmSimulation, true)
-	evmCfg := k.GetEVMConfig(rootCtx)
 
 	if req.GasCap < gethparams.TxGas {
 		return nil, grpcstatus.Errorf(grpccodes.InvalidArgument, "gas cap cannot be lower than %d", gethparams.TxGas)
@@ -580,6 +587,11 @@ func (k Keeper) EstimateGas(
 	if err != nil {
 		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
 	}
+	stateOverride, blockOverrides, err := req.ParseOverrides()
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	rootCtx, evmCfg := ApplyBlockOverrides(rootCtx, k.GetEVMConfig(rootCtx), blockOverrides)
 	isZeroGas := evm.IsZeroGasJsonTxArgs(rootCtx, k.SudoKeeper, args)
 	if isZeroGas {
 		rootCtx = evm.WithZeroGasMeta(rootCtx)
@@ -587,6 +599,9 @@ func (k Keeper) EstimateGas(
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetAccNonce(rootCtx, args.GetFrom())
+	if account, ok := stateOverride[args.GetFrom()]; ok && account.Nonce != nil {
+		nonce = uint64(*account.Nonce)
+	}
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	// Binary search the gas requirement, as it may be higher than the amount used
@@ -690,6 +705,9 @@ func (k Keeper) EstimateGas(
 				WithKVGasConfig(storetypes.GasConfig{}).
 				WithTransientKVGasConfig(storetypes.GasConfig{}),
 		)
+		if err := sdb.ApplyStateOverride(stateOverride); err != nil {
+			return true, nil, err
+		}
 
 		acct := k.GetAccount(sdb.Ctx(), evmMsg.From)
 
@@ -1101,9 +1119,10 @@ func (k *Keeper) TraceEthTxMsg(
 	}
 
 	tCtx := &tracers.Context{
-		BlockHash: txConfig.BlockHash,
-		TxIndex:   int(txConfig.TxIndex),
-		TxHash:    txConfig.TxHash,
+		BlockHash:   txConfig.BlockHash,
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		TxIndex:     int(txConfig.TxIndex),
+		TxHash:      txConfig.TxHash,
 	}
 
 	var usingCallTracer bool
@@ -1114,6 +1133,21 @@ func (k *Keeper) TraceEthTxMsg(
 			GetResult: logger.GetResult,
 			Stop:      logger.Stop,
 		}
+	} else if traceConfig.Tracer == evm.TracerAccessList {
+		// Used by "eth_createAccessList". The precompiles are always warm, so
+		// they are left out of the access list.
+		rules := evmCfg.ChainConfig.Rules(
+			big.NewInt(ctx.BlockHeight()), false, evm.ParseBlockTimeUnixU64(ctx),
+		)
+		precompileAddrs := evm.PRECOMPILE_ADDRS
+		if rules.IsCancun {
+			precompileAddrs = evm.PRECOMPILE_ADDRS_CANCUN
+		}
+		tracer = evm.NewAccessListTracer(msg, precompileAddrs)
+	} else if traceConfig.Tracer == evm.TracerERC7562 {
+		// Used by the ERC-4337 bundler to check the validation of user
+		// operations.
+		tracer = evm.NewERC7562Tracer()
 	} else {
 		if traceConfig.Tracer == "" || !gethTracerNames.Has(traceConfig.Tracer) {
 			traceConfig.Tracer = "callTracer"
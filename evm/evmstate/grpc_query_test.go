@@ -672,6 +672,68 @@ func (s *Suite) TestQueryEthCall() {
 	}
 }
 
+func (s *Suite) TestQueryEthCallOverrides() {
+	// Runtime code that returns the block number followed by storage slot 0:
+	// NUMBER PUSH1 0 MSTORE PUSH1 0 SLOAD PUSH1 32 MSTORE PUSH1 64 PUSH1 0 RETURN
+	code := hexutil.MustDecode("0x4360005260005460205260406000f3")
+	contractAddr := gethcommon.HexToAddress("0x000000000000000000000000000000000000c0de")
+	slotValue := gethcommon.HexToHash("0x2a")
+
+	deps := evmtest.NewTestDeps()
+	sender := evmtest.NewEthPrivAcc().EthAddr
+	value := evm.NativeToWei(big.NewInt(1_000))
+
+	jsonTxArgs, err := json.Marshal(&evm.JsonTxArgs{
+		From:  &sender,
+		To:    &contractAddr,
+		Value: (*hexutil.Big)(value),
+	})
+	s.Require().NoError(err)
+
+	balance := (*hexutil.Big)(value)
+	stateOverrides, err := json.Marshal(evm.StateOverride{
+		sender: {Balance: &balance},
+		contractAddr: {
+			Code:  (*hexutil.Bytes)(&code),
+			State: &map[gethcommon.Hash]gethcommon.Hash{{}: slotValue},
+		},
+	})
+	s.Require().NoError(err)
+	blockNumber := big.NewInt(1_234)
+	blockOverrides, err := json.Marshal(evm.BlockOverrides{
+		Number: (*hexutil.Big)(blockNumber),
+	})
+	s.Require().NoError(err)
+
+	s.Run("happy: state and block overrides", func() {
+		gotResp, err := deps.App.EvmKeeper.EthCall(sdk.WrapSDKContext(deps.Ctx()), &evm.EthCallRequest{
+			Args:           jsonTxArgs,
+			StateOverrides: stateOverrides,
+			BlockOverrides: blockOverrides,
+		})
+		s.Require().NoError(err)
+		s.Require().Empty(gotResp.VmError)
+		s.Require().Equal(
+			append(gethcommon.BigToHash(blockNumber).Bytes(), slotValue.Bytes()...),
+			gotResp.Ret,
+		)
+
+		// The overrides are not persisted.
+		s.Require().True(deps.App.EvmKeeper.GetWeiBalance(deps.Ctx(), sender).IsZero())
+		s.Require().Nil(deps.App.EvmKeeper.GetAccount(deps.Ctx(), contractAddr))
+	})
+
+	s.Run("sad: state and stateDiff on the same account", func() {
+		_, err := deps.App.EvmKeeper.EthCall(sdk.WrapSDKContext(deps.Ctx()), &evm.EthCallRequest{
+			Args: jsonTxArgs,
+			StateOverrides: []byte(fmt.Sprintf(
+				`{"%s":{"state":{},"stateDiff":{}}}`, contractAddr.Hex(),
+			)),
+		})
+		s.Require().ErrorContains(err, "InvalidArgument")
+	})
+}
+
 func (s *Suite) TestQueryBalance() {
 	type In = *evm.QueryBalanceRequest
 	type Out = *evm.QueryBalanceResponse
@@ -48,6 +48,15 @@ type Keeper struct {
 	stakingKeeper evm.StakingKeeper
 	SudoKeeper    evm.SudoKeeper
 
+	// DevGasKeeper: Optional x/devgas keeper that pays developers a share of
+	// the gas fees of Ethereum txs calling their contracts. It is set with
+	// [Keeper.SetDevGasKeeper] because x/devgas is built after the EVM keeper.
+	DevGasKeeper evm.DevGasKeeper
+
+	// OracleKeeper: Optional x/oracle keeper that prices fee tokens. It is set
+	// with [Keeper.SetOracleKeeper] because x/oracle queries the EVM keeper.
+	OracleKeeper evm.OracleKeeper
+
 	// tracer: Configures the output type for a geth `vm.EVMLogger`. Tracer types
 	// include "access_list", "json", "struct", and "markdown". If any other
 	// value is used, a no operation tracer is set.
@@ -98,6 +107,17 @@ func NewKeeper(
 	}
 }
 
+// SetDevGasKeeper sets the x/devgas keeper that receives the developer share
+// of EVM gas fees.
+func (k *Keeper) SetDevGasKeeper(devGasKeeper evm.DevGasKeeper) {
+	k.DevGasKeeper = devGasKeeper
+}
+
+// SetOracleKeeper sets the x/oracle keeper that prices fee tokens.
+func (k *Keeper) SetOracleKeeper(oracleKeeper evm.OracleKeeper) {
+	k.OracleKeeper = oracleKeeper
+}
+
 // GetWeiBalance: Used in the EVM Ante Handler,
 // "github.com/NibiruChain/nibiru/v2/evm/evmante": Load account's balance of gas
 // tokens for EVM execution in EVM denom units.
@@ -307,6 +307,29 @@ func (s *Suite) TestUpdateParams() {
 		s.Require().Error(err)
 		s.Require().ErrorContains(err, "invalid signing authority")
 	})
+
+	s.Run("cancun_time is fixed once Cancun is active", func() {
+		authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+		blockTime := uint64(deps.Ctx().BlockTime().Unix())
+		params := evm.DefaultParams()
+
+		// Scheduling and rescheduling a future activation is allowed.
+		for _, cancunTime := range []uint64{blockTime + 60, blockTime} {
+			params.CancunTime = cancunTime
+			_, err := deps.EvmKeeper.UpdateParams(deps.GoCtx(), &evm.MsgUpdateParams{
+				Authority: authority,
+				Params:    params,
+			})
+			s.Require().NoError(err)
+		}
+
+		params.CancunTime = 0
+		_, err := deps.EvmKeeper.UpdateParams(deps.GoCtx(), &evm.MsgUpdateParams{
+			Authority: authority,
+			Params:    params,
+		})
+		s.Require().ErrorContains(err, "cancun_time cannot change once Cancun is active")
+	})
 }
 
 func (s *Suite) TestSetParamsWasmPlugins() {
@@ -11,6 +11,7 @@ import (
 	"strconv"
 
 	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmttypes "github.com/cometbft/cometbft/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -193,9 +194,49 @@ func (k *Keeper) EthereumTx(
 			refundGas = evmMsg.GasLimit - evmResp.GasUsed
 		}
 		weiPerGas := txMsg.EffectiveGasPriceWeiPerGas(evmCfg.BaseFeeWei)
-		if err = k.RefundGas(sdb, evmMsg.From, refundGas, weiPerGas); err != nil {
+		// Gas-sponsored txs were paid from the sponsor escrow, so leftover gas
+		// goes back there.
+		if sponsorMeta := evm.GetGasSponsorMeta(rootCtxGasless); sponsorMeta != nil {
+			if err = k.RefundGas(sdb, sponsorMeta.Escrow, refundGas, weiPerGas); err != nil {
+				return nil, sdkioerrors.Wrapf(err, "error refunding leftover gas to gas sponsor %s", sponsorMeta.Sponsor)
+			}
+			refundWei := new(big.Int).Mul(new(big.Int).SetUint64(refundGas), weiPerGas)
+			k.SudoKeeper.RefundGasSponsor(
+				rootCtxGasless, sponsorMeta.Sponsor, eth.EthAddrToNibiruAddr(evmMsg.From),
+				sdkmath.NewIntFromBigInt(evm.WeiToNative(refundWei)),
+			)
+		} else if feeTokenMeta := evm.GetFeeTokenMeta(rootCtxGasless); feeTokenMeta != nil {
+			// Fee token txs were paid in the fee token, so leftover gas is
+			// refunded in it too.
+			if err = k.RefundFeeToken(
+				sdb, evmMsg.From, feeTokenMeta.Fee, refundGas, evmMsg.GasLimit,
+			); err != nil {
+				return nil, sdkioerrors.Wrapf(err, "error refunding leftover gas to sender %s", evmMsg.From)
+			}
+		} else if err = k.RefundGas(sdb, evmMsg.From, refundGas, weiPerGas); err != nil {
 			return nil, sdkioerrors.Wrapf(err, "error refunding leftover gas to sender %s", evmMsg.From)
 		}
+
+		// Pay the x/devgas developer share of the settled fees. The refund
+		// above is committed, so the payout uses the root context. Fees paid
+		// in a fee token are not shared because the payout is in NIBI.
+		//
+		// The payout is a side effect of the tx: the EVM has already run, so
+		// a failed payout is logged and skipped instead of reverting the tx.
+		if k.DevGasKeeper != nil && coreTx.To() != nil && !evm.IsFeeTokenEthTx(rootCtxGasless) {
+			feesWei := new(big.Int).Mul(new(big.Int).SetUint64(evmResp.GasUsed), weiPerGas)
+			payoutCtx, writePayout := rootCtxGasless.CacheContext()
+			if payoutErr := k.DevGasKeeper.PayoutEvmFeeShare(payoutCtx, *coreTx.To(), feesWei); payoutErr != nil {
+				k.Logger(rootCtxGasless).Error(
+					"failed to pay dev gas, skipping payout",
+					"contract", coreTx.To().Hex(),
+					"txhash", txMsg.Hash,
+					"error", payoutErr.Error(),
+				)
+			} else {
+				writePayout()
+			}
+		}
 	}
 
 	stage = "post_execution_events_and_tx_index"
@@ -280,6 +321,7 @@ func (k *Keeper) NewEVM(
 		Time:        evm.ParseBlockTimeUnixU64(ctx),
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
 		BaseFee:     evmCfg.BaseFeeWei,
+		BlobBaseFee: evm.BlobBaseFeeWei(), // read by BLOBBASEFEE after Cancun
 		Random:      &pseudoRandom,
 	}
 
@@ -288,6 +330,9 @@ func (k *Keeper) NewEVM(
 		// Return a default tracer (*[tracing.Hooks]) based on current keeper state
 		tracer = evm.NewTracer(k.tracer, msg, evmCfg.ChainConfig, ctx.BlockHeight())
 	}
+	if sdb, ok := stateDB.(*SDB); ok {
+		tracer = sdb.hookTracer(tracer)
+	}
 	vmConfig := k.VMConfig(ctx, &evmCfg, tracer)
 	evmObj = vm.NewEVM(blockCtx, txCtx, stateDB, evmCfg.ChainConfig, vmConfig)
 	evmObj.AccessEvents = state.NewAccessEvents(nil) // prevents nil pointers on access
@@ -500,12 +545,16 @@ func (k *Keeper) ApplyEvmMsg(
 	// access list preparation is moved from ante handler to here, because it's
 	// needed when `ApplyMessage` is called under contexts where ante handlers
 	// are not run, for example `eth_call` and `eth_estimateGas`.
+	precompileAddrs := evm.PRECOMPILE_ADDRS
+	if rules.IsCancun {
+		precompileAddrs = evm.PRECOMPILE_ADDRS_CANCUN
+	}
 	sdb.Prepare(
 		rules,
 		msg.From,                // sender
 		evmObj.Context.Coinbase, // coinbase
 		msg.To,
-		evm.PRECOMPILE_ADDRS,
+		precompileAddrs,
 		msg.AccessList, // accessList
 	)
 
@@ -815,6 +864,15 @@ func (k *Keeper) UpdateParams(
 		)
 	}
 
+	// Fork rules must not change for blocks that already ran under them.
+	if cancunTime := k.GetParams(ctx).CancunTime; cancunTime != 0 &&
+		cancunTime <= evm.ParseBlockTimeUnixU64(ctx) &&
+		req.Params.CancunTime != cancunTime {
+		return resp, fmt.Errorf(
+			"cancun_time cannot change once Cancun is active: cancun_time %d", cancunTime,
+		)
+	}
+
 	err = k.SetParams(ctx, req.Params)
 	if err != nil {
 		return nil, sdkioerrors.Wrapf(err, "failed to set params")
@@ -0,0 +1,110 @@
+package evmstate
+
+// Copyright (c) 2023-2024 Nibi, Inc.
+
+import (
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/holiman/uint256"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/evm"
+)
+
+// ApplyStateOverride writes the account overrides of an "eth_call" or
+// "eth_estimateGas" to the state of the [SDB]. The SDB must not be committed,
+// since the overrides are only meant for simulations.
+func (s *SDB) ApplyStateOverride(stateOverride evm.StateOverride) error {
+	if err := stateOverride.Validate(); err != nil {
+		return err
+	}
+	for addr, account := range stateOverride {
+		if account.Nonce != nil {
+			s.setNonceOverride(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			s.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil {
+			var balance *uint256.Int
+			if *account.Balance != nil {
+				var overflow bool
+				balance, overflow = uint256.FromBig((*account.Balance).ToInt())
+				if overflow {
+					return sdbErrorf("balance override of %s overflows 256 bits", addr.Hex())
+				}
+			} else {
+				balance = uint256.NewInt(0)
+			}
+			s.setBalanceOverride(addr, balance)
+		}
+		if account.State != nil {
+			// Replace the entire storage of the account.
+			for key := range s.GetStorageForOneContract(addr) {
+				s.SetState(addr, key, evm.EmptyHash)
+			}
+			for key, value := range *account.State {
+				s.SetState(addr, key, value)
+			}
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				s.SetState(addr, key, value)
+			}
+		}
+	}
+	return nil
+}
+
+// setNonceOverride sets the nonce of an account, creating the account if it
+// does not exist yet.
+func (s *SDB) setNonceOverride(addr gethcommon.Address, nonce uint64) {
+	acc := s.keeper.GetAccount(s.evmTxCtx, addr)
+	if acc == nil {
+		acc = NewEmptyAccount()
+	}
+	acc.Nonce = nonce
+	if err := s.keeper.SetAccount(s.evmTxCtx, addr, *acc); err != nil {
+		panic(sdbErrorf("%w", err))
+	}
+}
+
+// setBalanceOverride moves the balance of an account to the given value in
+// wei.
+func (s *SDB) setBalanceOverride(addr gethcommon.Address, balance *uint256.Int) {
+	current := s.GetBalance(addr)
+	switch current.Cmp(balance) {
+	case -1:
+		s.AddBalance(addr, new(uint256.Int).Sub(balance, current), tracing.BalanceChangeUnspecified)
+	case 1:
+		s.SubBalance(addr, new(uint256.Int).Sub(current, balance), tracing.BalanceChangeUnspecified)
+	}
+}
+
+// ApplyBlockOverrides returns the context and EVM config with the block
+// header fields of the overrides: the block number and time are read by the
+// EVM from the context, and the fee recipient (coinbase) and base fee from
+// the config.
+func ApplyBlockOverrides(
+	ctx sdk.Context, evmCfg EVMConfig, blockOverrides *evm.BlockOverrides,
+) (sdk.Context, EVMConfig) {
+	if blockOverrides == nil {
+		return ctx, evmCfg
+	}
+	if blockOverrides.Number != nil {
+		ctx = ctx.WithBlockHeight(blockOverrides.Number.ToInt().Int64())
+	}
+	if blockOverrides.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*blockOverrides.Time), 0).UTC())
+	}
+	if blockOverrides.FeeRecipient != nil {
+		evmCfg.BlockCoinbase = *blockOverrides.FeeRecipient
+	}
+	if blockOverrides.BaseFeePerGas != nil {
+		evmCfg.BaseFeeWei = blockOverrides.BaseFeePerGas.ToInt()
+	}
+	return ctx, evmCfg
+}
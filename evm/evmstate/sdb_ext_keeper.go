@@ -30,6 +30,11 @@ func (k *Keeper) GetAccount(ctx sdk.Context, addr gethcommon.Address) *Account {
 	return acct
 }
 
+// IsContract returns true if the account at "addr" has contract bytecode.
+func (k *Keeper) IsContract(ctx sdk.Context, addr gethcommon.Address) bool {
+	return k.getAccountWithoutBalance(ctx, addr).IsContract()
+}
+
 // GetCode: Loads smart contract bytecode associated with the given code hash.
 // Implements the `statedb.Keeper` interface.
 func (k *Keeper) GetCode(ctx sdk.Context, codeHash gethcommon.Hash) []byte {
@@ -73,6 +73,13 @@ type SDB struct {
 	savedEventLens []int
 
 	txConfig TxConfig
+
+	// tracer is the EVM tracer that follows call frames, if any. It receives
+	// the [CrossVMFrame]s reported by precompiles. See [SDB.TraceCrossVMFrames].
+	tracer *tracing.Hooks
+	// callDepth is the depth of the current EVM call frame, as last reported
+	// to the tracer.
+	callDepth int
 }
 
 func FromVM(evmObj *vm.EVM) *SDB {
@@ -760,11 +767,22 @@ func (t transientStorage) Set(addr gethcommon.Address, key, value gethcommon.Has
 
 // Get gets the transient storage for `key` at the given `addr`.
 func (t transientStorage) Get(addr gethcommon.Address, key gethcommon.Hash) gethcommon.Hash {
-	val, ok := t[addr]
+	val, _ := t.lookup(addr, key)
+	return val
+}
+
+// lookup gets the transient storage for `key` at the given `addr` and whether
+// it was set in this storage, which tells an explicit zero value apart from a
+// missing one.
+func (t transientStorage) lookup(
+	addr gethcommon.Address, key gethcommon.Hash,
+) (val gethcommon.Hash, found bool) {
+	contStore, ok := t[addr]
 	if !ok {
-		return gethcommon.Hash{}
+		return gethcommon.Hash{}, false
 	}
-	return val[key]
+	val, found = contStore[key]
+	return val, found
 }
 
 // Copy does a deep copy of the transientStorage
@@ -788,22 +806,22 @@ func (t transientStorage) CopyForContract(
 	return make(StorageForOneContract)
 }
 
-// GetTransientState gets transient storage ([gethcommon.Hash]) for a given account.
+// GetTransientState gets transient storage ([gethcommon.Hash]) for a given
+// account. The most recent snapshot that set the key wins, even if it set the
+// key back to zero (EIP-1153).
 func (s *SDB) GetTransientState(
 	addr gethcommon.Address,
 	key gethcommon.Hash,
 ) (stateVal gethcommon.Hash) {
-	stateVal = s.localState.ContractStorage.Get(addr, key)
-	if stateVal != evm.EmptyHash {
+	if stateVal, found := s.localState.ContractStorage.lookup(addr, key); found {
 		return stateVal
 	}
 	for i := len(s.savedStates) - 1; i >= 0; i-- {
-		stateVal = s.savedStates[i].ContractStorage.Get(addr, key)
-		if stateVal != evm.EmptyHash {
+		if stateVal, found := s.savedStates[i].ContractStorage.lookup(addr, key); found {
 			return stateVal
 		}
 	}
-	return stateVal
+	return evm.EmptyHash
 }
 
 func (s *SDB) GetTransientStorageForOneContract(
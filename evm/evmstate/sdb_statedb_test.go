@@ -713,6 +713,13 @@ func (s *Suite) TestSetStateAndSetTransientState() {
 	s.Equal(v5, sdb.GetTransientState(taddr, k3), "transient k3 should be v5")
 	s.Equal(v5, sdb.GetTransientState(taddr, k5), "transient k5 should be v5")
 
+	s.T().Log("Clear transient k3 after snapshot C -> zero hides the older value")
+	snapshotC := sdb.Snapshot()
+	sdb.SetTransientState(taddr, k3, evm.EmptyHash)
+	s.Equal(evm.EmptyHash, sdb.GetTransientState(taddr, k3), "transient k3 should be cleared")
+	sdb.RevertToSnapshot(snapshotC)
+	s.Equal(v5, sdb.GetTransientState(taddr, k3), "after revert: transient k3 should be v5")
+
 	s.T().Log("Revert to snapshot B -> should see B state")
 	sdb.RevertToSnapshot(snapshotB)
 	s.Equal(v1, sdb.GetState(taddr, k1), "after revert: state k1 should be v1")
@@ -0,0 +1,107 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evmstate
+
+import (
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// CrossVMFrame is a synthetic call frame for an operation that a precompile
+// runs outside of the EVM, such as the execution of a Wasm contract or a bank
+// transfer. Reported with [SDB.TraceCrossVMFrames], it shows up as a child of
+// the precompile call in the output of the "callTracer" and "flatCallTracer",
+// so that cross-VM txs can be debugged without correlating ABCI events by
+// hand.
+type CrossVMFrame struct {
+	// From is the account that starts the operation, like the caller of the
+	// precompile or the sender of a bank transfer.
+	From gethcommon.Address
+	// To is the target of the operation, like the Wasm contract or the
+	// recipient of a bank transfer. Cosmos addresses longer than 20 bytes are
+	// truncated as in [gethcommon.BytesToAddress], so the input includes the
+	// full Bech32 address.
+	To gethcommon.Address
+	// Input is a JSON description of the operation, like the contract and msg
+	// of a Wasm execute.
+	Input []byte
+	// Output is the result of the operation, like the response data of a Wasm
+	// contract.
+	Output []byte
+	// Value is the amount of NIBI in wei moved by the operation, if any.
+	Value   *big.Int
+	Gas     uint64
+	GasUsed uint64
+	// Err is the error of the operation if it failed.
+	Err error
+	// Logs are the EVM logs of the ABCI events emitted by the operation. See
+	// "precompile.EmitEventAbciEvents".
+	Logs []*gethcore.Log
+	// Calls are the nested frames of the operation, like the bank transfers
+	// made by a Wasm contract.
+	Calls []CrossVMFrame
+}
+
+// hookTracer returns the tracer hooks of an EVM that runs on the [SDB]. If
+// the tracer follows call frames, the returned hooks also record the depth of
+// the current call so that precompiles can report [CrossVMFrame]s.
+func (s *SDB) hookTracer(tracer *tracing.Hooks) *tracing.Hooks {
+	s.tracer, s.callDepth = nil, 0
+	if tracer == nil || tracer.OnEnter == nil {
+		return tracer
+	}
+	s.tracer = tracer
+
+	hooks := *tracer
+	hooks.OnEnter = func(
+		depth int, typ byte, from, to gethcommon.Address, input []byte, gas uint64, value *big.Int,
+	) {
+		s.callDepth = depth
+		tracer.OnEnter(depth, typ, from, to, input, gas, value)
+	}
+	hooks.OnExit = func(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+		s.callDepth = depth - 1
+		if tracer.OnExit != nil {
+			tracer.OnExit(depth, output, gasUsed, err, reverted)
+		}
+	}
+	return &hooks
+}
+
+// TracesCalls returns true if the EVM tracer follows call frames, in which
+// case precompiles should report their [CrossVMFrame]s.
+func (s *SDB) TracesCalls() bool {
+	return s.tracer != nil
+}
+
+// TraceCrossVMFrames reports the frames to the EVM tracer as children of the
+// current call frame, which is the precompile call when used inside of a
+// precompile. It is a no-op unless [SDB.TracesCalls] is true.
+func (s *SDB) TraceCrossVMFrames(frames ...CrossVMFrame) {
+	if s.tracer == nil {
+		return
+	}
+	for _, frame := range frames {
+		s.traceCrossVMFrame(s.callDepth+1, frame)
+	}
+}
+
+func (s *SDB) traceCrossVMFrame(depth int, frame CrossVMFrame) {
+	s.tracer.OnEnter(
+		depth, byte(vm.CALL), frame.From, frame.To, frame.Input, frame.Gas, frame.Value,
+	)
+	if s.tracer.OnLog != nil {
+		for _, log := range frame.Logs {
+			s.tracer.OnLog(log)
+		}
+	}
+	for _, call := range frame.Calls {
+		s.traceCrossVMFrame(depth+1, call)
+	}
+	if s.tracer.OnExit != nil {
+		s.tracer.OnExit(depth, frame.Output, frame.GasUsed, frame.Err, frame.Err != nil)
+	}
+}
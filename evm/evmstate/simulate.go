@@ -0,0 +1,409 @@
+package evmstate
+
+// Copyright (c) 2023-2024 Nibi, Inc.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/evm"
+)
+
+// SimulateV1: Implements the gRPC query for "/eth.evm.v1.Query/SimulateV1",
+// used by the "eth_simulateV1" JSON-RPC method. It executes a sequence of
+// calls across one or more simulated blocks on top of the state of the
+// queried block. Like [Keeper.EthCall], nothing is committed: the blocks run
+// on a branch of the query context that is discarded at the end, and each
+// block sees the state changes of the calls and blocks before it.
+func (k *Keeper) SimulateV1(
+	goCtx context.Context, req *evm.SimulateV1Request,
+) (*evm.SimulateV1Response, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var opts evm.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx).
+		WithValue(evm.CtxKeyEvmSimulation, true)
+	simCtx, _ := ctx.CacheContext()
+
+	blocks, err := k.simulateBlocks(simCtx, req.GasCap, opts)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.InvalidArgument, err.Error())
+	}
+	result, err := json.Marshal(blocks)
+	if err != nil {
+		return nil, grpcstatus.Error(grpccodes.Internal, err.Error())
+	}
+	return &evm.SimulateV1Response{Result: result}, nil
+}
+
+// simulateBlocks executes the blocks of opts in order on simCtx. The first
+// block defaults to the height after the one of simCtx.
+//
+// Like go-ethereum, gasCap is a budget for the gas used by all calls of the
+// request, not a limit per call. Each call can use at most the gas left in the
+// budget, so the whole request is bounded by gasCap.
+func (k *Keeper) simulateBlocks(
+	simCtx sdk.Context, gasCap uint64, opts evm.SimOpts,
+) ([]evm.SimBlockResult, error) {
+	var (
+		baseCfg    = k.GetEVMConfig(simCtx)
+		gasLimit   = eth.BlockGasLimit(simCtx)
+		parentHash = gethcommon.BytesToHash(simCtx.HeaderHash())
+		prevNumber = uint64(simCtx.BlockHeight())
+		prevTime   = evm.ParseBlockTimeUnixU64(simCtx)
+		results    = make([]evm.SimBlockResult, 0, len(opts.BlockStateCalls))
+		gasBudget  = gasCap
+	)
+	if gasBudget == 0 {
+		gasBudget = math.MaxUint64 / 2
+	}
+	if gasLimit == 0 {
+		// The query context has no block gas limit, so the gas of each call
+		// is only bounded by the gas budget.
+		gasLimit = gasBudget
+	}
+	for i, block := range opts.BlockStateCalls {
+		blockOverrides := evm.BlockOverrides{}
+		if block.BlockOverrides != nil {
+			blockOverrides = *block.BlockOverrides
+		}
+		if blockOverrides.Number == nil {
+			blockOverrides.Number = (*hexutil.Big)(new(big.Int).SetUint64(prevNumber + 1))
+		}
+		if number := blockOverrides.Number.ToInt().Uint64(); number <= prevNumber {
+			return nil, fmt.Errorf("block %d: block numbers must be in order: %d <= %d", i, number, prevNumber)
+		}
+		if blockOverrides.Time == nil {
+			t := hexutil.Uint64(prevTime + evm.SimulateTimestampIncrement)
+			blockOverrides.Time = &t
+		}
+		if t := uint64(*blockOverrides.Time); t <= prevTime {
+			return nil, fmt.Errorf("block %d: block timestamps must be in order: %d <= %d", i, t, prevTime)
+		}
+		// Without validation, calls don't need to pay the base fee.
+		if blockOverrides.BaseFeePerGas == nil && !opts.Validation {
+			blockOverrides.BaseFeePerGas = (*hexutil.Big)(big.NewInt(0))
+		}
+		blockCtx, evmCfg := ApplyBlockOverrides(simCtx, baseCfg, &blockOverrides)
+
+		if len(block.StateOverrides) > 0 {
+			sdb := NewSDB(blockCtx, k, NewEmptyTxConfig(gethcommon.Hash{}))
+			if err := sdb.ApplyStateOverride(block.StateOverrides); err != nil {
+				return nil, fmt.Errorf("block %d: %w", i, err)
+			}
+			sdb.Commit()
+		}
+
+		header := &gethcore.Header{
+			ParentHash: parentHash,
+			Coinbase:   evmCfg.BlockCoinbase,
+			Number:     blockOverrides.Number.ToInt(),
+			GasLimit:   gasLimit,
+			Time:       uint64(*blockOverrides.Time),
+			BaseFee:    evmCfg.BaseFeeWei,
+		}
+		result := evm.SimBlockResult{
+			Transactions: make([]evm.SimTx, 0, len(block.Calls)),
+			Calls:        make([]evm.SimCallResult, 0, len(block.Calls)),
+		}
+		var logIndex uint
+		for j, args := range block.Calls {
+			if gasBudget == 0 || (args.Gas != nil && uint64(*args.Gas) > gasBudget) {
+				return nil, fmt.Errorf(
+					"block %d, call %d: RPC gas cap exhausted: %d gas left of %d", i, j, gasBudget, gasCap,
+				)
+			}
+			callResult, simTx, err := k.simulateCall(
+				blockCtx, evmCfg, gasBudget, opts, args,
+				TxConfig{TxIndex: uint(j), LogIndex: logIndex},
+				min(gasLimit-header.GasUsed, gasBudget),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("block %d, call %d: %w", i, j, err)
+			}
+			gasBudget -= uint64(callResult.GasUsed)
+			header.GasUsed += uint64(callResult.GasUsed)
+			logIndex += uint(len(callResult.Logs))
+			result.Transactions = append(result.Transactions, *simTx)
+			result.Calls = append(result.Calls, *callResult)
+		}
+
+		blockHash := header.Hash()
+		for _, call := range result.Calls {
+			for _, log := range call.Logs {
+				log.BlockHash = blockHash
+			}
+		}
+		result.Number = hexutil.Uint64(header.Number.Uint64())
+		result.Hash = blockHash
+		result.ParentHash = header.ParentHash
+		result.Timestamp = hexutil.Uint64(header.Time)
+		result.GasLimit = hexutil.Uint64(header.GasLimit)
+		result.GasUsed = hexutil.Uint64(header.GasUsed)
+		result.Miner = header.Coinbase
+		result.BaseFeePerGas = (*hexutil.Big)(header.BaseFee)
+		results = append(results, result)
+
+		parentHash = blockHash
+		prevNumber = header.Number.Uint64()
+		prevTime = header.Time
+	}
+	return results, nil
+}
+
+// simulateCall executes one call of a simulated block and writes its state
+// changes to blockCtx. The txConfig carries the position of the call in the
+// block, gasCap is the gas left in the request's budget, and gasRemaining is
+// the gas the call can use: the smaller of the gas left in the block and
+// gasCap.
+func (k *Keeper) simulateCall(
+	blockCtx sdk.Context,
+	evmCfg EVMConfig,
+	gasCap uint64,
+	opts evm.SimOpts,
+	args evm.JsonTxArgs,
+	txConfig TxConfig,
+	gasRemaining uint64,
+) (*evm.SimCallResult, *evm.SimTx, error) {
+	ctx := blockCtx
+	isZeroGas := evm.IsZeroGasJsonTxArgs(ctx, k.SudoKeeper, args)
+	if isZeroGas {
+		ctx = evm.WithZeroGasMeta(ctx)
+	}
+
+	from := args.GetFrom()
+	nonce := k.GetAccNonce(ctx, from)
+	if args.Nonce == nil {
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	} else if opts.Validation {
+		switch callNonce := uint64(*args.Nonce); {
+		case callNonce < nonce:
+			return nil, nil, fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooLow, from.Hex(), callNonce, nonce)
+		case callNonce > nonce:
+			return nil, nil, fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooHigh, from.Hex(), callNonce, nonce)
+		}
+	}
+	if args.Gas == nil {
+		gas := hexutil.Uint64(gasRemaining)
+		args.Gas = &gas
+	} else if uint64(*args.Gas) > gasRemaining {
+		return nil, nil, fmt.Errorf("block gas limit reached: %d > %d", uint64(*args.Gas), gasRemaining)
+	}
+	if args.ChainID == nil {
+		args.ChainID = (*hexutil.Big)(evmCfg.ChainConfig.ChainID)
+	}
+
+	var (
+		msg core.Message
+		err error
+	)
+	if isZeroGas {
+		msg, err = args.ToZeroGasMessage(gasCap, evmCfg.BaseFeeWei)
+	} else {
+		msg, err = args.ToMessage(gasCap, evmCfg.BaseFeeWei)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	gas := hexutil.Uint64(msg.GasLimit)
+	args.Gas = &gas
+
+	msgEthTx := args.ToMsgEthTx()
+	txBz, err := msgEthTx.AsTransaction().MarshalBinary()
+	if err != nil {
+		return nil, nil, err
+	}
+	simTx := &evm.SimTx{
+		Hash: gethcommon.HexToHash(msgEthTx.Hash),
+		From: from,
+		Tx:   txBz,
+	}
+
+	txConfig.TxHash = simTx.Hash
+	sdb := NewSDB(ctx, k, txConfig)
+
+	var gasFee *uint256.Int
+	if opts.Validation && !isZeroGas {
+		if msg.GasFeeCap.Cmp(evmCfg.BaseFeeWei) < 0 {
+			return nil, nil, fmt.Errorf(
+				"%w: address %s, maxFeePerGas: %s, baseFee: %s",
+				core.ErrFeeCapTooLow, from.Hex(), msg.GasFeeCap, evmCfg.BaseFeeWei,
+			)
+		}
+		cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasFeeCap)
+		cost.Add(cost, msg.Value)
+		if balance := sdb.GetBalance(from).ToBig(); balance.Cmp(cost) < 0 {
+			return nil, nil, fmt.Errorf(
+				"%w: address %s have %s want %s", core.ErrInsufficientFunds, from.Hex(), balance, cost,
+			)
+		}
+		gasFee = new(uint256.Int)
+	}
+
+	var (
+		tracer         *tracing.Hooks
+		transferTracer *simTransferTracer
+	)
+	if opts.TraceTransfers {
+		transferTracer = &simTransferTracer{sdb: sdb}
+		tracer = transferTracer.Hooks()
+	}
+	evmObj := k.NewEVM(ctx, msg, evmCfg, tracer, sdb)
+	evmResp, err := k.ApplyEvmMsg(msg, evmObj, false /*commit*/)
+	if err != nil {
+		return nil, nil, err
+	}
+	if gasFee != nil {
+		// Charge the sender for the gas used as if the call were a tx.
+		gasFee.SetUint64(evmResp.GasUsed)
+		gasFee.Mul(gasFee, uint256.MustFromBig(msg.GasPrice))
+		sdb.SubBalance(from, gasFee, tracing.BalanceDecreaseGasBuy)
+	}
+
+	logs := sdb.Logs()
+	if logs == nil {
+		logs = []*gethcore.Log{}
+	}
+	if transferTracer != nil {
+		logs = transferTracer.mergeLogs(logs)
+	}
+	for i, log := range logs {
+		log.BlockNumber = uint64(ctx.BlockHeight())
+		log.TxHash = txConfig.TxHash
+		log.TxIndex = txConfig.TxIndex
+		log.Index = txConfig.LogIndex + uint(i)
+	}
+	sdb.Commit()
+	sdb.Finalise(true)
+
+	callResult := &evm.SimCallResult{
+		ReturnValue: evmResp.Ret,
+		Logs:        logs,
+		GasUsed:     hexutil.Uint64(evmResp.GasUsed),
+		Status:      hexutil.Uint64(gethcore.ReceiptStatusSuccessful),
+	}
+	if evmResp.Failed() {
+		callResult.Status = hexutil.Uint64(gethcore.ReceiptStatusFailed)
+		callResult.Logs = []*gethcore.Log{}
+		if evmResp.VmError == vm.ErrExecutionReverted.Error() {
+			callResult.Error = &evm.SimCallError{
+				Message: evm.NewRevertError(evmResp.Ret).Error(),
+				Code:    evm.SimErrCodeReverted,
+				Data:    hexutil.Encode(evmResp.Ret),
+			}
+		} else {
+			callResult.Error = &evm.SimCallError{
+				Message: evmResp.VmError,
+				Code:    evm.SimErrCodeVMError,
+			}
+		}
+	}
+	return callResult, simTx, nil
+}
+
+// simTransferTracer records the transfers of native NIBI in a simulated call
+// as ERC-20 "Transfer" logs for the "traceTransfers" option of
+// "eth_simulateV1". Transfers made in call frames that revert are dropped,
+// the same way the [SDB] drops the logs of those frames.
+type simTransferTracer struct {
+	sdb *SDB
+	// frames holds the number of transfers recorded when each call frame on
+	// the stack was entered.
+	frames    []int
+	transfers []simTransfer
+}
+
+// simTransfer is a synthesized "Transfer" log and the number of EVM logs
+// emitted before it, which is its position among the logs of the call.
+type simTransfer struct {
+	pos int
+	log *gethcore.Log
+}
+
+func (t *simTransferTracer) Hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnEnter: t.onEnter,
+		OnExit:  t.onExit,
+	}
+}
+
+func (t *simTransferTracer) onEnter(
+	depth int,
+	typ byte,
+	from gethcommon.Address,
+	to gethcommon.Address,
+	input []byte,
+	gas uint64,
+	value *big.Int,
+) {
+	t.frames = append(t.frames, len(t.transfers))
+	// A DELEGATECALL carries the value of its parent frame without moving it.
+	if vm.OpCode(typ) == vm.DELEGATECALL || value == nil || value.Sign() <= 0 {
+		return
+	}
+	t.transfers = append(t.transfers, simTransfer{
+		pos: len(t.sdb.Logs()),
+		log: &gethcore.Log{
+			Address: evm.SimTransferAddress,
+			Topics: []gethcommon.Hash{
+				evm.SimTransferTopic,
+				gethcommon.BytesToHash(from.Bytes()),
+				gethcommon.BytesToHash(to.Bytes()),
+			},
+			Data: gethcommon.BigToHash(value).Bytes(),
+		},
+	})
+}
+
+func (t *simTransferTracer) onExit(
+	depth int, output []byte, gasUsed uint64, err error, reverted bool,
+) {
+	if len(t.frames) == 0 {
+		return
+	}
+	start := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	if reverted {
+		t.transfers = t.transfers[:start]
+	}
+}
+
+// mergeLogs returns the EVM logs of the call with the transfer logs inserted
+// in the order they happened.
+func (t *simTransferTracer) mergeLogs(logs []*gethcore.Log) []*gethcore.Log {
+	merged := make([]*gethcore.Log, 0, len(logs)+len(t.transfers))
+	var next int
+	for _, transfer := range t.transfers {
+		for next < transfer.pos && next < len(logs) {
+			merged = append(merged, logs[next])
+			next++
+		}
+		merged = append(merged, transfer.log)
+	}
+	return append(merged, logs[next:]...)
+}
@@ -0,0 +1,166 @@
+package evmstate_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/evm/evmtest"
+)
+
+func (s *Suite) TestQuerySimulateV1() {
+	var (
+		sender    = evmtest.NewEthPrivAcc().EthAddr
+		recipient = evmtest.NewEthPrivAcc().EthAddr
+		value     = evm.NativeToWei(big.NewInt(1_000))
+		balance   = (*hexutil.Big)(new(big.Int).Mul(value, big.NewInt(2)))
+		// PUSH1 0 PUSH1 0 REVERT
+		revertCode   = hexutil.Bytes(hexutil.MustDecode("0x60006000fd"))
+		revertAddr   = gethcommon.HexToAddress("0x000000000000000000000000000000000000dead")
+		transferArgs = func(from, to gethcommon.Address) evm.JsonTxArgs {
+			return evm.JsonTxArgs{From: &from, To: &to, Value: (*hexutil.Big)(value)}
+		}
+	)
+
+	simulate := func(deps *evmtest.TestDeps, opts evm.SimOpts) ([]evm.SimBlockResult, error) {
+		bz, err := json.Marshal(opts)
+		s.Require().NoError(err)
+		resp, err := deps.App.EvmKeeper.SimulateV1(
+			sdk.WrapSDKContext(deps.Ctx()), &evm.SimulateV1Request{Opts: bz},
+		)
+		if err != nil {
+			return nil, err
+		}
+		var blocks []evm.SimBlockResult
+		s.Require().NoError(json.Unmarshal(resp.Result, &blocks))
+		return blocks, nil
+	}
+
+	s.Run("happy: calls across blocks with traceTransfers", func() {
+		deps := evmtest.NewTestDeps()
+		blocks, err := simulate(&deps, evm.SimOpts{
+			BlockStateCalls: []evm.SimBlock{
+				{
+					StateOverrides: evm.StateOverride{
+						sender:     {Balance: &balance},
+						revertAddr: {Code: &revertCode},
+					},
+					Calls: []evm.JsonTxArgs{
+						transferArgs(sender, recipient),
+						{From: &sender, To: &revertAddr},
+					},
+				},
+				{
+					// The recipient can only send the value back if the state
+					// of the first block carries over.
+					Calls: []evm.JsonTxArgs{transferArgs(recipient, sender)},
+				},
+			},
+			TraceTransfers: true,
+		})
+		s.Require().NoError(err)
+		s.Require().Len(blocks, 2)
+
+		first, second := blocks[0], blocks[1]
+		s.EqualValues(deps.Ctx().BlockHeight()+1, first.Number)
+		s.EqualValues(deps.Ctx().BlockHeight()+2, second.Number)
+		s.Equal(first.Hash, second.ParentHash)
+		s.Greater(second.Timestamp, first.Timestamp)
+		s.Len(first.Transactions, 2)
+
+		transfer := first.Calls[0]
+		s.EqualValues(1, transfer.Status)
+		s.Nil(transfer.Error)
+		s.Require().Len(transfer.Logs, 1)
+		log := transfer.Logs[0]
+		s.Equal(evm.SimTransferAddress, log.Address)
+		s.Equal([]gethcommon.Hash{
+			evm.SimTransferTopic,
+			gethcommon.BytesToHash(sender.Bytes()),
+			gethcommon.BytesToHash(recipient.Bytes()),
+		}, log.Topics)
+		s.Equal(gethcommon.BigToHash(value).Bytes(), log.Data)
+		s.Equal(first.Transactions[0].Hash, log.TxHash)
+		s.Equal(first.Hash, log.BlockHash)
+
+		reverted := first.Calls[1]
+		s.EqualValues(0, reverted.Status)
+		s.Require().NotNil(reverted.Error)
+		s.Equal(evm.SimErrCodeReverted, reverted.Error.Code)
+		s.Empty(reverted.Logs)
+
+		s.EqualValues(1, second.Calls[0].Status)
+		s.Require().Len(second.Calls[0].Logs, 1)
+
+		// Nothing is committed to the queried state.
+		s.True(deps.App.EvmKeeper.GetWeiBalance(deps.Ctx(), recipient).IsZero())
+		s.Nil(deps.App.EvmKeeper.GetAccount(deps.Ctx(), revertAddr))
+	})
+
+	s.Run("sad: block numbers out of order", func() {
+		deps := evmtest.NewTestDeps()
+		number := (*hexutil.Big)(big.NewInt(deps.Ctx().BlockHeight()))
+		_, err := simulate(&deps, evm.SimOpts{
+			BlockStateCalls: []evm.SimBlock{
+				{BlockOverrides: &evm.BlockOverrides{Number: number}},
+			},
+		})
+		s.Require().ErrorContains(err, "block numbers must be in order")
+	})
+
+	s.Run("sad: validation rejects a nonce that does not match the state", func() {
+		deps := evmtest.NewTestDeps()
+		nonce := hexutil.Uint64(5)
+		args := transferArgs(sender, recipient)
+		args.Nonce = &nonce
+		_, err := simulate(&deps, evm.SimOpts{
+			BlockStateCalls: []evm.SimBlock{{Calls: []evm.JsonTxArgs{args}}},
+			Validation:      true,
+		})
+		s.Require().ErrorContains(err, "nonce too high")
+	})
+
+	s.Run("sad: calls share the RPC gas cap", func() {
+		deps := evmtest.NewTestDeps()
+		gas := hexutil.Uint64(21_000)
+		first, second := transferArgs(sender, recipient), transferArgs(sender, recipient)
+		second.Gas = &gas
+		bz, err := json.Marshal(evm.SimOpts{
+			BlockStateCalls: []evm.SimBlock{
+				{
+					StateOverrides: evm.StateOverride{sender: {Balance: &balance}},
+					Calls:          []evm.JsonTxArgs{first},
+				},
+				{Calls: []evm.JsonTxArgs{second}},
+			},
+		})
+		s.Require().NoError(err)
+		_, err = deps.App.EvmKeeper.SimulateV1(
+			sdk.WrapSDKContext(deps.Ctx()), &evm.SimulateV1Request{Opts: bz, GasCap: 30_000},
+		)
+		s.Require().ErrorContains(err, "block 1, call 0: RPC gas cap exhausted")
+	})
+
+	s.Run("sad: too many calls", func() {
+		deps := evmtest.NewTestDeps()
+		calls := make([]evm.JsonTxArgs, evm.MaxSimulateCalls+1)
+		for i := range calls {
+			calls[i] = transferArgs(sender, recipient)
+		}
+		_, err := simulate(&deps, evm.SimOpts{
+			BlockStateCalls: []evm.SimBlock{{Calls: calls}},
+		})
+		s.Require().ErrorContains(err, "too many calls")
+	})
+
+	s.Run("sad: empty input", func() {
+		deps := evmtest.NewTestDeps()
+		_, err := simulate(&deps, evm.SimOpts{})
+		s.Require().ErrorContains(err, "empty input")
+	})
+}
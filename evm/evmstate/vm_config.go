@@ -13,9 +13,10 @@ import (
 )
 
 func (k *Keeper) GetEVMConfig(ctx sdk.Context) EVMConfig {
+	params := k.GetParams(ctx)
 	return EVMConfig{
-		Params:        k.GetParams(ctx),
-		ChainConfig:   evm.EthereumConfig(appconst.GetEthChainID(ctx.ChainID())),
+		Params:        params,
+		ChainConfig:   params.EthereumConfig(appconst.GetEthChainID(ctx.ChainID())),
 		BlockCoinbase: k.GetCoinbaseAddress(ctx),
 		BaseFeeWei:    k.BaseFeeWeiPerGas(ctx),
 	}
@@ -0,0 +1,58 @@
+package evm
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+)
+
+// GetGasSponsorTxData returns the x/sudo gas sponsor paying for txData sent by
+// from. Contract creations are never sponsored.
+func GetGasSponsorTxData(
+	ctx sdk.Context,
+	sudoKeeper SudoKeeper,
+	from gethcommon.Address,
+	txData TxData,
+) (meta *GasSponsorMeta, ok bool) {
+	if sudoKeeper == nil || txData == nil {
+		return nil, false
+	}
+
+	to := txData.GetTo()
+	if to == nil {
+		return nil, false
+	}
+
+	sponsor, ok := sudoKeeper.GetGasSponsor(
+		ctx, eth.EthAddrToNibiruAddr(from), eth.EthAddrToNibiruAddr(*to),
+	)
+	if !ok {
+		return nil, false
+	}
+	return &GasSponsorMeta{
+		Sponsor: sponsor,
+		Escrow:  eth.NibiruAddrToEthAddr(sudoKeeper.GasSponsorEscrowAddr()),
+	}, true
+}
+
+// WithGasSponsorMeta stores meta on ctx. Passing nil clears the marker.
+func WithGasSponsorMeta(ctx sdk.Context, meta *GasSponsorMeta) sdk.Context {
+	return ctx.WithValue(CtxKeyGasSponsorMeta, meta)
+}
+
+// WeiToNativeCeil converts a "wei" amount to "unibi" units, rounding up.
+// Sponsors are charged the rounded-up amount so that their recorded balance
+// never exceeds the funds held in escrow.
+func WeiToNativeCeil(weiAmount *big.Int) sdkmath.Int {
+	pow10 := new(big.Int).Exp(big.NewInt(10), big.NewInt(12), nil)
+	quo, rem := new(big.Int).QuoRem(weiAmount, pow10, new(big.Int))
+	if rem.Sign() > 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+	return sdkmath.NewIntFromBigInt(quo)
+}
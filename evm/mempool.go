@@ -443,6 +443,28 @@ func (m *Mempool) Snapshot() []MempoolSender {
 	return snapshot
 }
 
+// SplitByStateNonce splits the transactions of a sender at its committed state
+// nonce, following the same state nonce chain rule as [Mempool.CheckRecheck].
+// "pending" is the state nonce chain, the contiguous slots from stateNonce
+// upward, which can execute in order. "queued" is every slot after the first
+// nonce gap. Slots below stateNonce are stale and are in neither list.
+// [MempoolSender.Txs] must be in ascending nonce order.
+func (s MempoolSender) SplitByStateNonce(stateNonce uint64) (pending, queued []MempoolTx) {
+	nextNonce := stateNonce
+	for _, tx := range s.Txs {
+		switch {
+		case tx.Nonce < stateNonce:
+			continue
+		case tx.Nonce == nextNonce && len(queued) == 0:
+			pending = append(pending, tx)
+			nextNonce++
+		default:
+			queued = append(queued, tx)
+		}
+	}
+	return pending, queued
+}
+
 // mempoolIterator is a snapshot-style iterator over decoded [sdk.Tx] values for
 // [Mempool.Select]. PrepareProposal uses [Mempool.Snapshot] instead.
 type mempoolIterator struct {
@@ -207,3 +207,31 @@ func TestNewMempoolRejectsZeroLimit(t *testing.T) {
 func TestMempoolErrorsAreStable(t *testing.T) {
 	require.True(t, errors.Is(evm.ErrMempoolNonceCollision, evm.ErrMempoolNonceCollision))
 }
+
+// TestMempoolSenderSplitByStateNonce proves [evm.MempoolSender.SplitByStateNonce]
+// reports the state nonce chain as pending, slots after the first nonce gap as
+// queued, and drops stale slots below the state nonce.
+func TestMempoolSenderSplitByStateNonce(t *testing.T) {
+	sender := evm.MempoolSender{}
+	for _, nonce := range []uint64{8, 10, 11, 13, 14} {
+		sender.Txs = append(sender.Txs, evm.MempoolTx{Nonce: nonce})
+	}
+	nonces := func(txs []evm.MempoolTx) (out []uint64) {
+		for _, tx := range txs {
+			out = append(out, tx.Nonce)
+		}
+		return out
+	}
+
+	pending, queued := sender.SplitByStateNonce(10)
+	require.Equal(t, []uint64{10, 11}, nonces(pending))
+	require.Equal(t, []uint64{13, 14}, nonces(queued))
+
+	pending, queued = sender.SplitByStateNonce(9)
+	require.Empty(t, pending)
+	require.Equal(t, []uint64{10, 11, 13, 14}, nonces(queued))
+
+	pending, queued = sender.SplitByStateNonce(15)
+	require.Empty(t, pending)
+	require.Empty(t, queued)
+}
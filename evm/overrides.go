@@ -0,0 +1,94 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StateOverride is the set of accounts to override before executing an
+// "eth_call" or "eth_estimateGas", keyed by address. It is the optional third
+// parameter of "eth_call" in go-ethereum.
+type StateOverride map[gethcommon.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if stateDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64                      `json:"nonce"`
+	Code      *hexutil.Bytes                       `json:"code"`
+	Balance   **hexutil.Big                        `json:"balance"`
+	State     *map[gethcommon.Hash]gethcommon.Hash `json:"state"`
+	StateDiff *map[gethcommon.Hash]gethcommon.Hash `json:"stateDiff"`
+}
+
+// Validate returns an error if an account overrides both its full storage
+// ("state") and individual slots ("stateDiff").
+func (so StateOverride) Validate() error {
+	for addr, account := range so {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+		if account.Balance != nil && *account.Balance != nil && (*account.Balance).ToInt().Sign() < 0 {
+			return fmt.Errorf("account %s has a negative balance override", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// BlockOverrides is the set of block header fields to override before
+// executing an "eth_call" or "eth_estimateGas". It uses the JSON format of
+// go-ethereum's "BlockOverrides".
+type BlockOverrides struct {
+	Number        *hexutil.Big        `json:"number"`
+	Time          *hexutil.Uint64     `json:"time"`
+	FeeRecipient  *gethcommon.Address `json:"feeRecipient"`
+	BaseFeePerGas *hexutil.Big        `json:"baseFeePerGas"`
+}
+
+// Validate returns an error for block overrides the EVM cannot run with.
+func (bo *BlockOverrides) Validate() error {
+	if bo == nil {
+		return nil
+	}
+	if bo.Number != nil && (bo.Number.ToInt().Sign() < 0 || !bo.Number.ToInt().IsInt64()) {
+		return fmt.Errorf("invalid block number override %s", bo.Number)
+	}
+	if bo.BaseFeePerGas != nil && bo.BaseFeePerGas.ToInt().Sign() < 0 {
+		return fmt.Errorf("invalid base fee override %s", bo.BaseFeePerGas)
+	}
+	return nil
+}
+
+// ParseOverrides decodes and validates the JSON-encoded state and block
+// overrides of an [EthCallRequest]. Empty fields decode to nil.
+func (req *EthCallRequest) ParseOverrides() (StateOverride, *BlockOverrides, error) {
+	var (
+		stateOverride  StateOverride
+		blockOverrides *BlockOverrides
+	)
+	if len(req.StateOverrides) > 0 {
+		if err := json.Unmarshal(req.StateOverrides, &stateOverride); err != nil {
+			return nil, nil, fmt.Errorf("invalid state overrides: %w", err)
+		}
+		if err := stateOverride.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(req.BlockOverrides) > 0 {
+		blockOverrides = new(BlockOverrides)
+		if err := json.Unmarshal(req.BlockOverrides, blockOverrides); err != nil {
+			return nil, nil, fmt.Errorf("invalid block overrides: %w", err)
+		}
+		if err := blockOverrides.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+	return stateOverride, blockOverrides, nil
+}
@@ -0,0 +1,75 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOverrides(t *testing.T) {
+	testCases := []struct {
+		name    string
+		req     EthCallRequest
+		wantErr string
+	}{
+		{
+			name: "no overrides",
+			req:  EthCallRequest{},
+		},
+		{
+			name: "state and block overrides",
+			req: EthCallRequest{
+				StateOverrides: []byte(`{"0x0000000000000000000000000000000000000001":{"balance":"0x10","nonce":"0x2","code":"0x6000","stateDiff":{"0x0000000000000000000000000000000000000000000000000000000000000001":"0x0000000000000000000000000000000000000000000000000000000000000002"}}}`),
+				BlockOverrides: []byte(`{"number":"0x64","time":"0x3e8","feeRecipient":"0x0000000000000000000000000000000000000002","baseFeePerGas":"0x1"}`),
+			},
+		},
+		{
+			name: "state and stateDiff on the same account",
+			req: EthCallRequest{
+				StateOverrides: []byte(`{"0x0000000000000000000000000000000000000001":{"state":{},"stateDiff":{}}}`),
+			},
+			wantErr: "both 'state' and 'stateDiff'",
+		},
+		{
+			name: "invalid state overrides JSON",
+			req: EthCallRequest{
+				StateOverrides: []byte(`[]`),
+			},
+			wantErr: "invalid state overrides",
+		},
+		{
+			name: "invalid block overrides JSON",
+			req: EthCallRequest{
+				BlockOverrides: []byte(`{"number":5}`),
+			},
+			wantErr: "invalid block overrides",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stateOverride, blockOverrides, err := tc.req.ParseOverrides()
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if len(tc.req.StateOverrides) == 0 {
+				require.Nil(t, stateOverride)
+				require.Nil(t, blockOverrides)
+				return
+			}
+
+			account := stateOverride[common.BigToAddress(common.Big1)]
+			require.EqualValues(t, 2, *account.Nonce)
+			require.EqualValues(t, 16, (*account.Balance).ToInt().Int64())
+			require.Len(t, *account.StateDiff, 1)
+
+			require.EqualValues(t, 100, blockOverrides.Number.ToInt().Int64())
+			require.EqualValues(t, 1000, *blockOverrides.Time)
+			require.Equal(t, common.BigToAddress(common.Big2), *blockOverrides.FeeRecipient)
+			require.EqualValues(t, 1, blockOverrides.BaseFeePerGas.ToInt().Int64())
+		})
+	}
+}
@@ -3,6 +3,7 @@ package evm
 
 import (
 	"fmt"
+	"strings"
 
 	sdkioerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -44,6 +45,7 @@ func DefaultParams() Params {
 			Address: gethcommon.HexToAddress("0x0CaCF669f8446BeCA826913a3c6B96aCD4b02a97"),
 		},
 		WasmPlugins: []WasmPlugin{},
+		FeeTokens:   []FeeToken{},
 	}
 }
 
@@ -86,6 +88,10 @@ func (p Params) Validate() error {
 		return fmt.Errorf("ParamsError: %w", err)
 	}
 
+	if err := ValidateFeeTokens(p.FeeTokens); err != nil {
+		return fmt.Errorf("ParamsError: %w", err)
+	}
+
 	return nil
 }
 
@@ -157,3 +163,73 @@ func WasmPluginAddrsByName(
 	}
 	return pluginAddrByName, pluginNames, nil
 }
+
+// ValidateFeeTokens checks the fee tokens that can pay for gas in place of
+// NIBI. Each token is identified by exactly one of a bank denom or a
+// FunToken-mapped ERC20, and no token can appear twice.
+func ValidateFeeTokens(feeTokens []FeeToken) error {
+	seen := make(map[string]struct{}, len(feeTokens))
+	for _, feeToken := range feeTokens {
+		if err := feeToken.Validate(); err != nil {
+			return err
+		}
+		id := feeToken.ID()
+		if _, exists := seen[id]; exists {
+			return fmt.Errorf("duplicate fee token: %s", id)
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
+
+// Validate performs stateless validation of the fee token.
+func (ft FeeToken) Validate() error {
+	switch {
+	case ft.Denom == "" && ft.Erc20 == "":
+		return fmt.Errorf("fee token must have a denom or an erc20 address")
+	case ft.Denom != "" && ft.Erc20 != "":
+		return fmt.Errorf("fee token %s cannot have both a denom and an erc20 address", ft.Denom)
+	case ft.Denom != "":
+		if err := sdk.ValidateDenom(ft.Denom); err != nil {
+			return fmt.Errorf("invalid fee token denom: %w", err)
+		}
+		if ft.Denom == EVMBankDenom {
+			return fmt.Errorf("fee token cannot be the EVM bank denom %s", EVMBankDenom)
+		}
+	default:
+		if _, err := eth.NewEIP55AddrFromStr(ft.Erc20); err != nil {
+			return fmt.Errorf("invalid fee token erc20 address: %w", err)
+		}
+	}
+
+	if _, _, err := ft.PriceQuote(); err != nil {
+		return err
+	}
+	if ft.Decimals > 18 {
+		return fmt.Errorf("fee token %s has more than 18 decimals: %d", ft.ID(), ft.Decimals)
+	}
+	if ft.MaxPriceAgeSeconds == 0 {
+		return fmt.Errorf("fee token %s must have a positive max price age", ft.ID())
+	}
+	return nil
+}
+
+// ID returns the bank denom or ERC20 address that identifies the fee token.
+func (ft FeeToken) ID() string {
+	if ft.Denom != "" {
+		return ft.Denom
+	}
+	return gethcommon.HexToAddress(ft.Erc20).Hex()
+}
+
+// PriceQuote returns the adapter symbols that price the fee token and NIBI in
+// the same quote asset.
+func (ft FeeToken) PriceQuote() (tokenPair, nibiPair string, err error) {
+	base, quote, found := strings.Cut(ft.PricePair, ":")
+	if !found || base == "" || quote == "" || strings.Contains(quote, ":") {
+		return "", "", fmt.Errorf(
+			"invalid fee token price pair %q: expected the format \"{base}:{quote}\"", ft.PricePair,
+		)
+	}
+	return ft.PricePair, EVMBankDenom + ":" + quote, nil
+}
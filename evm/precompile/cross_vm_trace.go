@@ -0,0 +1,165 @@
+package precompile
+
+import (
+	"encoding/json"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	bank "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/bank/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/evm/evmstate"
+)
+
+// crossVMTrace measures an operation that a precompile runs outside of the
+// EVM, like a Wasm contract call, so that it can be reported to the EVM tracer
+// as an [evmstate.CrossVMFrame]. A nil [crossVMTrace] is inert, which is the
+// case unless the tracer follows call frames.
+type crossVMTrace struct {
+	start OnRunStartResult
+	// emittingAddr is the address of the precompile, which emits the EVM
+	// logs of the ABCI events. See [EmitEventAbciEvents].
+	emittingAddr       gethcommon.Address
+	abciEventsStartIdx int
+	gasStart           uint64
+}
+
+// startCrossVMTrace marks the beginning of an operation of the precompile at
+// "emittingAddr". It returns nil if the EVM tracer does not follow call
+// frames.
+func startCrossVMTrace(
+	start OnRunStartResult, emittingAddr gethcommon.Address,
+) *crossVMTrace {
+	if !start.SDB.TracesCalls() {
+		return nil
+	}
+	return &crossVMTrace{
+		start:              start,
+		emittingAddr:       emittingAddr,
+		abciEventsStartIdx: len(start.Ctx.EventManager().Events()),
+		gasStart:           start.Ctx.GasMeter().GasConsumed(),
+	}
+}
+
+// reportWasmCall reports a call from "caller" to a Wasm contract, where
+// "codeID" is set for instantiations. The ABCI events emitted by the call
+// become the logs of its frame, except for bank transfers, which become
+// nested frames.
+func (t *crossVMTrace) reportWasmCall(
+	caller gethcommon.Address,
+	contract sdk.AccAddress,
+	codeID uint64,
+	msg []byte,
+	funds sdk.Coins,
+	output []byte,
+	err error,
+) {
+	if t == nil {
+		return
+	}
+	var (
+		ctx      = t.start.Ctx
+		gasMeter = ctx.GasMeter()
+		frame    = evmstate.CrossVMFrame{
+			From:    caller,
+			Input:   wasmCallTraceInput(contract, codeID, msg, funds),
+			Output:  output,
+			Gas:     gasMeter.Limit() - t.gasStart,
+			GasUsed: gasMeter.GasConsumed() - t.gasStart,
+			Err:     err,
+		}
+	)
+	if !contract.Empty() {
+		frame.To = eth.NibiruAddrToEthAddr(contract)
+	}
+	for _, abciEvent := range ctx.EventManager().Events()[t.abciEventsStartIdx:] {
+		if abciEvent.Type == bank.EventTypeTransfer {
+			frame.Calls = append(frame.Calls, bankTransferFrame(ctx, abciEvent, t.emittingAddr))
+			continue
+		}
+		frame.Logs = append(frame.Logs, abciEventLog(ctx, abciEvent, t.emittingAddr))
+	}
+	t.start.SDB.TraceCrossVMFrames(frame)
+}
+
+// reportBankTransfers reports each bank transfer made since the operation
+// started as a frame of its own.
+func (t *crossVMTrace) reportBankTransfers() {
+	if t == nil {
+		return
+	}
+	var (
+		ctx    = t.start.Ctx
+		frames []evmstate.CrossVMFrame
+	)
+	for _, abciEvent := range ctx.EventManager().Events()[t.abciEventsStartIdx:] {
+		if abciEvent.Type == bank.EventTypeTransfer {
+			frames = append(frames, bankTransferFrame(ctx, abciEvent, t.emittingAddr))
+		}
+	}
+	t.start.SDB.TraceCrossVMFrames(frames...)
+}
+
+// wasmCallTraceInput returns the input of the frame of a Wasm contract call:
+// a JSON object with the contract, the code ID of instantiations, the msg and
+// the funds.
+func wasmCallTraceInput(
+	contract sdk.AccAddress, codeID uint64, msg []byte, funds sdk.Coins,
+) []byte {
+	var contractBech32 string
+	if !contract.Empty() {
+		contractBech32 = contract.String()
+	}
+	input, err := json.Marshal(struct {
+		Contract string          `json:"contract,omitempty"`
+		CodeID   uint64          `json:"codeId,omitempty"`
+		Msg      json.RawMessage `json:"msg"`
+		Funds    string          `json:"funds,omitempty"`
+	}{
+		Contract: contractBech32,
+		CodeID:   codeID,
+		Msg:      msg,
+		Funds:    funds.String(),
+	})
+	if err != nil {
+		// The msg is not valid JSON, so the Wasm VM rejects it as well.
+		return msg
+	}
+	return input
+}
+
+// bankTransferFrame returns the frame of the bank transfer of a "transfer"
+// ABCI event. Its input holds the event attributes as JSON, and its value is
+// the amount of NIBI transferred, if any.
+func bankTransferFrame(
+	ctx sdk.Context, abciEvent sdk.Event, emittingAddr gethcommon.Address,
+) evmstate.CrossVMFrame {
+	frame := evmstate.CrossVMFrame{
+		Input: AttrsToJSON(abciEvent.Attributes),
+		Logs:  []*gethcore.Log{abciEventLog(ctx, abciEvent, emittingAddr)},
+	}
+	for _, attr := range abciEvent.Attributes {
+		switch attr.Key {
+		case bank.AttributeKeySender:
+			if addr, err := sdk.AccAddressFromBech32(attr.Value); err == nil {
+				frame.From = eth.NibiruAddrToEthAddr(addr)
+			}
+		case bank.AttributeKeyRecipient:
+			if addr, err := sdk.AccAddressFromBech32(attr.Value); err == nil {
+				frame.To = eth.NibiruAddrToEthAddr(addr)
+			}
+		case sdk.AttributeKeyAmount:
+			coins, err := sdk.ParseCoinsNormalized(attr.Value)
+			if err != nil {
+				continue
+			}
+			if amount := coins.AmountOf(evm.EVMBankDenom); amount.IsPositive() {
+				frame.Value = evm.NativeToWei(amount.BigInt())
+			}
+		}
+	}
+	return frame
+}
@@ -112,6 +112,7 @@ func (p precompileFunToken) DynamicRun(
 	}()
 
 	abciEventsStartIdx := len(startResult.Ctx.EventManager().Events())
+	trace := startCrossVMTrace(startResult, p.Address())
 
 	method := startResult.Method
 	switch PrecompileMethod(method.Name) {
@@ -148,6 +149,9 @@ func (p precompileFunToken) DynamicRun(
 	// Emit extra events for the EVM if this is a transaction
 	// https://github.com/NibiruChain/nibiru/issues/2121
 	if isMutation[PrecompileMethod(startResult.Method.Name)] {
+		// Report the bank side of the call, like the coins sent by
+		// "sendToBank", alongside the ERC20 calls seen by the EVM tracer.
+		trace.reportBankTransfers()
 		EmitEventAbciEvents(
 			startResult.Ctx,
 			startResult.SDB,
@@ -217,9 +221,41 @@ func (p precompileFunToken) sendToBank(
 		return nil, fmt.Errorf("recipient address invalid (%s): %w", to, err)
 	}
 
+	// Amount should be positive
+	if amount == nil || amount.Cmp(big.NewInt(0)) != 1 {
+		return nil, fmt.Errorf("transfer amount must be positive")
+	}
+
+	coinSent, err := sendErc20AsBankCoin(
+		ctx, p.evmKeeper, evmObj, erc20, caller, amount, eth.EthAddrToNibiruAddr(toAddr),
+	)
+	if err != nil {
+		return nil, err
+	}
+	gotAmount = coinSent.Amount.BigInt()
+
+	return method.Outputs.Pack(gotAmount)
+}
+
+// sendErc20AsBankCoin moves "amount" of an ERC20 held by "caller" to the EVM
+// module account and sends the equivalent Bank Coin of the ERC20's FunToken
+// mapping to "to". The ERC20 is burned if the FunToken mapping was made from a
+// coin; otherwise it stays escrowed and the Bank Coin is minted.
+//
+// The returned coin may be smaller than "amount" if the ERC20 charges a fee or
+// otherwise reduces the transfer value.
+func sendErc20AsBankCoin(
+	ctx sdk.Context,
+	evmKeeper *evmstate.Keeper,
+	evmObj *vm.EVM,
+	erc20 gethcommon.Address,
+	caller gethcommon.Address,
+	amount *big.Int,
+	to sdk.AccAddress,
+) (coinSent sdk.Coin, err error) {
 	// ERC20 must have a FunToken mapping with the Bank Coin.
-	funtokens := p.evmKeeper.FunTokens.Collect(
-		ctx, p.evmKeeper.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20),
+	funtokens := evmKeeper.FunTokens.Collect(
+		ctx, evmKeeper.FunTokens.Indexes.ERC20Addr.ExactMatch(ctx, erc20),
 	)
 	if len(funtokens) != 1 {
 		err = fmt.Errorf("no FunToken mapping exists for ERC20 \"%s\"", erc20.Hex())
@@ -227,13 +263,8 @@ func (p precompileFunToken) sendToBank(
 	}
 	funtoken := funtokens[0]
 
-	// Amount should be positive
-	if amount == nil || amount.Cmp(big.NewInt(0)) != 1 {
-		return nil, fmt.Errorf("transfer amount must be positive")
-	}
-
 	// Caller transfers ERC20 to the EVM module account
-	gotAmount, _, err = p.evmKeeper.ERC20().Transfer(
+	gotAmount, _, err := evmKeeper.ERC20().Transfer(
 		erc20,                  /*erc20*/
 		caller,                 /*from*/
 		evm.EVM_MODULE_ADDRESS, /*to*/
@@ -242,7 +273,7 @@ func (p precompileFunToken) sendToBank(
 		evmObj,
 	)
 	if err != nil {
-		return nil, fmt.Errorf(
+		return coinSent, fmt.Errorf(
 			"error in ERC20.transfer from caller to EVM account: from %s, erc20 %s, amount: %s: %w",
 			caller, erc20, amount, err,
 		)
@@ -255,9 +286,9 @@ func (p precompileFunToken) sendToBank(
 		// owns the ERC20 contract and was the original minter of the ERC20 tokens.
 		// Since we're sending them away and want accurate total supply tracking, the
 		// tokens need to be burned.
-		_, err := p.evmKeeper.ERC20().Burn(erc20, evm.EVM_MODULE_ADDRESS, gotAmount, ctx, evmObj)
+		_, err := evmKeeper.ERC20().Burn(erc20, evm.EVM_MODULE_ADDRESS, gotAmount, ctx, evmObj)
 		if err != nil {
-			return nil, fmt.Errorf("ERC20.Burn: %w", err)
+			return coinSent, fmt.Errorf("ERC20.Burn: %w", err)
 		}
 	} else {
 		// NOTE: [Security - Nibiru#2095](https://github.com/NibiruChain/nibiru/pull/2095)
@@ -265,9 +296,9 @@ func (p precompileFunToken) sendToBank(
 		// before any operation that has the potential to use Bank send methods.
 		// This will guarantee that [evmkeeper.Keeper.SetAccBalance] journal
 		// changes are recorded if wei (NIBI) is transferred.
-		err = p.evmKeeper.Bank.MintCoins(ctx, evm.ModuleName, sdk.NewCoins(coinToSend))
+		err = evmKeeper.Bank.MintCoins(ctx, evm.ModuleName, sdk.NewCoins(coinToSend))
 		if err != nil {
-			return nil, fmt.Errorf("mint failed for module \"%s\" (%s): contract caller %s: %w",
+			return coinSent, fmt.Errorf("mint failed for module \"%s\" (%s): contract caller %s: %w",
 				evm.ModuleName, evm.EVM_MODULE_ADDRESS.Hex(), caller.Hex(), err,
 			)
 		}
@@ -280,19 +311,18 @@ func (p precompileFunToken) sendToBank(
 	// before any operation that has the potential to use Bank send methods.
 	// This will guarantee that [evmkeeper.Keeper.SetAccBalance] journal
 	// changes are recorded if wei (NIBI) is transferred.
-	err = p.evmKeeper.Bank.SendCoinsFromModuleToAccount(
+	err = evmKeeper.Bank.SendCoinsFromModuleToAccount(
 		ctx,
 		evm.ModuleName,
-		eth.EthAddrToNibiruAddr(toAddr),
+		to,
 		sdk.NewCoins(coinToSend),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("send failed for module \"%s\" (%s): contract caller %s: %w",
+		return coinSent, fmt.Errorf("send failed for module \"%s\" (%s): contract caller %s: %w",
 			evm.ModuleName, evm.EVM_MODULE_ADDRESS.Hex(), caller.Hex(), err,
 		)
 	}
-
-	return method.Outputs.Pack(gotAmount)
+	return coinToSend, nil
 }
 
 func (p precompileFunToken) parseArgsSendToBank(args []any) (
@@ -0,0 +1,353 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	ibctransferkeeper "github.com/NibiruChain/nibiru/v2/lib/ibc-go/modules/apps/transfer/keeper"
+	ibctransfertypes "github.com/NibiruChain/nibiru/v2/lib/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/NibiruChain/nibiru/v2/lib/ibc-go/modules/core/02-client/types"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/evm/embeds"
+	evmstate "github.com/NibiruChain/nibiru/v2/evm/evmstate"
+)
+
+var (
+	_ vm.PrecompiledContract = (*precompileIbcTransfer)(nil)
+	_ vm.DynamicPrecompile   = (*precompileIbcTransfer)(nil)
+)
+
+// Precompile address for "IIbcTransfer.sol", the contract that sends bank
+// coins and FunToken ERC20s to other chains over ICS-20 channels.
+var PrecompileAddr_IbcTransfer = gethcommon.HexToAddress("0x0000000000000000000000000000000000000804")
+
+// IbcTransferDefaultTimeout is the packet timeout, relative to the current
+// block time, used when "IIbcTransfer.transfer" is called with a zero
+// "timeoutTimestamp".
+const IbcTransferDefaultTimeout = 10 * time.Minute
+
+// EvmEventIbcTransfer is the name of the EVM event emitted by
+// "IIbcTransfer.transfer".
+const EvmEventIbcTransfer = "IbcTransfer"
+
+// Contract methods from IIbcTransfer.sol
+const (
+	IbcTransferMethod_transfer PrecompileMethod = "transfer"
+)
+
+func (p precompileIbcTransfer) Address() gethcommon.Address {
+	return PrecompileAddr_IbcTransfer
+}
+
+// RequiredGas calculates the cost of calling the precompile in gas units.
+func (p precompileIbcTransfer) RequiredGas(input []byte) (gasCost uint64) {
+	return requiredGas(input, p.ABI())
+}
+
+func (p precompileIbcTransfer) ABI() *gethabi.ABI {
+	return embeds.SmartContract_IbcTransfer.ABI
+}
+
+func (p precompileIbcTransfer) Run(
+	evmObj *vm.EVM,
+	trueCaller gethcommon.Address,
+	// Note that we use "trueCaller" here to differentiate between a delegate
+	// caller ("parent.CallerAddress" in geth) and "contract.CallerAddress"
+	// because these two addresses may differ.
+	contract *vm.Contract,
+	readonly bool,
+	// isDelegatedCall: Flag to add conditional logic specific to delegate calls
+	isDelegatedCall bool,
+) (bz []byte, err error) {
+	bz, _, err = p.DynamicRun(evmObj, trueCaller, contract, readonly, isDelegatedCall)
+	return bz, err
+}
+
+// DynamicRun runs the precompiled contract and returns the gas cost.
+func (p precompileIbcTransfer) DynamicRun(
+	evmObj *vm.EVM,
+	trueCaller gethcommon.Address,
+	// Note that we use "trueCaller" here to differentiate between a delegate
+	// caller ("parent.CallerAddress" in geth) and "contract.CallerAddress"
+	// because these two addresses may differ.
+	contract *vm.Contract,
+	readonly bool,
+	// isDelegatedCall: Flag to add conditional logic specific to delegate calls
+	isDelegatedCall bool,
+) (bz []byte, gasCost uint64, err error) {
+	defer func() {
+		err = ErrPrecompileRun(err, p)
+	}()
+	startResult, err := OnRunStart(evmObj, contract.Input, p.ABI(), contract.Gas)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		// Recover OOG panics as ErrOutOfGas; other panics become an error.
+		var (
+			oog  bool  // true if panic was out-of-gas
+			perr error // ErrOutOfGas for OOG, or formatted error for unexpected panic
+		)
+		panicInfo := recover()
+		if panicInfo != nil {
+			oog, perr = evm.ParseOOGPanic(panicInfo, func(p any) string {
+				return fmt.Sprintf("unexpected panic in precompile: %v", p)
+			})
+		}
+		if oog {
+			gasCost = startResult.Ctx.GasMeter().GasConsumed()
+			err = perr
+			return
+		} else if perr != nil {
+			err = perr
+			return
+		}
+	}()
+
+	abciEventsStartIdx := len(startResult.Ctx.EventManager().Events())
+
+	method := startResult.Method
+	switch PrecompileMethod(method.Name) {
+	case IbcTransferMethod_transfer:
+		bz, err = p.transfer(startResult, trueCaller, readonly, evmObj)
+	default:
+		// Note that this code path should be impossible to reach since
+		// "[decomposeInput]" parses methods directly from the ABI.
+		err = fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+		return
+	}
+	// Gas consumed by a local gas meter (the one in startResult.Ctx from OnRunStart).
+	// The EVM applies it via the returned gasCost from DynamicRun.
+	gasCost = startResult.Ctx.GasMeter().GasConsumed()
+	if err != nil {
+		bz = revertBzForErr(err)
+		return bz, gasCost, err
+	}
+
+	// Emit extra events for the EVM if this is a transaction
+	// https://github.com/NibiruChain/nibiru/issues/2121
+	if isMutation[PrecompileMethod(startResult.Method.Name)] {
+		EmitEventAbciEvents(
+			startResult.Ctx,
+			startResult.SDB,
+			startResult.Ctx.EventManager().Events()[abciEventsStartIdx:],
+			p.Address(),
+		)
+	}
+
+	return bz, gasCost, err
+}
+
+func PrecompileIbcTransfer(keepers keepers.PublicKeepers) NibiruCustomPrecompile {
+	return precompileIbcTransfer{
+		transferKeeper: keepers.IbcTransferKeeper,
+		evmKeeper:      keepers.EvmKeeper,
+	}
+}
+
+type precompileIbcTransfer struct {
+	transferKeeper ibctransferkeeper.Keeper
+	evmKeeper      *evmstate.Keeper
+}
+
+// transfer: Implements "IIbcTransfer.transfer"
+//
+//	```solidity
+//	function transfer(
+//	    string calldata sourceChannel,
+//	    string calldata receiver,
+//	    string calldata denom,
+//	    uint256 amount,
+//	    uint64 timeoutTimestamp,
+//	    string calldata memo
+//	) external returns (uint64 sequence);
+//	```
+//
+// If "denom" is the hex address of an ERC20 with a FunToken mapping, the ERC20
+// is converted to its Bank Coin on the caller's account before the transfer,
+// exactly as "IFunToken.sendToBank" would do.
+func (p precompileIbcTransfer) transfer(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+	evmObj *vm.EVM,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.Ctx
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+	if err := assertNotVMCaller(ctx, method); err != nil {
+		return nil, err
+	}
+
+	parsed, err := p.parseArgsTransfer(args)
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+	sender := eth.EthAddrToNibiruAddr(caller)
+
+	// Resolve the coin to send. ERC20s are converted to their Bank Coin first.
+	var token sdk.Coin
+	if eth.ValidateAddress(parsed.Denom) == nil {
+		token, err = sendErc20AsBankCoin(
+			ctx,
+			p.evmKeeper,
+			evmObj,
+			gethcommon.HexToAddress(parsed.Denom),
+			caller,
+			parsed.Amount,
+			sender,
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		token = sdk.NewCoin(parsed.Denom, sdkmath.NewIntFromBigInt(parsed.Amount))
+	}
+
+	timeoutTimestamp := parsed.TimeoutTimestamp
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = uint64(ctx.BlockTime().Add(IbcTransferDefaultTimeout).UnixNano())
+	}
+
+	msg := ibctransfertypes.NewMsgTransfer(
+		ibctransfertypes.PortID,
+		parsed.SourceChannel,
+		token,
+		sender.String(),
+		parsed.Receiver,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		parsed.Memo,
+	)
+	if err = msg.ValidateBasic(); err != nil {
+		return
+	}
+	resp, err := p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return
+	}
+
+	p.emitEventIbcTransfer(start, caller, parsed.SourceChannel, resp.Sequence, parsed.Receiver, token)
+	return method.Outputs.Pack(resp.Sequence)
+}
+
+// emitEventIbcTransfer adds the "IIbcTransfer.IbcTransfer" event to the EVM
+// logs of the current transaction.
+func (p precompileIbcTransfer) emitEventIbcTransfer(
+	start OnRunStartResult,
+	sender gethcommon.Address,
+	sourceChannel string,
+	sequence uint64,
+	receiver string,
+	token sdk.Coin,
+) {
+	event := p.ABI().Events[EvmEventIbcTransfer]
+	// 4 topics = event ID + number of indexed event fields
+	topics := []gethcommon.Hash{
+		event.ID,
+		gethcommon.BytesToHash(sender.Bytes()),
+		EventTopicFromString(sourceChannel),
+		gethcommon.BigToHash(new(big.Int).SetUint64(sequence)),
+	}
+	nonIndexedArgs, _ := event.Inputs.NonIndexed().Pack(
+		receiver, token.Denom, token.Amount.BigInt(),
+	)
+	start.SDB.AddLog(&gethcore.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        nonIndexedArgs,
+		BlockNumber: uint64(start.Ctx.BlockHeight()),
+	})
+}
+
+// ibcTransferArgs holds the parsed arguments of "IIbcTransfer.transfer".
+type ibcTransferArgs struct {
+	SourceChannel    string
+	Receiver         string
+	Denom            string
+	Amount           *big.Int
+	TimeoutTimestamp uint64
+	Memo             string
+}
+
+func (p precompileIbcTransfer) parseArgsTransfer(args []any) (
+	parsed ibcTransferArgs, err error,
+) {
+	if e := assertNumArgs(args, 6); e != nil {
+		err = e
+		return
+	}
+
+	argIdx := 0
+	sourceChannel, ok := args[argIdx].(string)
+	if !ok || sourceChannel == "" {
+		err = ErrArgTypeValidation("string sourceChannel", args[argIdx])
+		return
+	}
+
+	argIdx++
+	receiver, ok := args[argIdx].(string)
+	if !ok || receiver == "" {
+		err = ErrArgTypeValidation("string receiver", args[argIdx])
+		return
+	}
+
+	argIdx++
+	denom, ok := args[argIdx].(string)
+	if !ok || denom == "" {
+		err = ErrArgTypeValidation("string denom", args[argIdx])
+		return
+	}
+
+	argIdx++
+	amount, ok := args[argIdx].(*big.Int)
+	if !ok {
+		err = ErrArgTypeValidation("uint256 amount", args[argIdx])
+		return
+	}
+	if amount == nil || amount.Sign() != 1 {
+		err = fmt.Errorf("transfer amount must be positive")
+		return
+	}
+
+	argIdx++
+	timeoutTimestamp, ok := args[argIdx].(uint64)
+	if !ok {
+		err = ErrArgTypeValidation("uint64 timeoutTimestamp", args[argIdx])
+		return
+	}
+
+	argIdx++
+	memo, ok := args[argIdx].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string memo", args[argIdx])
+		return
+	}
+
+	return ibcTransferArgs{
+		SourceChannel:    sourceChannel,
+		Receiver:         receiver,
+		Denom:            denom,
+		Amount:           amount,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}, nil
+}
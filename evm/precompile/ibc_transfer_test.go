@@ -0,0 +1,277 @@
+package precompile_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	ibctransfertypes "github.com/NibiruChain/nibiru/v2/lib/ibc-go/modules/apps/transfer/types"
+	ibctesting "github.com/NibiruChain/nibiru/v2/lib/ibc-go/testing"
+
+	"github.com/NibiruChain/nibiru/v2/app"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/evm/precompile"
+	"github.com/NibiruChain/nibiru/v2/x/nutil/testapp"
+)
+
+const ibcTransferPrecompileGasLimit uint64 = 2_000_000
+
+type IbcTransferSuite struct {
+	suite.Suite
+}
+
+func TestIbcTransferSuite(t *testing.T) {
+	suite.Run(t, new(IbcTransferSuite))
+}
+
+func TestIbcTransferPrecompileFailToPackABI(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		methodName string
+		callArgs   []any
+		wantError  string
+	}{
+		{
+			name:       "wrong amount of call args",
+			methodName: string(precompile.IbcTransferMethod_transfer),
+			callArgs:   []any{"channel-0", "cosmos1", "unibi", big.NewInt(1)},
+			wantError:  "argument count mismatch: got 4 for 6",
+		},
+		{
+			name:       "wrong type for timeoutTimestamp",
+			methodName: string(precompile.IbcTransferMethod_transfer),
+			callArgs:   []any{"channel-0", "cosmos1", "unibi", big.NewInt(1), big.NewInt(1), ""},
+			wantError:  "abi: cannot use ptr as type uint64 as argument",
+		},
+		{
+			name:       "invalid method name",
+			methodName: "foo",
+			callArgs:   []any{"channel-0"},
+			wantError:  "method 'foo' not found",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			input, err := embeds.SmartContract_IbcTransfer.ABI.Pack(tc.methodName, tc.callArgs...)
+			require.ErrorContains(t, err, tc.wantError)
+			require.Nil(t, input)
+		})
+	}
+}
+
+func (s *IbcTransferSuite) TestTransfer() {
+	deps := evmtest.NewTestDeps()
+	const receiver = "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+
+	callTransfer := func(denom string, amount *big.Int) error {
+		input, err := embeds.SmartContract_IbcTransfer.ABI.Pack(
+			string(precompile.IbcTransferMethod_transfer),
+			"channel-0", receiver, denom, amount, uint64(0), "",
+		)
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		_, err = deps.EvmKeeper.CallContract(
+			evmObj,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_IbcTransfer,
+			input,
+			ibcTransferPrecompileGasLimit,
+			evm.COMMIT_ETH_TX,
+			nil,
+		)
+		return err
+	}
+
+	s.Run("sad: zero amount", func() {
+		err := callTransfer("unibi", big.NewInt(0))
+		s.Require().ErrorContains(err, "transfer amount must be positive")
+	})
+
+	s.Run("sad: ERC20 without a FunToken mapping", func() {
+		err := callTransfer(evmtest.NewEthPrivAcc().EthAddr.Hex(), big.NewInt(1))
+		s.Require().ErrorContains(err, "no FunToken mapping exists for ERC20")
+	})
+
+	s.Run("sad: bank coin over a channel that does not exist", func() {
+		s.Require().NoError(testapp.FundAccount(
+			deps.App.BankKeeper,
+			deps.Ctx(),
+			deps.Sender.NibiruAddr,
+			sdk.NewCoins(sdk.NewInt64Coin("unibi", 1_000)),
+		))
+		err := callTransfer("unibi", big.NewInt(1_000))
+		s.Require().ErrorContains(err, "channel not found")
+	})
+
+	s.Run("sad: FunToken ERC20 is not spent when the transfer fails", func() {
+		funtoken := evmtest.CreateFunTokenForBankCoin(deps, "ibcdenom", &s.Suite)
+		s.Require().NoError(testapp.FundAccount(
+			deps.App.BankKeeper,
+			deps.Ctx(),
+			deps.Sender.NibiruAddr,
+			sdk.NewCoins(sdk.NewInt64Coin(funtoken.BankDenom, 500)),
+		))
+		_, err := deps.EvmKeeper.ConvertCoinToEvm(
+			sdk.WrapSDKContext(deps.Ctx()),
+			&evm.MsgConvertCoinToEvm{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				BankCoin:  sdk.NewInt64Coin(funtoken.BankDenom, 500),
+				ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+			},
+		)
+		s.Require().NoError(err)
+
+		err = callTransfer(funtoken.Erc20Addr.Hex(), big.NewInt(200))
+		s.Require().ErrorContains(err, "channel not found")
+
+		evmObj, _ := deps.NewEVM()
+		evmtest.FunTokenBalanceAssert{
+			FunToken:     funtoken,
+			Account:      deps.Sender.EthAddr,
+			BalanceBank:  big.NewInt(0),
+			BalanceERC20: big.NewInt(500),
+			Description:  "ERC20 conversion reverts with the failed transfer",
+		}.Assert(s.T(), deps, evmObj)
+	})
+}
+
+// TestTransferOverOpenChannel sends a bank coin and a FunToken ERC20 over an
+// ICS-20 channel opened with the ibctesting helpers.
+func (s *IbcTransferSuite) TestTransferOverOpenChannel() {
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		return testapp.NewNibiruTestApp(app.GenesisState{})
+	}
+	coordinator := ibctesting.NewCoordinator(s.T(), 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = ibctransfertypes.Version
+	path.EndpointB.ChannelConfig.Version = ibctransfertypes.Version
+	coordinator.Setup(path)
+
+	nibiru, ok := chainA.App.(*app.NibiruApp)
+	s.Require().True(ok)
+	deps := evmtest.TestDeps{
+		App:       nibiru,
+		EvmKeeper: nibiru.EvmKeeper,
+		GenState:  evm.DefaultGenesisState(),
+		Sender:    evmtest.NewEthPrivAcc(),
+	}
+	deps.SetCtx(chainA.GetContext())
+
+	channel := path.EndpointA.ChannelID
+	escrow := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, channel)
+	receiver := chainB.SenderAccount.GetAddress().String()
+	event := embeds.SmartContract_IbcTransfer.ABI.Events[precompile.EvmEventIbcTransfer]
+
+	// callTransfer calls the precompile and returns the packet sequence and
+	// the "IbcTransfer" log it emitted.
+	callTransfer := func(denom string, amount *big.Int) (uint64, *gethcore.Log) {
+		input, err := embeds.SmartContract_IbcTransfer.ABI.Pack(
+			string(precompile.IbcTransferMethod_transfer),
+			channel, receiver, denom, amount, uint64(0), "memo",
+		)
+		s.Require().NoError(err)
+		evmObj, sdb := deps.NewEVM()
+		logsStartIdx := len(sdb.Logs())
+		evmResp, err := deps.EvmKeeper.CallContract(
+			evmObj,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_IbcTransfer,
+			input,
+			ibcTransferPrecompileGasLimit,
+			evm.COMMIT_ETH_TX,
+			nil,
+		)
+		s.Require().NoError(err)
+
+		out, err := embeds.SmartContract_IbcTransfer.ABI.Unpack(
+			string(precompile.IbcTransferMethod_transfer), evmResp.Ret,
+		)
+		s.Require().NoError(err)
+		s.Require().Len(out, 1)
+		sequence, ok := out[0].(uint64)
+		s.Require().True(ok)
+
+		for _, log := range sdb.Logs()[logsStartIdx:] {
+			if log.Address == precompile.PrecompileAddr_IbcTransfer &&
+				len(log.Topics) > 0 && log.Topics[0] == event.ID {
+				return sequence, log
+			}
+		}
+		s.FailNow("missing IbcTransfer event log")
+		return 0, nil
+	}
+
+	assertLog := func(log *gethcore.Log, sequence uint64, denom string, amount *big.Int) {
+		s.Require().Equal([]gethcommon.Hash{
+			event.ID,
+			gethcommon.BytesToHash(deps.Sender.EthAddr.Bytes()),
+			precompile.EventTopicFromString(channel),
+			gethcommon.BigToHash(new(big.Int).SetUint64(sequence)),
+		}, log.Topics)
+		data, err := event.Inputs.NonIndexed().Unpack(log.Data)
+		s.Require().NoError(err)
+		s.Require().Equal([]any{receiver, denom, amount}, data)
+	}
+
+	s.Run("happy: bank coin is escrowed", func() {
+		s.Require().NoError(testapp.FundAccount(
+			deps.App.BankKeeper,
+			deps.Ctx(),
+			deps.Sender.NibiruAddr,
+			sdk.NewCoins(sdk.NewInt64Coin("unibi", 1_000)),
+		))
+
+		sequence, log := callTransfer("unibi", big.NewInt(400))
+		s.Require().EqualValues(1, sequence)
+		assertLog(log, sequence, "unibi", big.NewInt(400))
+
+		bankKeeper := deps.App.BankKeeper
+		s.Require().EqualValues(600, bankKeeper.GetBalance(deps.Ctx(), deps.Sender.NibiruAddr, "unibi").Amount.Int64())
+		s.Require().EqualValues(400, bankKeeper.GetBalance(deps.Ctx(), escrow, "unibi").Amount.Int64())
+	})
+
+	s.Run("happy: FunToken ERC20 is converted, then escrowed", func() {
+		funtoken := evmtest.CreateFunTokenForBankCoin(deps, "ibcdenom", &s.Suite)
+		s.Require().NoError(testapp.FundAccount(
+			deps.App.BankKeeper,
+			deps.Ctx(),
+			deps.Sender.NibiruAddr,
+			sdk.NewCoins(sdk.NewInt64Coin(funtoken.BankDenom, 500)),
+		))
+		_, err := deps.EvmKeeper.ConvertCoinToEvm(
+			sdk.WrapSDKContext(deps.Ctx()),
+			&evm.MsgConvertCoinToEvm{
+				Sender:    deps.Sender.NibiruAddr.String(),
+				BankCoin:  sdk.NewInt64Coin(funtoken.BankDenom, 500),
+				ToEthAddr: eth.EIP55Addr{Address: deps.Sender.EthAddr},
+			},
+		)
+		s.Require().NoError(err)
+
+		sequence, log := callTransfer(funtoken.Erc20Addr.Hex(), big.NewInt(200))
+		s.Require().EqualValues(2, sequence)
+		assertLog(log, sequence, funtoken.BankDenom, big.NewInt(200))
+
+		evmObj, _ := deps.NewEVM()
+		evmtest.FunTokenBalanceAssert{
+			FunToken:     funtoken,
+			Account:      deps.Sender.EthAddr,
+			BalanceBank:  big.NewInt(0),
+			BalanceERC20: big.NewInt(300),
+			Description:  "ERC20 is spent by the transfer",
+		}.Assert(s.T(), deps, evmObj)
+		s.Require().EqualValues(200, deps.App.BankKeeper.GetBalance(deps.Ctx(), escrow, funtoken.BankDenom).Amount.Int64())
+	})
+}
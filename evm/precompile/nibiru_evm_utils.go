@@ -38,26 +38,33 @@ func EmitEventAbciEvents(
 	abciEvents []sdk.Event,
 	emittingAddr gethcommon.Address,
 ) {
-	blockNumber := uint64(ctx.BlockHeight())
-	event := embeds.SmartContract_Wasm.ABI.Events[EvmEventAbciEvent]
 	for _, abciEvent := range abciEvents {
-		// Why 2 topics? Because 2 = event ID + number of indexed event fields
-		topics := make([]gethcommon.Hash, 2)
-		topics[0] = event.ID
-
-		// eventType is the first (and only) indexed field
-		topics[1] = EventTopicFromString(abciEvent.Type)
-
-		attrsBz := AttrsToJSON(append([]abci.EventAttribute{
-			{Key: "eventType", Value: abciEvent.Type},
-		}, abciEvent.Attributes...))
-		nonIndexedArgs, _ := event.Inputs.NonIndexed().Pack(string(attrsBz))
-		sdb.AddLog(&gethcore.Log{
-			Address:     emittingAddr,
-			Topics:      topics,
-			Data:        nonIndexedArgs,
-			BlockNumber: blockNumber,
-		})
+		sdb.AddLog(abciEventLog(ctx, abciEvent, emittingAddr))
+	}
+}
+
+// abciEventLog returns the "AbciEvent" EVM log of an ABCI event emitted by the
+// precompile at "emittingAddr". See [EmitEventAbciEvents].
+func abciEventLog(
+	ctx sdk.Context, abciEvent sdk.Event, emittingAddr gethcommon.Address,
+) *gethcore.Log {
+	event := embeds.SmartContract_Wasm.ABI.Events[EvmEventAbciEvent]
+	// Why 2 topics? Because 2 = event ID + number of indexed event fields
+	topics := make([]gethcommon.Hash, 2)
+	topics[0] = event.ID
+
+	// eventType is the first (and only) indexed field
+	topics[1] = EventTopicFromString(abciEvent.Type)
+
+	attrsBz := AttrsToJSON(append([]abci.EventAttribute{
+		{Key: "eventType", Value: abciEvent.Type},
+	}, abciEvent.Attributes...))
+	nonIndexedArgs, _ := event.Inputs.NonIndexed().Pack(string(attrsBz))
+	return &gethcore.Log{
+		Address:     emittingAddr,
+		Topics:      topics,
+		Data:        nonIndexedArgs,
+		BlockNumber: uint64(ctx.BlockHeight()),
 	}
 }
 
@@ -18,6 +18,8 @@ import (
 	"github.com/NibiruChain/nibiru/v2/evm/embeds"
 	"github.com/NibiruChain/nibiru/v2/evm/evmstate"
 	xoracle "github.com/NibiruChain/nibiru/v2/x/oracle"
+	oraclekeeper "github.com/NibiruChain/nibiru/v2/x/oracle/keeper"
+	oracletypes "github.com/NibiruChain/nibiru/v2/x/oracle/types"
 )
 
 var (
@@ -42,7 +44,11 @@ func (p precompileOracle) ABI() *gethabi.ABI {
 
 const (
 	OracleMethod_queryExchangeRate        PrecompileMethod = "queryExchangeRate"
+	OracleMethod_queryTwap                PrecompileMethod = "queryTwap"
 	OracleMethod_chainLinkLatestRoundData PrecompileMethod = "chainLinkLatestRoundData"
+	OracleMethod_chainLinkGetRoundData    PrecompileMethod = "chainLinkGetRoundData"
+	OracleMethod_chainLinkGetAnswer       PrecompileMethod = "chainLinkGetAnswer"
+	OracleMethod_chainLinkGetTimestamp    PrecompileMethod = "chainLinkGetTimestamp"
 )
 
 // Run runs the precompiled contract
@@ -110,9 +116,15 @@ func (p precompileOracle) DynamicRun(
 	switch PrecompileMethod(method.Name) {
 	case OracleMethod_queryExchangeRate:
 		bz, err = p.queryExchangeRate(ctx, method, args)
+	case OracleMethod_queryTwap:
+		bz, err = p.queryTwap(ctx, method, args)
 	// For "@chainlink/contracts/src/v0.8/shared/interfaces/AggregatorV3Interface.sol"
 	case OracleMethod_chainLinkLatestRoundData:
 		bz, err = p.chainLinkLatestRoundData(ctx, method, args)
+	case OracleMethod_chainLinkGetRoundData:
+		bz, err = p.chainLinkGetRoundData(ctx, method, args)
+	case OracleMethod_chainLinkGetAnswer, OracleMethod_chainLinkGetTimestamp:
+		bz, err = p.chainLinkGetAnswerOrTimestamp(ctx, method, args)
 
 	default:
 		// Note that this code path should be impossible to reach since
@@ -133,14 +145,16 @@ func (p precompileOracle) DynamicRun(
 
 func PrecompileOracle(keepers keepers.PublicKeepers) NibiruCustomPrecompile {
 	return precompileOracle{
-		evmKeeper:  keepers.EvmKeeper,
-		wasmKeeper: keepers.WasmKeeper,
+		evmKeeper:    keepers.EvmKeeper,
+		wasmKeeper:   keepers.WasmKeeper,
+		oracleKeeper: keepers.OracleKeeper,
 	}
 }
 
 type precompileOracle struct {
-	evmKeeper  *evmstate.Keeper
-	wasmKeeper wasmkeeper.Keeper
+	evmKeeper    *evmstate.Keeper
+	wasmKeeper   wasmkeeper.Keeper
+	oracleKeeper oraclekeeper.Keeper
 }
 
 // Implements "IOracle.queryExchangeRate"
@@ -207,6 +221,49 @@ func (p precompileOracle) parseQueryExchangeRateArgs(args []any) (
 	return pair, nil
 }
 
+// Implements "IOracle.queryTwap"
+//
+//	```solidity
+//	function queryTwap(
+//	    string memory pair,
+//	    uint64 lookbackSeconds
+//	) external view returns (uint256 price);
+//	```
+//
+// The TWAP comes from the price snapshots that the x/oracle module samples from
+// the x-oracle adapter at the end of each block. See
+// [oraclekeeper.Keeper.ExchangeRateTwapWindow].
+func (p precompileOracle) queryTwap(
+	ctx sdk.Context,
+	method *gethabi.Method,
+	args []any,
+) (bz []byte, err error) {
+	if e := assertNumArgs(args, 2); e != nil {
+		return nil, e
+	}
+	pairStr, ok := args[0].(string)
+	if !ok {
+		return nil, ErrArgTypeValidation("string pair", args[0])
+	}
+	pair, err := oracletypes.TryNewPair(pairStr)
+	if err != nil {
+		return nil, err
+	}
+	lookbackSeconds, ok := args[1].(uint64)
+	if !ok {
+		return nil, ErrArgTypeValidation("uint64 lookbackSeconds", args[1])
+	}
+
+	resp, err := p.oracleKeeper.ExchangeRateTwapWindow(ctx, &oracletypes.QueryExchangeRateTwapWindowRequest{
+		Pair:            pair,
+		LookbackSeconds: lookbackSeconds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(resp.ExchangeRate.BigInt()) // 18 decimals
+}
+
 // Implements "IOracle.chainLinkLatestRoundData"
 //
 //	```solidity
@@ -226,6 +283,12 @@ func (p precompileOracle) parseQueryExchangeRateArgs(args []any) (
 //	  // ...
 //	}
 //	```
+//
+// The answer is always the adapter's current price. Once the pair has price
+// rounds recorded by the x/oracle module, roundId and answeredInRound are the
+// ID of the round for that price: the latest round, or the next one if the
+// price was updated during this block and is recorded at the end of it.
+// Without rounds, roundId is the block height.
 func (p precompileOracle) chainLinkLatestRoundData(
 	ctx sdk.Context,
 	method *gethabi.Method,
@@ -253,6 +316,16 @@ func (p precompileOracle) chainLinkLatestRoundData(
 	// adapter, not this query's block time.
 	timestampSeconds := new(big.Int).SetUint64(adapterUpdateTimeSeconds(adapterResp))
 	answeredInRound := big.NewInt(420) // for no reason in particular / unused
+	if oraclePair, err := oracletypes.TryNewPair(pair); err == nil {
+		latestRoundId, latest, err := p.oracleKeeper.GetLatestPriceRound(ctx, oraclePair)
+		if err == nil {
+			if int64(adapterBlockTimeMs(adapterResp)) > latest.TimestampMs {
+				latestRoundId++
+			}
+			roundId = new(big.Int).SetUint64(latestRoundId)
+			answeredInRound = roundId
+		}
+	}
 	return method.Outputs.Pack(
 		roundId,
 		answer,
@@ -266,14 +339,9 @@ func (p precompileOracle) queryLegacyExchangeRate(
 	ctx sdk.Context,
 	pair string,
 ) (xoracle.XOracleAdapterLegacyExchangeRateResp, error) {
-	adapterAddr, err := p.evmKeeper.EVMState().WasmPlugins.Get(ctx, evm.WasmPluginNameXOracle)
+	adapterAddr, err := p.evmKeeper.GetWasmPluginAddr(ctx, evm.WasmPluginNameXOracle)
 	if err != nil {
-		return xoracle.XOracleAdapterLegacyExchangeRateResp{},
-			fmt.Errorf("x-oracle wasm plugin is not configured: %w", err)
-	}
-	if adapterAddr.Empty() {
-		return xoracle.XOracleAdapterLegacyExchangeRateResp{},
-			fmt.Errorf("x-oracle wasm plugin address is empty")
+		return xoracle.XOracleAdapterLegacyExchangeRateResp{}, err
 	}
 
 	req, err := json.Marshal(xoracle.XOracleAdapterQueryMsg{
@@ -295,6 +363,114 @@ func (p precompileOracle) queryLegacyExchangeRate(
 	return resp, nil
 }
 
+// Implements "IOracle.chainLinkGetRoundData"
+//
+//	```solidity
+//	function chainLinkGetRoundData(
+//	  string memory pair,
+//	  uint80 _roundId
+//	)
+//	    external
+//	    view
+//	    returns (
+//	        uint80 roundId,
+//	        int256 answer,
+//	        uint256 startedAt,
+//	        uint256 updatedAt,
+//	        uint80 answeredInRound
+//	    );
+//	```
+//
+// Rounds are recorded by the x/oracle module each block in which the x-oracle
+// adapter reports a new price for the pair.
+func (p precompileOracle) chainLinkGetRoundData(
+	ctx sdk.Context,
+	method *gethabi.Method,
+	args []any,
+) (bz []byte, err error) {
+	pair, roundId, err := p.parseRoundArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	round, err := p.oracleKeeper.GetPriceRound(ctx, pair, roundId.Uint64())
+	if err != nil {
+		return nil, err
+	}
+
+	timestampSeconds := big.NewInt(round.TimestampMs / 1000)
+	return method.Outputs.Pack(
+		roundId,
+		round.Price.BigInt(), // 18 decimals
+		timestampSeconds,     // startedAt (seconds)
+		timestampSeconds,     // updatedAt (seconds)
+		roundId,              // answeredInRound
+	)
+}
+
+// Implements "IOracle.chainLinkGetAnswer" and "IOracle.chainLinkGetTimestamp"
+//
+//	```solidity
+//	function chainLinkGetAnswer(
+//	  string memory pair, uint256 roundId
+//	) external view returns (int256);
+//	function chainLinkGetTimestamp(
+//	  string memory pair, uint256 roundId
+//	) external view returns (uint256);
+//	```
+func (p precompileOracle) chainLinkGetAnswerOrTimestamp(
+	ctx sdk.Context,
+	method *gethabi.Method,
+	args []any,
+) (bz []byte, err error) {
+	pair, roundId, err := p.parseRoundArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	round, err := p.oracleKeeper.GetPriceRound(ctx, pair, roundId.Uint64())
+	if err != nil {
+		return nil, err
+	}
+
+	if PrecompileMethod(method.Name) == OracleMethod_chainLinkGetAnswer {
+		return method.Outputs.Pack(round.Price.BigInt())
+	}
+	return method.Outputs.Pack(big.NewInt(round.TimestampMs / 1000))
+}
+
+// parseRoundArgs parses the (string pair, uint roundId) arguments shared by
+// the methods that query past price rounds.
+func (p precompileOracle) parseRoundArgs(args []any) (
+	pair oracletypes.Pair,
+	roundId *big.Int,
+	err error,
+) {
+	if e := assertNumArgs(args, 2); e != nil {
+		err = e
+		return
+	}
+
+	pairStr, ok := args[0].(string)
+	if !ok {
+		err = ErrArgTypeValidation("string pair", args[0])
+		return
+	}
+	pair, err = oracletypes.TryNewPair(pairStr)
+	if err != nil {
+		return
+	}
+
+	roundId, ok = args[1].(*big.Int)
+	if !ok {
+		err = ErrArgTypeValidation("uint roundId", args[1])
+		return
+	}
+	if !roundId.IsUint64() {
+		err = fmt.Errorf("round %s does not exist", roundId)
+		return
+	}
+	return pair, roundId, nil
+}
+
 func parseAdapterPrice18(price18 string) (*big.Int, error) {
 	price, ok := new(big.Int).SetString(price18, 10)
 	if !ok {
@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
@@ -123,6 +124,155 @@ func TestOraclePrecompileQueriesAdapter(t *testing.T) {
 	})
 }
 
+func TestOraclePrecompilePriceRounds(t *testing.T) {
+	deps := evmtest.NewTestDeps()
+	adapterAddr := instantiateXOracleAdapterFixtureForPrecompile(t, &deps)
+	setXOracleWasmPluginForPrecompile(t, &deps, adapterAddr)
+
+	callOracle := func(method precompile.PrecompileMethod, args ...any) ([]any, error) {
+		input, err := embeds.SmartContract_Oracle.ABI.Pack(string(method), args...)
+		require.NoError(t, err)
+		evmObj, _ := deps.NewEVM()
+		resp, err := deps.EvmKeeper.CallContract(
+			evmObj,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_Oracle,
+			input,
+			oraclePrecompileGasLimit,
+			evm.COMMIT_READONLY,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return embeds.SmartContract_Oracle.ABI.Unpack(string(method), resp.Ret)
+	}
+
+	// Record round 1 at the current block time, skip a sample with no new
+	// adapter price, then record round 2 in a later block.
+	time1 := deps.Ctx().BlockTime()
+	deps.App.OracleKeeper.SampleAdapterPrices(deps.Ctx())
+	deps.App.OracleKeeper.SampleAdapterPrices(deps.Ctx())
+	deps.SetCtx(deps.Ctx().WithBlockTime(time1.Add(10 * time.Second)))
+	deps.App.OracleKeeper.SampleAdapterPrices(deps.Ctx())
+
+	t.Run("chainLinkGetRoundData", func(t *testing.T) {
+		for roundId, wantTime := range map[int64]time.Time{
+			1: time1,
+			2: time1.Add(10 * time.Second),
+		} {
+			vals, err := callOracle(
+				precompile.OracleMethod_chainLinkGetRoundData, "ubtc:uusd", big.NewInt(roundId),
+			)
+			require.NoError(t, err)
+			require.EqualValues(t, roundId, vals[0].(*big.Int).Int64())
+			require.Equal(t, "420000000000000000000", vals[1].(*big.Int).String())
+			require.EqualValues(t, wantTime.Unix(), vals[2].(*big.Int).Int64())
+			require.EqualValues(t, wantTime.Unix(), vals[3].(*big.Int).Int64())
+			require.EqualValues(t, roundId, vals[4].(*big.Int).Int64())
+		}
+	})
+
+	t.Run("chainLinkGetAnswer and chainLinkGetTimestamp", func(t *testing.T) {
+		vals, err := callOracle(
+			precompile.OracleMethod_chainLinkGetAnswer, "unibi:uusd", big.NewInt(2),
+		)
+		require.NoError(t, err)
+		require.Equal(t, "138000000000000000000", vals[0].(*big.Int).String())
+
+		vals, err = callOracle(
+			precompile.OracleMethod_chainLinkGetTimestamp, "unibi:uusd", big.NewInt(1),
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, time1.Unix(), vals[0].(*big.Int).Int64())
+	})
+
+	t.Run("chainLinkLatestRoundData uses the latest round", func(t *testing.T) {
+		vals, err := callOracle(precompile.OracleMethod_chainLinkLatestRoundData, "ubtc:uusd")
+		require.NoError(t, err)
+		require.EqualValues(t, 2, vals[0].(*big.Int).Int64())
+		require.EqualValues(t, 2, vals[4].(*big.Int).Int64())
+
+		// A price updated during this block is the next round.
+		deps.SetCtx(deps.Ctx().WithBlockTime(time1.Add(20 * time.Second)))
+		vals, err = callOracle(precompile.OracleMethod_chainLinkLatestRoundData, "ubtc:uusd")
+		require.NoError(t, err)
+		require.EqualValues(t, 3, vals[0].(*big.Int).Int64())
+		require.EqualValues(t, 3, vals[4].(*big.Int).Int64())
+	})
+
+	t.Run("sad: round not recorded", func(t *testing.T) {
+		for _, roundId := range []int64{0, 3} {
+			_, err := callOracle(
+				precompile.OracleMethod_chainLinkGetRoundData, "ubtc:uusd", big.NewInt(roundId),
+			)
+			require.ErrorContains(t, err, "price round not found")
+		}
+		_, err := callOracle(
+			precompile.OracleMethod_chainLinkGetAnswer, "uusdt:uusd", big.NewInt(1),
+		)
+		require.ErrorContains(t, err, "price round not found")
+	})
+}
+
+func TestOraclePrecompileQueryTwap(t *testing.T) {
+	deps := evmtest.NewTestDeps()
+	adapterAddr := instantiateXOracleAdapterFixtureForPrecompile(t, &deps)
+	setXOracleWasmPluginForPrecompile(t, &deps, adapterAddr)
+
+	callQueryTwap := func(pair string, lookbackSeconds uint64) ([]any, error) {
+		input, err := embeds.SmartContract_Oracle.ABI.Pack(
+			string(precompile.OracleMethod_queryTwap), pair, lookbackSeconds,
+		)
+		require.NoError(t, err)
+		evmObj, _ := deps.NewEVM()
+		resp, err := deps.EvmKeeper.CallContract(
+			evmObj,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_Oracle,
+			input,
+			oraclePrecompileGasLimit,
+			evm.COMMIT_READONLY,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return embeds.SmartContract_Oracle.ABI.Unpack(string(precompile.OracleMethod_queryTwap), resp.Ret)
+	}
+
+	_, err := callQueryTwap("ubtc:uusd", 60)
+	require.Error(t, err, "no price has been sampled yet")
+
+	// Sample the adapter prices at the end of three blocks, 5 seconds apart.
+	start := deps.Ctx().BlockTime()
+	for i := 0; i < 3; i++ {
+		deps.SetCtx(deps.Ctx().
+			WithBlockTime(start.Add(time.Duration(i) * 5 * time.Second)).
+			WithBlockHeight(deps.Ctx().BlockHeight() + 1))
+		deps.App.OracleKeeper.SampleAdapterPrices(deps.Ctx())
+	}
+	deps.SetCtx(deps.Ctx().WithBlockTime(start.Add(15 * time.Second)))
+
+	for _, lookbackSeconds := range []uint64{10, 60} {
+		vals, err := callQueryTwap("ubtc:uusd", lookbackSeconds)
+		require.NoError(t, err)
+		require.Equal(t, "420000000000000000000", vals[0].(*big.Int).String())
+	}
+
+	t.Run("sad: invalid lookback", func(t *testing.T) {
+		for _, lookbackSeconds := range []uint64{0, 86_401} {
+			_, err := callQueryTwap("ubtc:uusd", lookbackSeconds)
+			require.ErrorContains(t, err, "lookback_seconds")
+		}
+	})
+
+	t.Run("sad: pair without samples", func(t *testing.T) {
+		_, err := callQueryTwap("uusdt:uusd", 60)
+		require.Error(t, err)
+	})
+}
+
 func TestOraclePrecompileSupportsFeeHandlerQuote(t *testing.T) {
 	deps := evmtest.NewTestDeps()
 	adapterAddr := instantiateXOracleAdapterFixtureForPrecompile(t, &deps)
@@ -8,6 +8,8 @@
 // Key components:
 //   - InitPrecompiles: Initializes and returns a map of precompiled contracts.
 //   - PrecompileFunToken: Implements the FunToken precompile for ERC20-to-bank transfers.
+//   - PrecompileStaking: Implements staking and distribution actions for EVM accounts.
+//   - PrecompileIbcTransfer: Implements ICS-20 transfers of bank coins and FunToken ERC20s.
 //
 // The package also provides utility functions for working with precompiles, such
 // as "ABIMethodByID" and "OnRunStart" for common precompile execution setup.
@@ -28,6 +30,7 @@ import (
 	"github.com/NibiruChain/nibiru/v2/x/collections"
 
 	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/evm"
 	"github.com/NibiruChain/nibiru/v2/evm/evmstate"
 )
 
@@ -48,6 +51,8 @@ func InitPrecompiles(
 		PrecompileOracle,
 		PrecompileWasm,
 		PrecompileP256,
+		PrecompileStaking,
+		PrecompileIbcTransfer,
 	} {
 		pc := precompileSetupFn(k)
 		for _, precompileMap := range []map[gethcommon.Address]vm.PrecompiledContract{
@@ -55,21 +60,13 @@ func InitPrecompiles(
 			vm.PrecompiledContractsByzantium,
 			vm.PrecompiledContractsIstanbul,
 			vm.PrecompiledContractsBerlin,
+			vm.PrecompiledContractsCancun, // used once the "cancun_time" param passes
 			// Below precompiles omitted intentionally.
-			// vm.PrecompiledContractsCancun,
 			// vm.PrecompiledContractsBLS,
 		} {
 			precompileMap[pc.Address()] = pc
 		}
 	}
-
-	// TODO: feat(evm): implement precompiled contracts for ibc transfer
-	// Check if there is sufficient demand for this.
-
-	// TODO: feat(evm): implement precompiled contracts for staking
-	// Note that liquid staked assets can be a useful alternative to adding a
-	// staking precompile.
-	// Check if there is sufficient demand for this.
 }
 
 type NibiruCustomPrecompile interface {
@@ -203,7 +200,8 @@ func OnRunStart(
 	// Switching to a local gas meter to enforce gas limit check for a precompile
 	ctx = ctx.WithGasMeter(sdk.NewGasMeter(gasLimit)).
 		WithKVGasConfig(store.KVGasConfig()).
-		WithTransientKVGasConfig(store.TransientGasConfig())
+		WithTransientKVGasConfig(store.TransientGasConfig()).
+		WithValue(evm.CtxKeyPrecompileRun, true)
 
 	return OnRunStartResult{
 		Args:   args,
@@ -220,6 +218,10 @@ var isMutation map[PrecompileMethod]bool = map[PrecompileMethod]bool{
 	WasmMethod_query:        false,
 	WasmMethod_queryRaw:     false,
 
+	WasmMethod_pluginQuery:   false,
+	WasmMethod_pluginExecute: true,
+	WasmMethod_plugins:       false,
+
 	FunTokenMethod_sendToBank:      true,
 	FunTokenMethod_balance:         false,
 	FunTokenMethod_bankBalance:     false,
@@ -228,4 +230,16 @@ var isMutation map[PrecompileMethod]bool = map[PrecompileMethod]bool{
 
 	FunTokenMethod_sendToEvm:   true,
 	FunTokenMethod_bankMsgSend: true,
+
+	StakingMethod_delegate:        true,
+	StakingMethod_undelegate:      true,
+	StakingMethod_redelegate:      true,
+	StakingMethod_withdrawRewards: true,
+	StakingMethod_delegation:      false,
+	StakingMethod_delegations:     false,
+	StakingMethod_rewards:         false,
+	StakingMethod_validator:       false,
+	StakingMethod_validators:      false,
+
+	IbcTransferMethod_transfer: true,
 }
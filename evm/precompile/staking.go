@@ -0,0 +1,729 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	distrkeeper "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/staking/types"
+
+	"github.com/NibiruChain/nibiru/v2/app/keepers"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/evm/embeds"
+)
+
+var (
+	_ vm.PrecompiledContract = (*precompileStaking)(nil)
+	_ vm.DynamicPrecompile   = (*precompileStaking)(nil)
+)
+
+// Precompile address for "IStaking.sol", the contract that enables delegation
+// and reward management with the staking and distribution modules.
+var PrecompileAddr_Staking = gethcommon.HexToAddress("0x0000000000000000000000000000000000000803")
+
+// stakingMaxDelegationsQuery bounds the number of delegations returned by
+// "IStaking.delegations" to keep the gas cost of the query predictable.
+const stakingMaxDelegationsQuery uint16 = 100
+
+// Contract methods from IStaking.sol
+const (
+	StakingMethod_delegate        PrecompileMethod = "delegate"
+	StakingMethod_undelegate      PrecompileMethod = "undelegate"
+	StakingMethod_redelegate      PrecompileMethod = "redelegate"
+	StakingMethod_withdrawRewards PrecompileMethod = "withdrawRewards"
+	StakingMethod_delegation      PrecompileMethod = "delegation"
+	StakingMethod_delegations     PrecompileMethod = "delegations"
+	StakingMethod_rewards         PrecompileMethod = "rewards"
+	StakingMethod_validator       PrecompileMethod = "validator"
+	StakingMethod_validators      PrecompileMethod = "validators"
+)
+
+func (p precompileStaking) Address() gethcommon.Address {
+	return PrecompileAddr_Staking
+}
+
+// RequiredGas calculates the cost of calling the precompile in gas units.
+func (p precompileStaking) RequiredGas(input []byte) (gasCost uint64) {
+	return requiredGas(input, p.ABI())
+}
+
+func (p precompileStaking) ABI() *gethabi.ABI {
+	return embeds.SmartContract_Staking.ABI
+}
+
+func (p precompileStaking) Run(
+	evmObj *vm.EVM,
+	trueCaller gethcommon.Address,
+	// Note that we use "trueCaller" here to differentiate between a delegate
+	// caller ("parent.CallerAddress" in geth) and "contract.CallerAddress"
+	// because these two addresses may differ.
+	contract *vm.Contract,
+	readonly bool,
+	// isDelegatedCall: Flag to add conditional logic specific to delegate calls
+	isDelegatedCall bool,
+) (bz []byte, err error) {
+	bz, _, err = p.DynamicRun(evmObj, trueCaller, contract, readonly, isDelegatedCall)
+	return bz, err
+}
+
+// DynamicRun runs the precompiled contract and returns the gas cost.
+func (p precompileStaking) DynamicRun(
+	evmObj *vm.EVM,
+	trueCaller gethcommon.Address,
+	// Note that we use "trueCaller" here to differentiate between a delegate
+	// caller ("parent.CallerAddress" in geth) and "contract.CallerAddress"
+	// because these two addresses may differ.
+	contract *vm.Contract,
+	readonly bool,
+	// isDelegatedCall: Flag to add conditional logic specific to delegate calls
+	isDelegatedCall bool,
+) (bz []byte, gasCost uint64, err error) {
+	defer func() {
+		err = ErrPrecompileRun(err, p)
+	}()
+	startResult, err := OnRunStart(evmObj, contract.Input, p.ABI(), contract.Gas)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		// Recover OOG panics as ErrOutOfGas; other panics become an error.
+		var (
+			oog  bool  // true if panic was out-of-gas
+			perr error // ErrOutOfGas for OOG, or formatted error for unexpected panic
+		)
+		panicInfo := recover()
+		if panicInfo != nil {
+			oog, perr = evm.ParseOOGPanic(panicInfo, func(p any) string {
+				return fmt.Sprintf("unexpected panic in precompile: %v", p)
+			})
+		}
+		if oog {
+			gasCost = startResult.Ctx.GasMeter().GasConsumed()
+			err = perr
+			return
+		} else if perr != nil {
+			err = perr
+			return
+		}
+	}()
+
+	abciEventsStartIdx := len(startResult.Ctx.EventManager().Events())
+
+	method := startResult.Method
+	switch PrecompileMethod(method.Name) {
+	case StakingMethod_delegate:
+		bz, err = p.delegate(startResult, trueCaller, readonly)
+	case StakingMethod_undelegate:
+		bz, err = p.undelegate(startResult, trueCaller, readonly)
+	case StakingMethod_redelegate:
+		bz, err = p.redelegate(startResult, trueCaller, readonly)
+	case StakingMethod_withdrawRewards:
+		bz, err = p.withdrawRewards(startResult, trueCaller, readonly)
+	case StakingMethod_delegation:
+		bz, err = p.delegation(startResult, contract)
+	case StakingMethod_delegations:
+		bz, err = p.delegations(startResult, contract)
+	case StakingMethod_rewards:
+		bz, err = p.rewards(startResult, contract)
+	case StakingMethod_validator:
+		bz, err = p.validator(startResult, contract)
+	case StakingMethod_validators:
+		bz, err = p.validators(startResult, contract)
+	default:
+		// Note that this code path should be impossible to reach since
+		// "[decomposeInput]" parses methods directly from the ABI.
+		err = fmt.Errorf("invalid method called with name \"%s\"", method.Name)
+		return
+	}
+	// Gas consumed by a local gas meter (the one in startResult.Ctx from OnRunStart).
+	// The EVM applies it via the returned gasCost from DynamicRun.
+	gasCost = startResult.Ctx.GasMeter().GasConsumed()
+	if err != nil {
+		bz = revertBzForErr(err)
+		return bz, gasCost, err
+	}
+
+	// Emit extra events for the EVM if this is a transaction
+	// https://github.com/NibiruChain/nibiru/issues/2121
+	if isMutation[PrecompileMethod(startResult.Method.Name)] {
+		EmitEventAbciEvents(
+			startResult.Ctx,
+			startResult.SDB,
+			startResult.Ctx.EventManager().Events()[abciEventsStartIdx:],
+			p.Address(),
+		)
+	}
+
+	return bz, gasCost, err
+}
+
+func PrecompileStaking(keepers keepers.PublicKeepers) NibiruCustomPrecompile {
+	return precompileStaking{
+		staking: keepers.StakingKeeper,
+		distr:   keepers.DistrKeeper,
+	}
+}
+
+type precompileStaking struct {
+	staking *stakingkeeper.Keeper
+	distr   distrkeeper.Keeper
+}
+
+// stakingValidator is the Go representation of the "IStaking.Validator"
+// struct used for ABI packing.
+type stakingValidator struct {
+	OperatorAddress string   `json:"operatorAddress"`
+	Moniker         string   `json:"moniker"`
+	Jailed          bool     `json:"jailed"`
+	Status          uint8    `json:"status"`
+	Tokens          *big.Int `json:"tokens"`
+	DelegatorShares *big.Int `json:"delegatorShares"`
+	CommissionRate  *big.Int `json:"commissionRate"`
+}
+
+// stakingDelegation is the Go representation of the "IStaking.Delegation"
+// struct used for ABI packing.
+type stakingDelegation struct {
+	ValidatorAddr string       `json:"validatorAddr"`
+	Shares        *big.Int     `json:"shares"`
+	Balance       WasmBankCoin `json:"balance"`
+}
+
+// delegate: Implements "IStaking.delegate"
+//
+//	```solidity
+//	function delegate(
+//	    string calldata validatorAddr,
+//	    uint256 amount
+//	) external returns (bool success);
+//	```
+func (p precompileStaking) delegate(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.Ctx
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+	if err := assertNotVMCaller(ctx, method); err != nil {
+		return nil, err
+	}
+
+	valAddr, amount, err := parseArgsValidatorAmount(args)
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	msg := &stakingtypes.MsgDelegate{
+		DelegatorAddress: eth.EthAddrToNibiruAddr(caller).String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           p.bondCoin(ctx, amount),
+	}
+	if err = msg.ValidateBasic(); err != nil {
+		return
+	}
+	if _, err = stakingkeeper.NewMsgServerImpl(p.staking).Delegate(
+		sdk.WrapSDKContext(ctx), msg,
+	); err != nil {
+		return
+	}
+	return method.Outputs.Pack(true)
+}
+
+// undelegate: Implements "IStaking.undelegate"
+//
+//	```solidity
+//	function undelegate(
+//	    string calldata validatorAddr,
+//	    uint256 amount
+//	) external returns (int64 completionTime);
+//	```
+func (p precompileStaking) undelegate(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.Ctx
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+	if err := assertNotVMCaller(ctx, method); err != nil {
+		return nil, err
+	}
+
+	valAddr, amount, err := parseArgsValidatorAmount(args)
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	msg := &stakingtypes.MsgUndelegate{
+		DelegatorAddress: eth.EthAddrToNibiruAddr(caller).String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           p.bondCoin(ctx, amount),
+	}
+	if err = msg.ValidateBasic(); err != nil {
+		return
+	}
+	resp, err := stakingkeeper.NewMsgServerImpl(p.staking).Undelegate(
+		sdk.WrapSDKContext(ctx), msg,
+	)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack(resp.CompletionTime.Unix())
+}
+
+// redelegate: Implements "IStaking.redelegate"
+//
+//	```solidity
+//	function redelegate(
+//	    string calldata srcValidatorAddr,
+//	    string calldata dstValidatorAddr,
+//	    uint256 amount
+//	) external returns (int64 completionTime);
+//	```
+func (p precompileStaking) redelegate(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.Ctx
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+	if err := assertNotVMCaller(ctx, method); err != nil {
+		return nil, err
+	}
+
+	if e := assertNumArgs(args, 3); e != nil {
+		err = ErrInvalidArgs(e)
+		return
+	}
+	srcValAddr, e := parseArgValAddr(args[0], "string srcValidatorAddr")
+	if e != nil {
+		err = ErrInvalidArgs(e)
+		return
+	}
+	dstValAddr, e := parseArgValAddr(args[1], "string dstValidatorAddr")
+	if e != nil {
+		err = ErrInvalidArgs(e)
+		return
+	}
+	amount, ok := args[2].(*big.Int)
+	if !ok {
+		err = ErrInvalidArgs(ErrArgTypeValidation("uint256 amount", args[2]))
+		return
+	}
+
+	msg := &stakingtypes.MsgBeginRedelegate{
+		DelegatorAddress:    eth.EthAddrToNibiruAddr(caller).String(),
+		ValidatorSrcAddress: srcValAddr.String(),
+		ValidatorDstAddress: dstValAddr.String(),
+		Amount:              p.bondCoin(ctx, amount),
+	}
+	if err = msg.ValidateBasic(); err != nil {
+		return
+	}
+	resp, err := stakingkeeper.NewMsgServerImpl(p.staking).BeginRedelegate(
+		sdk.WrapSDKContext(ctx), msg,
+	)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack(resp.CompletionTime.Unix())
+}
+
+// withdrawRewards: Implements "IStaking.withdrawRewards"
+//
+//	```solidity
+//	function withdrawRewards(
+//	    string calldata validatorAddr
+//	) external returns (INibiruEvm.BankCoin[] memory amount);
+//	```
+func (p precompileStaking) withdrawRewards(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.Ctx
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+	if err := assertNotVMCaller(ctx, method); err != nil {
+		return nil, err
+	}
+
+	if e := assertNumArgs(args, 1); e != nil {
+		err = ErrInvalidArgs(e)
+		return
+	}
+	valAddr, e := parseArgValAddr(args[0], "string validatorAddr")
+	if e != nil {
+		err = ErrInvalidArgs(e)
+		return
+	}
+
+	msg := &distrtypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: eth.EthAddrToNibiruAddr(caller).String(),
+		ValidatorAddress: valAddr.String(),
+	}
+	if err = msg.ValidateBasic(); err != nil {
+		return
+	}
+	resp, err := distrkeeper.NewMsgServerImpl(p.distr).WithdrawDelegatorReward(
+		sdk.WrapSDKContext(ctx), msg,
+	)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack(bankCoinsToABI(resp.Amount))
+}
+
+// delegation: Implements "IStaking.delegation"
+//
+//	```solidity
+//	function delegation(
+//	    address delegator,
+//	    string calldata validatorAddr
+//	) external view returns (Delegation memory result);
+//	```
+func (p precompileStaking) delegation(
+	start OnRunStartResult,
+	contract *vm.Contract,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.Ctx
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertContractQuery(contract); err != nil {
+		return bz, err
+	}
+
+	if e := assertNumArgs(args, 2); e != nil {
+		err = ErrInvalidArgs(e)
+		return
+	}
+	delegator, ok := args[0].(gethcommon.Address)
+	if !ok {
+		err = ErrInvalidArgs(ErrArgTypeValidation("address delegator", args[0]))
+		return
+	}
+	valAddr, e := parseArgValAddr(args[1], "string validatorAddr")
+	if e != nil {
+		err = ErrInvalidArgs(e)
+		return
+	}
+
+	out := stakingDelegation{
+		ValidatorAddr: valAddr.String(),
+		Shares:        big.NewInt(0),
+		Balance: WasmBankCoin{
+			Denom:  p.staking.BondDenom(ctx),
+			Amount: big.NewInt(0),
+		},
+	}
+	del, found := p.staking.GetDelegation(ctx, eth.EthAddrToNibiruAddr(delegator), valAddr)
+	if found {
+		out, err = p.toABIDelegation(ctx, del)
+		if err != nil {
+			return
+		}
+	}
+	return method.Outputs.Pack(out)
+}
+
+// delegations: Implements "IStaking.delegations"
+//
+//	```solidity
+//	function delegations(
+//	    address delegator
+//	) external view returns (Delegation[] memory result);
+//	```
+func (p precompileStaking) delegations(
+	start OnRunStartResult,
+	contract *vm.Contract,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.Ctx
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertContractQuery(contract); err != nil {
+		return bz, err
+	}
+
+	if e := assertNumArgs(args, 1); e != nil {
+		err = ErrInvalidArgs(e)
+		return
+	}
+	delegator, ok := args[0].(gethcommon.Address)
+	if !ok {
+		err = ErrInvalidArgs(ErrArgTypeValidation("address delegator", args[0]))
+		return
+	}
+
+	dels := p.staking.GetDelegatorDelegations(
+		ctx, eth.EthAddrToNibiruAddr(delegator), stakingMaxDelegationsQuery,
+	)
+	out := make([]stakingDelegation, 0, len(dels))
+	for _, del := range dels {
+		abiDel, e := p.toABIDelegation(ctx, del)
+		if e != nil {
+			err = e
+			return
+		}
+		out = append(out, abiDel)
+	}
+	return method.Outputs.Pack(out)
+}
+
+// rewards: Implements "IStaking.rewards"
+//
+//	```solidity
+//	function rewards(
+//	    address delegator,
+//	    string calldata validatorAddr
+//	) external view returns (INibiruEvm.BankCoin[] memory result);
+//	```
+func (p precompileStaking) rewards(
+	start OnRunStartResult,
+	contract *vm.Contract,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.Ctx
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertContractQuery(contract); err != nil {
+		return bz, err
+	}
+
+	if e := assertNumArgs(args, 2); e != nil {
+		err = ErrInvalidArgs(e)
+		return
+	}
+	delegator, ok := args[0].(gethcommon.Address)
+	if !ok {
+		err = ErrInvalidArgs(ErrArgTypeValidation("address delegator", args[0]))
+		return
+	}
+	valAddr, e := parseArgValAddr(args[1], "string validatorAddr")
+	if e != nil {
+		err = ErrInvalidArgs(e)
+		return
+	}
+
+	resp, err := distrkeeper.NewQuerier(p.distr).DelegationRewards(
+		sdk.WrapSDKContext(ctx),
+		&distrtypes.QueryDelegationRewardsRequest{
+			DelegatorAddress: eth.EthAddrToNibiruAddr(delegator).String(),
+			ValidatorAddress: valAddr.String(),
+		},
+	)
+	if err != nil {
+		return
+	}
+	rewards, _ := resp.Rewards.TruncateDecimal()
+	return method.Outputs.Pack(bankCoinsToABI(rewards))
+}
+
+// validator: Implements "IStaking.validator"
+//
+//	```solidity
+//	function validator(
+//	    string calldata validatorAddr
+//	) external view returns (Validator memory result);
+//	```
+func (p precompileStaking) validator(
+	start OnRunStartResult,
+	contract *vm.Contract,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.Ctx
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertContractQuery(contract); err != nil {
+		return bz, err
+	}
+
+	if e := assertNumArgs(args, 1); e != nil {
+		err = ErrInvalidArgs(e)
+		return
+	}
+	valAddr, e := parseArgValAddr(args[0], "string validatorAddr")
+	if e != nil {
+		err = ErrInvalidArgs(e)
+		return
+	}
+
+	val, found := p.staking.GetValidator(ctx, valAddr)
+	if !found {
+		err = fmt.Errorf("validator %s does not exist", valAddr)
+		return
+	}
+	return method.Outputs.Pack(toABIValidator(val))
+}
+
+// validators: Implements "IStaking.validators"
+//
+//	```solidity
+//	function validators() external view returns (Validator[] memory result);
+//	```
+func (p precompileStaking) validators(
+	start OnRunStartResult,
+	contract *vm.Contract,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.Ctx
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertContractQuery(contract); err != nil {
+		return bz, err
+	}
+	if e := assertNumArgs(args, 0); e != nil {
+		err = ErrInvalidArgs(e)
+		return
+	}
+
+	vals := p.staking.GetBondedValidatorsByPower(ctx)
+	out := make([]stakingValidator, 0, len(vals))
+	for _, val := range vals {
+		out = append(out, toABIValidator(val))
+	}
+	return method.Outputs.Pack(out)
+}
+
+// bondCoin returns a coin of the staking bond denom for the given amount.
+// Validation of the amount happens in the message ValidateBasic.
+func (p precompileStaking) bondCoin(ctx sdk.Context, amount *big.Int) sdk.Coin {
+	return sdk.Coin{
+		Denom:  p.staking.BondDenom(ctx),
+		Amount: sdkmath.NewIntFromBigInt(amount),
+	}
+}
+
+func (p precompileStaking) toABIDelegation(
+	ctx sdk.Context, del stakingtypes.Delegation,
+) (out stakingDelegation, err error) {
+	valAddr := del.GetValidatorAddr()
+	val, found := p.staking.GetValidator(ctx, valAddr)
+	if !found {
+		return out, fmt.Errorf("validator %s does not exist", valAddr)
+	}
+	return stakingDelegation{
+		ValidatorAddr: valAddr.String(),
+		Shares:        del.Shares.BigInt(),
+		Balance: WasmBankCoin{
+			Denom:  p.staking.BondDenom(ctx),
+			Amount: val.TokensFromShares(del.Shares).TruncateInt().BigInt(),
+		},
+	}, nil
+}
+
+func toABIValidator(val stakingtypes.Validator) stakingValidator {
+	return stakingValidator{
+		OperatorAddress: val.OperatorAddress,
+		Moniker:         val.GetMoniker(),
+		Jailed:          val.IsJailed(),
+		Status:          uint8(val.GetStatus()),
+		Tokens:          val.Tokens.BigInt(),
+		DelegatorShares: val.DelegatorShares.BigInt(),
+		CommissionRate:  val.Commission.Rate.BigInt(),
+	}
+}
+
+func bankCoinsToABI(coins sdk.Coins) []WasmBankCoin {
+	out := make([]WasmBankCoin, 0, len(coins))
+	for _, coin := range coins {
+		out = append(out, WasmBankCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.BigInt(),
+		})
+	}
+	return out
+}
+
+// Parses (string validatorAddr, uint256 amount) arguments.
+func parseArgsValidatorAmount(args []any) (
+	valAddr sdk.ValAddress,
+	amount *big.Int,
+	err error,
+) {
+	if e := assertNumArgs(args, 2); e != nil {
+		err = e
+		return
+	}
+
+	argIdx := 0
+	valAddr, err = parseArgValAddr(args[argIdx], "string validatorAddr")
+	if err != nil {
+		return
+	}
+
+	argIdx++
+	amount, ok := args[argIdx].(*big.Int)
+	if !ok {
+		err = ErrArgTypeValidation("uint256 amount", args[argIdx])
+		return
+	}
+	if amount == nil || amount.Sign() != 1 {
+		err = fmt.Errorf("amount must be positive")
+		return
+	}
+	return valAddr, amount, nil
+}
+
+// Parses [sdk.ValAddress] from a "string" solidity argument.
+func parseArgValAddr(arg any, solidityHint string) (valAddr sdk.ValAddress, err error) {
+	valAddrStr, ok := arg.(string)
+	if !ok {
+		err = ErrArgTypeValidation(solidityHint, arg)
+		return
+	}
+	valAddr, err = sdk.ValAddressFromBech32(valAddrStr)
+	if err != nil {
+		err = fmt.Errorf("%s: %w", ErrArgTypeValidation(solidityHint, arg), err)
+		return
+	}
+	return valAddr, nil
+}
@@ -0,0 +1,188 @@
+package precompile_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/evm/embeds"
+	"github.com/NibiruChain/nibiru/v2/evm/evmtest"
+	"github.com/NibiruChain/nibiru/v2/evm/precompile"
+	"github.com/NibiruChain/nibiru/v2/x/nutil/testapp"
+)
+
+const stakingPrecompileGasLimit uint64 = 1_000_000
+
+func TestStakingPrecompileFailToPackABI(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		methodName string
+		callArgs   []any
+		wantError  string
+	}{
+		{
+			name:       "wrong amount of call args",
+			methodName: string(precompile.StakingMethod_delegate),
+			callArgs:   []any{"nibivaloper1", big.NewInt(1), "extra"},
+			wantError:  "argument count mismatch: got 3 for 2",
+		},
+		{
+			name:       "wrong type for amount",
+			methodName: string(precompile.StakingMethod_delegate),
+			callArgs:   []any{"nibivaloper1", "foo"},
+			wantError:  "abi: cannot use string as type ptr as argument",
+		},
+		{
+			name:       "invalid method name",
+			methodName: "foo",
+			callArgs:   []any{"nibivaloper1"},
+			wantError:  "method 'foo' not found",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			input, err := embeds.SmartContract_Staking.ABI.Pack(tc.methodName, tc.callArgs...)
+			require.ErrorContains(t, err, tc.wantError)
+			require.Nil(t, input)
+		})
+	}
+}
+
+func TestStakingPrecompile(t *testing.T) {
+	deps := evmtest.NewTestDeps()
+	bondDenom := deps.App.StakingKeeper.BondDenom(deps.Ctx())
+	validators := deps.App.StakingKeeper.GetBondedValidatorsByPower(deps.Ctx())
+	require.NotEmpty(t, validators)
+	valAddr := validators[0].OperatorAddress
+
+	require.NoError(t, testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx(),
+		deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10_000_000)),
+	))
+
+	callStaking := func(
+		t *testing.T, commit bool, method precompile.PrecompileMethod, args ...any,
+	) ([]any, error) {
+		input, err := embeds.SmartContract_Staking.ABI.Pack(string(method), args...)
+		require.NoError(t, err)
+		evmObj, _ := deps.NewEVM()
+		resp, err := deps.EvmKeeper.CallContract(
+			evmObj,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_Staking,
+			input,
+			stakingPrecompileGasLimit,
+			commit,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return embeds.SmartContract_Staking.ABI.Unpack(string(method), resp.Ret)
+	}
+
+	t.Run("validators", func(t *testing.T) {
+		vals, err := callStaking(t, evm.COMMIT_READONLY, precompile.StakingMethod_validators)
+		require.NoError(t, err)
+		gotVals := vals[0].([]struct {
+			OperatorAddress string   `json:"operatorAddress"`
+			Moniker         string   `json:"moniker"`
+			Jailed          bool     `json:"jailed"`
+			Status          uint8    `json:"status"`
+			Tokens          *big.Int `json:"tokens"`
+			DelegatorShares *big.Int `json:"delegatorShares"`
+			CommissionRate  *big.Int `json:"commissionRate"`
+		})
+		require.Len(t, gotVals, len(validators))
+		require.Equal(t, valAddr, gotVals[0].OperatorAddress)
+		require.EqualValues(t, 3, gotVals[0].Status) // bonded
+	})
+
+	t.Run("sad: delegate to invalid validator", func(t *testing.T) {
+		_, err := callStaking(t, evm.COMMIT_ETH_TX,
+			precompile.StakingMethod_delegate, "not-a-valoper", big.NewInt(1_000),
+		)
+		require.ErrorContains(t, err, "string validatorAddr")
+	})
+
+	t.Run("sad: delegate zero amount", func(t *testing.T) {
+		_, err := callStaking(t, evm.COMMIT_ETH_TX,
+			precompile.StakingMethod_delegate, valAddr, big.NewInt(0),
+		)
+		require.ErrorContains(t, err, "amount must be positive")
+	})
+
+	t.Run("delegate", func(t *testing.T) {
+		vals, err := callStaking(t, evm.COMMIT_ETH_TX,
+			precompile.StakingMethod_delegate, valAddr, big.NewInt(5_000_000),
+		)
+		require.NoError(t, err)
+		require.True(t, vals[0].(bool))
+
+		bal := deps.App.BankKeeper.GetBalance(deps.Ctx(), deps.Sender.NibiruAddr, bondDenom)
+		require.Equal(t, "5000000", bal.Amount.String())
+	})
+
+	t.Run("delegation", func(t *testing.T) {
+		vals, err := callStaking(t, evm.COMMIT_READONLY,
+			precompile.StakingMethod_delegation, deps.Sender.EthAddr, valAddr,
+		)
+		require.NoError(t, err)
+		got := vals[0].(struct {
+			ValidatorAddr string   `json:"validatorAddr"`
+			Shares        *big.Int `json:"shares"`
+			Balance       struct {
+				Denom  string   `json:"denom"`
+				Amount *big.Int `json:"amount"`
+			} `json:"balance"`
+		})
+		require.Equal(t, valAddr, got.ValidatorAddr)
+		require.Equal(t, bondDenom, got.Balance.Denom)
+		require.Equal(t, "5000000", got.Balance.Amount.String())
+		require.Positive(t, got.Shares.Sign())
+	})
+
+	t.Run("delegations", func(t *testing.T) {
+		vals, err := callStaking(t, evm.COMMIT_READONLY,
+			precompile.StakingMethod_delegations, deps.Sender.EthAddr,
+		)
+		require.NoError(t, err)
+		got := vals[0].([]struct {
+			ValidatorAddr string   `json:"validatorAddr"`
+			Shares        *big.Int `json:"shares"`
+			Balance       struct {
+				Denom  string   `json:"denom"`
+				Amount *big.Int `json:"amount"`
+			} `json:"balance"`
+		})
+		require.Len(t, got, 1)
+		require.Equal(t, valAddr, got[0].ValidatorAddr)
+	})
+
+	t.Run("withdrawRewards", func(t *testing.T) {
+		_, err := callStaking(t, evm.COMMIT_ETH_TX,
+			precompile.StakingMethod_withdrawRewards, valAddr,
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("undelegate", func(t *testing.T) {
+		vals, err := callStaking(t, evm.COMMIT_ETH_TX,
+			precompile.StakingMethod_undelegate, valAddr, big.NewInt(2_000_000),
+		)
+		require.NoError(t, err)
+		require.Greater(t, vals[0].(int64), deps.Ctx().BlockTime().Unix())
+
+		ubd, found := deps.App.StakingKeeper.GetUnbondingDelegation(
+			deps.Ctx(), deps.Sender.NibiruAddr, validators[0].GetOperator(),
+		)
+		require.True(t, found)
+		require.Len(t, ubd.Entries, 1)
+		require.Equal(t, "2000000", ubd.Entries[0].Balance.String())
+	})
+}
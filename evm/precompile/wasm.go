@@ -34,6 +34,10 @@ const (
 	WasmMethod_instantiate  PrecompileMethod = "instantiate"
 	WasmMethod_executeMulti PrecompileMethod = "executeMulti"
 	WasmMethod_queryRaw     PrecompileMethod = "queryRaw"
+
+	WasmMethod_pluginQuery   PrecompileMethod = "pluginQuery"
+	WasmMethod_pluginExecute PrecompileMethod = "pluginExecute"
+	WasmMethod_plugins       PrecompileMethod = "plugins"
 )
 
 func (p precompileWasm) Run(
@@ -105,6 +109,12 @@ func (p precompileWasm) DynamicRun(
 		bz, err = p.executeMulti(startResult, trueCaller, readonly)
 	case WasmMethod_queryRaw:
 		bz, err = p.queryRaw(startResult, contract)
+	case WasmMethod_pluginQuery:
+		bz, err = p.pluginQuery(startResult, contract)
+	case WasmMethod_pluginExecute:
+		bz, err = p.pluginExecute(startResult, trueCaller, readonly)
+	case WasmMethod_plugins:
+		bz, err = p.plugins(startResult, contract)
 	default:
 		// Note that this code path should be impossible to reach since
 		// "[decomposeInput]" parses methods directly from the ABI.
@@ -217,7 +227,9 @@ func (p precompileWasm) execute(
 		err = ErrInvalidArgs(err)
 		return
 	}
+	trace := startCrossVMTrace(start, p.Address())
 	data, err := p.Wasm.Execute(ctx, wasmContract, eth.EthAddrToNibiruAddr(caller), msgArgsBz, funds)
+	trace.reportWasmCall(caller, wasmContract, 0, msgArgsBz, funds, data, err)
 	if err != nil {
 		return
 	}
@@ -310,9 +322,11 @@ func (p precompileWasm) instantiate(
 	if len(txMsg.Admin) > 0 {
 		adminAddr = sdk.MustAccAddressFromBech32(txMsg.Admin) // validated in parse
 	}
+	trace := startCrossVMTrace(start, p.Address())
 	contractAddr, data, err := p.Wasm.Instantiate(
 		ctx, txMsg.CodeID, callerBech32, adminAddr, txMsg.Msg, txMsg.Label, txMsg.Funds,
 	)
+	trace.reportWasmCall(caller, contractAddr, txMsg.CodeID, txMsg.Msg, txMsg.Funds, data, err)
 	if err != nil {
 		return
 	}
@@ -379,7 +393,9 @@ func (p precompileWasm) executeMulti(
 				Amount: sdk.NewIntFromBigInt(fund.Amount),
 			})
 		}
+		trace := startCrossVMTrace(start, p.Address())
 		respBz, e := p.Wasm.Execute(ctx, wasmContract, callerBech32, m.MsgArgs, funds)
+		trace.reportWasmCall(caller, wasmContract, 0, m.MsgArgs, funds, respBz, e)
 		if e != nil {
 			err = fmt.Errorf("execute failed at index %d: %w", i, e)
 			return
@@ -444,3 +460,158 @@ func (p precompileWasm) queryRaw(
 	respBz := p.Wasm.QueryRaw(ctx, wasmContract, []byte(key))
 	return method.Outputs.Pack(respBz)
 }
+
+// pluginQuery runs a smart query against the Wasm contract registered under a
+// plugin name in [evm.Params.WasmPlugins].
+//
+// Implements "pluginQuery" from evm/embeds/contracts/Wasm.sol:
+//
+//	```solidity
+//	function pluginQuery(
+//	  string memory name,
+//	  bytes memory req
+//	) external view returns (bytes memory response);
+//	```
+func (p precompileWasm) pluginQuery(
+	start OnRunStartResult,
+	contract *vm.Contract,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.Ctx
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertContractQuery(contract); err != nil {
+		return bz, err
+	}
+
+	if e := assertNumArgs(args, 2); e != nil {
+		err = e
+		return
+	}
+	name, err := parseArgPluginName(args[0])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+	wasmContract, err := p.GetWasmPluginAddr(ctx, name)
+	if err != nil {
+		return
+	}
+	_, req, err := p.parseArgsWasmQuery(
+		[]any{wasmContract.String(), args[1]},
+	)
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	respBz, err := p.Wasm.QuerySmart(ctx, wasmContract, req)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack(respBz)
+}
+
+// pluginExecute invokes the "ExecuteMsg" of the Wasm contract registered under
+// a plugin name in [evm.Params.WasmPlugins]. Only plugins that governance
+// marked as [evm.WasmPlugin.Mutable] can be executed.
+//
+// Implements "pluginExecute" from evm/embeds/contracts/Wasm.sol:
+//
+//	```solidity
+//	function pluginExecute(
+//	  string memory name,
+//	  bytes memory msgArgs,
+//	  BankCoin[] memory funds
+//	) external payable returns (bytes memory response);
+//	```
+func (p precompileWasm) pluginExecute(
+	start OnRunStartResult,
+	caller gethcommon.Address,
+	readOnly bool,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.Ctx
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertNotReadonlyTx(readOnly, method); err != nil {
+		return nil, err
+	}
+	if err := assertNotVMCaller(start.Ctx, start.Method); err != nil {
+		return nil, err
+	}
+
+	if e := assertNumArgs(args, 3); e != nil {
+		err = e
+		return
+	}
+	name, err := parseArgPluginName(args[0])
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+	plugin, err := p.GetWasmPlugin(ctx, name)
+	if err != nil {
+		return
+	}
+	if !plugin.Mutable {
+		err = fmt.Errorf("%s wasm plugin is not mutable", name)
+		return
+	}
+	wasmContract, msgArgsBz, funds, err := p.parseArgsWasmExecute(
+		[]any{plugin.Addr, args[1], args[2]},
+	)
+	if err != nil {
+		err = ErrInvalidArgs(err)
+		return
+	}
+
+	trace := startCrossVMTrace(start, p.Address())
+	data, err := p.Wasm.Execute(ctx, wasmContract, eth.EthAddrToNibiruAddr(caller), msgArgsBz, funds)
+	trace.reportWasmCall(caller, wasmContract, 0, msgArgsBz, funds, data, err)
+	if err != nil {
+		return
+	}
+	return method.Outputs.Pack(data)
+}
+
+// plugins lists the Wasm plugins in [evm.Params.WasmPlugins], preserving the
+// order of the params.
+//
+// Implements "plugins" from evm/embeds/contracts/Wasm.sol:
+//
+//	```solidity
+//	function plugins() external view returns (WasmPlugin[] memory wasmPlugins);
+//	```
+func (p precompileWasm) plugins(
+	start OnRunStartResult,
+	contract *vm.Contract,
+) (bz []byte, err error) {
+	method, args, ctx := start.Method, start.Args, start.Ctx
+	defer func() {
+		if err != nil {
+			err = ErrMethodCalled(method, err)
+		}
+	}()
+	if err := assertContractQuery(contract); err != nil {
+		return bz, err
+	}
+	if e := assertNumArgs(args, 0); e != nil {
+		err = e
+		return
+	}
+
+	wasmPlugins := []WasmPluginInfo{}
+	for _, plugin := range p.GetParams(ctx).WasmPlugins {
+		wasmPlugins = append(wasmPlugins, WasmPluginInfo{
+			Name:         plugin.Name,
+			ContractAddr: plugin.Addr,
+			IsMutable:    plugin.Mutable,
+		})
+	}
+	return method.Outputs.Pack(wasmPlugins)
+}
@@ -16,6 +16,13 @@ type WasmBankCoin struct {
 	Amount *big.Int `json:"amount"`
 }
 
+// WasmPluginInfo is a naked struct for the "WasmPlugin" type from Wasm.sol.
+type WasmPluginInfo struct {
+	Name         string `json:"name"`
+	ContractAddr string `json:"contractAddr"`
+	IsMutable    bool   `json:"isMutable"`
+}
+
 // Parses [sdk.Coins] from a "BankCoin[]" solidity argument:
 //
 //	```solidity
@@ -67,6 +74,15 @@ func parseArgContractAddr(arg any) (addr sdk.AccAddress, err error) {
 	return addr, nil
 }
 
+// Parses the name of a Wasm plugin from a "string" solidity argument.
+func parseArgPluginName(arg any) (name string, err error) {
+	name, ok := arg.(string)
+	if !ok || name == "" {
+		return "", ErrArgTypeValidation("string name", arg)
+	}
+	return name, nil
+}
+
 func (p precompileWasm) parseArgsWasmInstantiate(args []any, sender string) (
 	txMsg wasm.MsgInstantiateContract,
 	err error,
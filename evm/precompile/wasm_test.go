@@ -7,6 +7,9 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
@@ -258,6 +261,92 @@ func (s *WasmSuite) TestQuerySmart() {
 	s.Require().EqualValues(deps.Sender.NibiruAddr.String(), typedResp.Owner)
 }
 
+func (s *WasmSuite) TestPlugins() {
+	deps := evmtest.NewTestDeps()
+	wasmContracts := test.SetupWasmContracts(&deps, &s.Suite)
+	wasmContract := wasmContracts[1] // hello_world_counter.wasm
+
+	params := deps.EvmKeeper.GetParams(deps.Ctx())
+	params.WasmPlugins = []evm.WasmPlugin{
+		{Name: "counter", Addr: wasmContract.String(), Mutable: true},
+		{Name: "counter-readonly", Addr: wasmContract.String()},
+	}
+	s.Require().NoError(deps.EvmKeeper.SetParams(deps.Ctx(), params))
+
+	callWasm := func(method precompile.PrecompileMethod, commit bool, args ...any) (*evm.MsgEthereumTxResponse, error) {
+		contractInput, err := embeds.SmartContract_Wasm.ABI.Pack(string(method), args...)
+		s.Require().NoError(err)
+		evmObj, _ := deps.NewEVM()
+		gasLimit := WasmGasLimitQuery
+		if commit {
+			gasLimit = WasmGasLimitExecute
+		}
+		return deps.EvmKeeper.CallContract(
+			evmObj,
+			deps.Sender.EthAddr,
+			&precompile.PrecompileAddr_Wasm,
+			contractInput,
+			gasLimit,
+			commit,
+			nil,
+		)
+	}
+
+	s.Run("plugins lists the params in order", func() {
+		resp, err := callWasm(precompile.WasmMethod_plugins, evm.COMMIT_READONLY)
+		s.Require().NoError(err)
+		vals, err := embeds.SmartContract_Wasm.ABI.Unpack(
+			string(precompile.WasmMethod_plugins), resp.Ret,
+		)
+		s.Require().NoError(err)
+		var got []precompile.WasmPluginInfo
+		bz, err := json.Marshal(vals[0])
+		s.Require().NoError(err)
+		s.Require().NoError(json.Unmarshal(bz, &got))
+		s.Equal([]precompile.WasmPluginInfo{
+			{Name: "counter", ContractAddr: wasmContract.String(), IsMutable: true},
+			{Name: "counter-readonly", ContractAddr: wasmContract.String()},
+		}, got)
+	})
+
+	s.Run("pluginExecute increments the counter", func() {
+		_, err := callWasm(precompile.WasmMethod_pluginExecute, evm.COMMIT_ETH_TX,
+			"counter", []byte(`{"increment": {}}`), []precompile.WasmBankCoin{},
+		)
+		s.Require().NoError(err)
+		test.AssertWasmCounterState(&s.Suite, deps, wasmContract, 1)
+	})
+
+	s.Run("pluginExecute rejects plugins that are not mutable", func() {
+		_, err := callWasm(precompile.WasmMethod_pluginExecute, evm.COMMIT_ETH_TX,
+			"counter-readonly", []byte(`{"increment": {}}`), []precompile.WasmBankCoin{},
+		)
+		s.Require().ErrorContains(err, "counter-readonly wasm plugin is not mutable")
+		test.AssertWasmCounterState(&s.Suite, deps, wasmContract, 1)
+	})
+
+	s.Run("pluginQuery reads any plugin", func() {
+		resp, err := callWasm(precompile.WasmMethod_pluginQuery, evm.COMMIT_READONLY,
+			"counter-readonly", []byte(`{"count": {}}`),
+		)
+		s.Require().NoError(err)
+		var respBz []byte
+		s.Require().NoError(embeds.SmartContract_Wasm.ABI.UnpackIntoInterface(
+			&respBz, string(precompile.WasmMethod_pluginQuery), resp.Ret,
+		))
+		var typedResp test.QueryMsgCountResp
+		s.Require().NoError(json.Unmarshal(respBz, &typedResp))
+		s.EqualValues(1, typedResp.Count)
+	})
+
+	s.Run("unknown plugin", func() {
+		_, err := callWasm(precompile.WasmMethod_pluginQuery, evm.COMMIT_READONLY,
+			"missing", []byte(`{"count": {}}`),
+		)
+		s.Require().ErrorContains(err, "missing wasm plugin is not configured")
+	})
+}
+
 func (s *WasmSuite) TestSadArgsCount() {
 	nonsenseArgs := []any{"nonsense", "args here", "to see if", "precompile is", "called"}
 	testcases := []struct {
@@ -780,3 +869,104 @@ func (s *WasmSuite) TestWasmPrecompileDirtyStateAttack5() {
 		s.Require().Equal(big.NewInt(0), balance.Amount.BigInt())
 	})
 }
+
+// TestTraceCrossVMFrames: Wasm contract calls made through the precompile
+// appear as child frames of the precompile call in the "callTracer" output,
+// with the bank transfers of their funds nested below them.
+func (s *WasmSuite) TestTraceCrossVMFrames() {
+	deps := evmtest.NewTestDeps()
+	s.Require().NoError(testapp.FundAccount(
+		deps.App.BankKeeper,
+		deps.Ctx(),
+		deps.Sender.NibiruAddr,
+		sdk.NewCoins(sdk.NewCoin(evm.EVMBankDenom, sdk.NewInt(100))),
+	))
+	wasmContracts := test.SetupWasmContracts(&deps, &s.Suite)
+	wasmContract := wasmContracts[1] // hello_world_counter.wasm
+
+	evmCfg := deps.EvmKeeper.GetEVMConfig(deps.Ctx())
+	tracer, err := tracers.DefaultDirectory.New(
+		"callTracer", &tracers.Context{}, json.RawMessage(`{"withLog":true}`), evmCfg.ChainConfig,
+	)
+	s.Require().NoError(err)
+	evmObj := deps.EvmKeeper.NewEVM(
+		deps.Ctx(), evmtest.MOCK_GETH_MESSAGE, evmCfg, tracer.Hooks, deps.NewStateDB(),
+	)
+
+	executeMsgs := []WasmExecuteMsg{
+		{
+			ContractAddr: wasmContract.String(),
+			MsgArgs:      []byte(`{"increment": {}}`),
+			Funds: []precompile.WasmBankCoin{{
+				Denom:  evm.EVMBankDenom,
+				Amount: big.NewInt(100),
+			}},
+		},
+		{
+			ContractAddr: wasmContract.String(),
+			MsgArgs:      []byte(`{"invalid": "json"}`),
+			Funds:        []precompile.WasmBankCoin{},
+		},
+	}
+	contractInput, err := embeds.SmartContract_Wasm.ABI.Pack(
+		string(precompile.WasmMethod_executeMulti),
+		executeMsgs,
+	)
+	s.Require().NoError(err)
+	_, err = deps.EvmKeeper.CallContract(
+		evmObj,
+		deps.Sender.EthAddr,
+		&precompile.PrecompileAddr_Wasm,
+		contractInput,
+		WasmGasLimitExecute,
+		evm.COMMIT_ETH_TX, /*commit*/
+		nil,
+	)
+	s.Require().ErrorContains(err, "unknown variant")
+
+	type callFrame struct {
+		From  gethcommon.Address `json:"from"`
+		To    gethcommon.Address `json:"to"`
+		Input hexutil.Bytes      `json:"input"`
+		Value *hexutil.Big       `json:"value"`
+		Error string             `json:"error"`
+		Calls []callFrame        `json:"calls"`
+		Logs  []json.RawMessage  `json:"logs"`
+	}
+	traceResult, err := tracer.GetResult()
+	s.Require().NoError(err)
+	var precompileCall callFrame
+	s.Require().NoError(json.Unmarshal(traceResult, &precompileCall))
+	s.Equal(precompile.PrecompileAddr_Wasm, precompileCall.To)
+	s.NotEmpty(precompileCall.Error)
+	s.Require().Len(precompileCall.Calls, 2, "one frame per Wasm contract call")
+
+	contractEthAddr := eth.NibiruAddrToEthAddr(wasmContract)
+	for _, wasmCall := range precompileCall.Calls {
+		s.Equal(deps.Sender.EthAddr, wasmCall.From)
+		s.Equal(contractEthAddr, wasmCall.To)
+		s.Contains(string(wasmCall.Input), wasmContract.String())
+	}
+
+	s.Run("successful call with funds", func() {
+		wasmCall := precompileCall.Calls[0]
+		s.Contains(string(wasmCall.Input), `"msg":{"increment":{}}`)
+		s.Empty(wasmCall.Error)
+		s.NotEmpty(wasmCall.Logs, "ABCI events of the Wasm call")
+
+		s.Require().Len(wasmCall.Calls, 1, "bank transfer of the funds")
+		transfer := wasmCall.Calls[0]
+		s.Equal(deps.Sender.EthAddr, transfer.From)
+		s.Equal(contractEthAddr, transfer.To)
+		s.Equal(
+			evm.NativeToWei(big.NewInt(100)).String(),
+			transfer.Value.ToInt().String(),
+		)
+	})
+
+	s.Run("failed call", func() {
+		wasmCall := precompileCall.Calls[1]
+		s.Contains(wasmCall.Error, "unknown variant")
+		s.Empty(wasmCall.Calls)
+	})
+}
@@ -98,6 +98,13 @@ func (req *EthCallRequest) Validate() error {
 	return nil
 }
 
+func (req *SimulateV1Request) Validate() error {
+	if req == nil {
+		return nutil.ErrNilGrpcMsg
+	}
+	return nil
+}
+
 func (req *QueryTraceTxRequest) Validate() error {
 	if req == nil {
 		return nutil.ErrNilGrpcMsg
@@ -903,6 +903,13 @@ type EthCallRequest struct {
 	ProposerAddress github_com_NibiruChain_nibiru_v2_lib_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// state_overrides is the JSON-encoded set of account overrides (balance,
+	// nonce, code, state, stateDiff) applied before the call, in the same format
+	// as the third parameter of "eth_call".
+	StateOverrides []byte `protobuf:"bytes,5,opt,name=state_overrides,json=stateOverrides,proto3" json:"state_overrides,omitempty"`
+	// block_overrides is the JSON-encoded set of block header overrides (number,
+	// time, fee recipient, base fee) in the format of geth's "BlockOverrides".
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -966,6 +973,20 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetStateOverrides() []byte {
+	if m != nil {
+		return m.StateOverrides
+	}
+	return nil
+}
+
+func (m *EthCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -1012,6 +1033,128 @@ func (m *EstimateGasResponse) GetGas() uint64 {
 	return 0
 }
 
+// SimulateV1Request defines SimulateV1 request
+type SimulateV1Request struct {
+	// opts is the JSON-encoded simulation options ("blockStateCalls",
+	// "traceTransfers", "validation", and "returnFullTransactions") in the same
+	// format as the first parameter of "eth_simulateV1".
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap is the total gas that all calls of the request can use. Zero
+	// means no cap.
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_NibiruChain_nibiru_v2_lib_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *SimulateV1Request) Reset()         { *m = SimulateV1Request{} }
+func (m *SimulateV1Request) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Request) ProtoMessage()    {}
+func (*SimulateV1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{18}
+}
+func (m *SimulateV1Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateV1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateV1Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateV1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Request.Merge(m, src)
+}
+func (m *SimulateV1Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateV1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateV1Request proto.InternalMessageInfo
+
+func (m *SimulateV1Request) GetOpts() []byte {
+	if m != nil {
+		return m.Opts
+	}
+	return nil
+}
+
+func (m *SimulateV1Request) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *SimulateV1Request) GetProposerAddress() github_com_NibiruChain_nibiru_v2_lib_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *SimulateV1Request) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// SimulateV1Response defines SimulateV1 response
+type SimulateV1Response struct {
+	// result is the JSON-encoded list of simulated blocks
+	Result []byte `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *SimulateV1Response) Reset()         { *m = SimulateV1Response{} }
+func (m *SimulateV1Response) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Response) ProtoMessage()    {}
+func (*SimulateV1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ffa36cdc5add14ed, []int{19}
+}
+func (m *SimulateV1Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateV1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateV1Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateV1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Response.Merge(m, src)
+}
+func (m *SimulateV1Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateV1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateV1Response proto.InternalMessageInfo
+
+func (m *SimulateV1Response) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	// msg is the MsgEthereumTx for the requested transaction
@@ -1039,7 +1182,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{18}
+	return fileDescriptor_ffa36cdc5add14ed, []int{20}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1141,7 +1284,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{19}
+	return fileDescriptor_ffa36cdc5add14ed, []int{21}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1201,7 +1344,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{20}
+	return fileDescriptor_ffa36cdc5add14ed, []int{22}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1296,7 +1439,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{21}
+	return fileDescriptor_ffa36cdc5add14ed, []int{23}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1341,7 +1484,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{22}
+	return fileDescriptor_ffa36cdc5add14ed, []int{24}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1384,7 +1527,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{23}
+	return fileDescriptor_ffa36cdc5add14ed, []int{25}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1423,7 +1566,7 @@ func (m *QueryFunTokenMappingRequest) Reset()         { *m = QueryFunTokenMappin
 func (m *QueryFunTokenMappingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFunTokenMappingRequest) ProtoMessage()    {}
 func (*QueryFunTokenMappingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{24}
+	return fileDescriptor_ffa36cdc5add14ed, []int{26}
 }
 func (m *QueryFunTokenMappingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1461,7 +1604,7 @@ func (m *QueryFunTokenMappingResponse) Reset()         { *m = QueryFunTokenMappi
 func (m *QueryFunTokenMappingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFunTokenMappingResponse) ProtoMessage()    {}
 func (*QueryFunTokenMappingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffa36cdc5add14ed, []int{25}
+	return fileDescriptor_ffa36cdc5add14ed, []int{27}
 }
 func (m *QueryFunTokenMappingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1509,6 +1652,8 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "eth.evm.v1.QueryParamsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "eth.evm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "eth.evm.v1.EstimateGasResponse")
+	proto.RegisterType((*SimulateV1Request)(nil), "eth.evm.v1.SimulateV1Request")
+	proto.RegisterType((*SimulateV1Response)(nil), "eth.evm.v1.SimulateV1Response")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "eth.evm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "eth.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "eth.evm.v1.QueryTraceBlockRequest")
@@ -1522,118 +1667,124 @@ func init() {
 func init() { proto.RegisterFile("eth/evm/v1/query.proto", fileDescriptor_ffa36cdc5add14ed) }
 
 var fileDescriptor_ffa36cdc5add14ed = []byte{
-	// 1764 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x5f, 0x6f, 0x1b, 0x59,
-	0x15, 0xcf, 0xc4, 0x4e, 0xec, 0x1c, 0x3b, 0x6d, 0xb8, 0x4d, 0x9b, 0xc4, 0x6d, 0xe2, 0x66, 0xb2,
-	0x34, 0xd9, 0x65, 0x77, 0xa6, 0xf1, 0xae, 0x40, 0x5a, 0xb1, 0xa0, 0x3a, 0xa4, 0xdd, 0x65, 0xb7,
-	0xcb, 0x32, 0x04, 0x90, 0xe0, 0xc1, 0xba, 0x1e, 0xdf, 0xcc, 0x8c, 0xec, 0x99, 0xeb, 0xce, 0xbd,
-	0xf6, 0x3a, 0x94, 0xbc, 0xb0, 0x42, 0x42, 0x42, 0x2b, 0xad, 0xc4, 0x17, 0xe8, 0x13, 0xe2, 0x13,
-	0x20, 0x10, 0x5f, 0x60, 0xc5, 0x53, 0x25, 0x5e, 0x10, 0x0f, 0x05, 0xb5, 0x3c, 0xf0, 0x19, 0x78,
-	0x42, 0xf7, 0x5f, 0x3c, 0xb6, 0x27, 0x49, 0xf9, 0x23, 0x9e, 0xf6, 0xc9, 0xf7, 0x9e, 0x7b, 0xfe,
-	0xfc, 0xce, 0x1f, 0x9f, 0x73, 0x06, 0x6e, 0x10, 0x1e, 0xba, 0x64, 0x18, 0xbb, 0xc3, 0x7d, 0xf7,
-	0xd1, 0x80, 0xa4, 0x27, 0x4e, 0x3f, 0xa5, 0x9c, 0x22, 0x20, 0x3c, 0x74, 0xc8, 0x30, 0x76, 0x86,
-	0xfb, 0xb5, 0xd7, 0x7c, 0xca, 0x62, 0xca, 0xdc, 0x36, 0x66, 0x44, 0x31, 0xb9, 0xc3, 0xfd, 0x36,
-	0xe1, 0x78, 0xdf, 0xed, 0xe3, 0x20, 0x4a, 0x30, 0x8f, 0x68, 0xa2, 0xe4, 0x6a, 0xab, 0x19, 0x7d,
-	0x42, 0x5c, 0x51, 0xaf, 0x65, 0xa8, 0x7c, 0x64, 0x58, 0x03, 0x1a, 0x50, 0x79, 0x74, 0xc5, 0x49,
-	0x53, 0x6f, 0x05, 0x94, 0x06, 0x3d, 0xe2, 0xe2, 0x7e, 0xe4, 0xe2, 0x24, 0xa1, 0x5c, 0x6a, 0x67,
-	0xfa, 0xb5, 0xae, 0x5f, 0xe5, 0xad, 0x3d, 0x38, 0x76, 0x79, 0x14, 0x13, 0xc6, 0x71, 0xdc, 0x57,
-	0x0c, 0xf6, 0xd7, 0xe1, 0xc6, 0x77, 0x05, 0xc2, 0x43, 0x1e, 0xde, 0xf3, 0x7d, 0x3a, 0x48, 0xb8,
-	0x47, 0x1e, 0x0d, 0x08, 0xe3, 0x68, 0x1d, 0x4a, 0xb8, 0xd3, 0x49, 0x09, 0x63, 0xeb, 0xd6, 0x6d,
-	0x6b, 0x6f, 0xc9, 0x33, 0xd7, 0xb7, 0xcb, 0xbf, 0x78, 0x52, 0x9f, 0xfb, 0xc7, 0x93, 0xfa, 0x9c,
-	0xfd, 0x07, 0x0b, 0xd6, 0x66, 0xc4, 0x59, 0x9f, 0x26, 0x8c, 0xa0, 0x3a, 0x54, 0xda, 0xb8, 0x87,
-	0x13, 0x9f, 0xb4, 0x3e, 0x26, 0xd1, 0xfa, 0xbc, 0xd4, 0x01, 0x9a, 0xf4, 0x43, 0x12, 0xa1, 0x9b,
-	0xb0, 0xe4, 0xd3, 0x0e, 0x69, 0x85, 0x98, 0x85, 0xeb, 0x05, 0xf9, 0x5c, 0x16, 0x84, 0x77, 0x31,
-	0x0b, 0xd1, 0x2a, 0x2c, 0x24, 0x34, 0xf1, 0xc9, 0x7a, 0xf1, 0xb6, 0xb5, 0x57, 0xf4, 0xd4, 0x45,
-	0xe8, 0x24, 0x3c, 0x6c, 0x19, 0x5c, 0x0b, 0x4a, 0x27, 0xe1, 0xe1, 0x3d, 0x45, 0x41, 0x5f, 0x86,
-	0x2b, 0x6d, 0xe2, 0x87, 0x6f, 0x36, 0xce, 0x78, 0x16, 0x25, 0xcf, 0xb2, 0xa2, 0x6a, 0xb6, 0x6f,
-	0x17, 0xcb, 0xd6, 0xca, 0xbc, 0xfd, 0x3e, 0xdc, 0x92, 0xe0, 0x7f, 0x80, 0x7b, 0x51, 0x07, 0x73,
-	0x9a, 0x4e, 0x45, 0x60, 0x1b, 0xaa, 0x3e, 0x4d, 0x58, 0x6b, 0x32, 0x0c, 0x15, 0x41, 0xbb, 0x37,
-	0x13, 0x8a, 0x5f, 0x5a, 0xb0, 0x79, 0x8e, 0x36, 0x1d, 0x90, 0x5d, 0xb8, 0x8a, 0x15, 0x69, 0x4a,
-	0xe3, 0x15, 0x4d, 0x36, 0x4e, 0xd4, 0xa0, 0xcc, 0x04, 0x04, 0xe1, 0xfe, 0xbc, 0x74, 0xff, 0xec,
-	0x2e, 0x1c, 0x34, 0x4a, 0x92, 0x41, 0xdc, 0x26, 0xa9, 0x8c, 0x5c, 0xd1, 0x5b, 0xd6, 0xd4, 0x0f,
-	0x25, 0xd1, 0xfe, 0x0e, 0x5c, 0x93, 0x60, 0x9a, 0x2a, 0xdc, 0x97, 0xe6, 0x54, 0xc4, 0x9b, 0xd3,
-	0x2e, 0x49, 0x74, 0x9e, 0xd4, 0x25, 0xe3, 0xde, 0xaf, 0x2d, 0x58, 0x9d, 0xd4, 0xf8, 0xb2, 0x69,
-	0xde, 0x87, 0x62, 0x1b, 0x27, 0x5d, 0x89, 0xb3, 0xd2, 0x58, 0x73, 0xc6, 0x7f, 0x14, 0x47, 0xeb,
-	0x6a, 0xe2, 0xa4, 0xdb, 0x2c, 0x7e, 0xfe, 0xac, 0x6e, 0x79, 0x92, 0x15, 0xbd, 0x05, 0x0b, 0x24,
-	0xf5, 0x1b, 0x77, 0x65, 0xf2, 0x2b, 0x8d, 0xf5, 0x1c, 0x99, 0x43, 0xef, 0xa0, 0x71, 0x57, 0x0b,
-	0x29, 0x66, 0x9d, 0xd4, 0xdf, 0x58, 0x50, 0xc9, 0xe8, 0x45, 0x37, 0x60, 0x91, 0x9d, 0xc4, 0x6d,
-	0xda, 0xd3, 0x1e, 0xeb, 0x1b, 0xda, 0x81, 0x65, 0x83, 0x3b, 0x1c, 0xc4, 0xd8, 0x38, 0x5e, 0xd5,
-	0xc4, 0x77, 0x05, 0x4d, 0x64, 0xa2, 0x43, 0xfc, 0x28, 0xc6, 0x3d, 0x26, 0xf1, 0x2f, 0x7b, 0x67,
-	0x77, 0xb4, 0x09, 0xe0, 0xd3, 0x28, 0x69, 0x75, 0x48, 0x42, 0x63, 0x89, 0x74, 0xc9, 0x5b, 0x12,
-	0x94, 0x6f, 0x09, 0x82, 0x28, 0x1e, 0xa3, 0x5f, 0xf4, 0x01, 0x5d, 0xab, 0x26, 0x56, 0x4d, 0xcc,
-	0x88, 0xfd, 0x3b, 0x0b, 0xaa, 0x59, 0x77, 0x2e, 0x48, 0xcf, 0xd8, 0x8b, 0xf9, 0x8b, 0xbd, 0x28,
-	0x5c, 0xe2, 0x45, 0x71, 0xca, 0x0b, 0x04, 0xc5, 0x04, 0xc7, 0x06, 0x9e, 0x3c, 0xcf, 0x40, 0x5f,
-	0x9c, 0x85, 0xfe, 0xbe, 0xae, 0xaf, 0xef, 0x71, 0x9a, 0xe2, 0xe0, 0x25, 0xea, 0x6b, 0x05, 0x0a,
-	0x5d, 0x72, 0xa2, 0xd1, 0x8b, 0x63, 0xa6, 0xb6, 0x5e, 0xd7, 0xa5, 0x75, 0xa6, 0x4c, 0x97, 0xd6,
-	0x2a, 0x2c, 0x0c, 0x71, 0x6f, 0x40, 0xb4, 0x2e, 0x75, 0xb1, 0xbf, 0x0a, 0x2b, 0x92, 0xfb, 0x80,
-	0x76, 0xc8, 0xbf, 0xd3, 0xab, 0x76, 0xe1, 0x4b, 0x19, 0x39, 0x6d, 0x02, 0x41, 0x51, 0xb4, 0x1c,
-	0x29, 0x55, 0xf5, 0xe4, 0xd9, 0xfe, 0x09, 0x20, 0xc9, 0x78, 0x34, 0xfa, 0x80, 0x06, 0xcc, 0x98,
-	0x40, 0x50, 0x94, 0x8d, 0x4a, 0xe9, 0x97, 0x67, 0x74, 0x1f, 0x60, 0xdc, 0xd0, 0xa5, 0x6f, 0x95,
-	0xc6, 0x1d, 0x47, 0x75, 0x7f, 0x47, 0x84, 0xce, 0x51, 0x23, 0x42, 0x77, 0x7f, 0xe7, 0xa3, 0x71,
-	0xa8, 0xbc, 0x8c, 0x64, 0x06, 0xe4, 0x27, 0x96, 0x0e, 0xac, 0x31, 0xae, 0x71, 0xee, 0x40, 0xb1,
-	0x47, 0x03, 0xe1, 0x5d, 0x61, 0xaf, 0xd2, 0xb8, 0x9a, 0xfd, 0x43, 0x7c, 0x40, 0x03, 0x4f, 0x3e,
-	0xa2, 0x07, 0x39, 0x70, 0x76, 0x2f, 0x85, 0xa3, 0x2c, 0x64, 0xf1, 0xd8, 0xab, 0x3a, 0x02, 0x1f,
-	0xe1, 0x14, 0xc7, 0x26, 0x02, 0xf6, 0x03, 0x0d, 0xcd, 0x50, 0x35, 0xb4, 0xbb, 0xb0, 0xd8, 0x97,
-	0x14, 0x19, 0x9a, 0x4a, 0x03, 0x65, 0xc1, 0x29, 0x5e, 0xf9, 0x3f, 0x9d, 0xf3, 0x34, 0x9f, 0xfd,
-	0x47, 0x0b, 0xae, 0x1c, 0xf2, 0xf0, 0x00, 0xf7, 0x7a, 0x99, 0xe8, 0xe2, 0x34, 0x60, 0x26, 0x0f,
-	0xe2, 0x8c, 0xd6, 0xa0, 0x14, 0x60, 0xd6, 0xf2, 0x71, 0x5f, 0x77, 0xc1, 0xc5, 0x00, 0xb3, 0x03,
-	0xdc, 0x47, 0x7d, 0x58, 0xe9, 0xa7, 0xb4, 0x4f, 0x19, 0x49, 0xcf, 0x3a, 0xa9, 0xa8, 0xfb, 0x6a,
-	0xf3, 0xf0, 0x9f, 0xcf, 0xea, 0xf7, 0x82, 0x88, 0x87, 0x83, 0xb6, 0xe3, 0xd3, 0xd8, 0xfd, 0x30,
-	0x6a, 0x47, 0xe9, 0xe0, 0x20, 0xc4, 0x51, 0xe2, 0x26, 0xf2, 0xec, 0x0e, 0x1b, 0x6e, 0x2f, 0x6a,
-	0xbb, 0x2a, 0x2a, 0x6f, 0xb0, 0x4e, 0xd7, 0xe5, 0x27, 0x7d, 0xc2, 0x9c, 0x83, 0x71, 0x57, 0xf7,
-	0xae, 0x1a, 0xf5, 0xa6, 0x23, 0x6f, 0x40, 0xd9, 0x17, 0x4a, 0x5a, 0x51, 0x47, 0xfe, 0x83, 0x0a,
-	0x5e, 0x49, 0xde, 0xdf, 0xeb, 0xd8, 0xbb, 0x70, 0xed, 0x90, 0xf1, 0x28, 0xc6, 0x9c, 0x3c, 0xc0,
-	0xe3, 0xa8, 0xac, 0x40, 0x21, 0xc0, 0xca, 0x9f, 0xa2, 0x27, 0x8e, 0xf6, 0xcf, 0x8b, 0x26, 0xb5,
-	0x29, 0xf6, 0xc9, 0xd1, 0xc8, 0xb8, 0xfe, 0x15, 0x28, 0xc4, 0x2c, 0xd0, 0xc1, 0xdb, 0xc8, 0x06,
-	0xef, 0x21, 0x0b, 0x0e, 0x79, 0x48, 0x52, 0x32, 0x88, 0x8f, 0x46, 0x9e, 0xe0, 0x42, 0x6f, 0x43,
-	0x95, 0x0b, 0xf1, 0x96, 0x4f, 0x93, 0xe3, 0x28, 0xc8, 0x6b, 0xaa, 0x52, 0xfd, 0x81, 0x7c, 0xf6,
-	0x2a, 0x7c, 0x7c, 0x41, 0xef, 0x40, 0xb5, 0x9f, 0x92, 0x0e, 0xf1, 0x09, 0x63, 0x34, 0x15, 0xad,
-	0xa0, 0x70, 0xb1, 0xc5, 0x09, 0x76, 0xd9, 0x15, 0x7a, 0xd4, 0xef, 0x9a, 0xb9, 0xb3, 0x20, 0xe3,
-	0x50, 0x91, 0x34, 0x35, 0x75, 0x44, 0x4b, 0x54, 0x2c, 0xf2, 0x9f, 0xa2, 0xda, 0xc6, 0x92, 0xa4,
-	0xc8, 0x99, 0x7e, 0x60, 0x9e, 0xc5, 0x12, 0xb2, 0x5e, 0x92, 0xd0, 0x6b, 0x8e, 0xda, 0x50, 0x1c,
-	0xb3, 0xa1, 0x38, 0x47, 0x66, 0x43, 0x69, 0x96, 0x45, 0xd5, 0x7c, 0xf6, 0xd7, 0xba, 0xa5, 0x95,
-	0x88, 0x97, 0xdc, 0xe4, 0x97, 0xff, 0x6f, 0xc9, 0x5f, 0x9a, 0x48, 0x3e, 0xb2, 0x61, 0x59, 0x79,
-	0x14, 0xe3, 0x51, 0x4b, 0xe4, 0x1b, 0x32, 0x41, 0x79, 0x88, 0x47, 0x0f, 0xb0, 0xd8, 0x35, 0xe6,
-	0x57, 0x0a, 0x5e, 0x99, 0x8f, 0x5a, 0x51, 0xd2, 0x21, 0x23, 0xfb, 0x35, 0xdd, 0xed, 0xce, 0xca,
-	0x60, 0xdc, 0x8a, 0x3a, 0x98, 0x63, 0xf3, 0x17, 0x10, 0x67, 0xfb, 0xf7, 0x05, 0xbd, 0x9e, 0x49,
-	0xe6, 0xa6, 0xd0, 0x9a, 0x29, 0x1b, 0x3e, 0x32, 0x0d, 0xe1, 0xa2, 0xb2, 0xe1, 0x23, 0xf6, 0x5f,
-	0x95, 0xcd, 0x17, 0x79, 0xff, 0x8f, 0xf2, 0x6e, 0xbf, 0xa1, 0x57, 0xe3, 0x6c, 0xea, 0x2e, 0x48,
-	0xf5, 0xf5, 0xb3, 0x8d, 0x8d, 0x91, 0xfb, 0xc4, 0x8c, 0x09, 0xfb, 0xd3, 0xf1, 0xde, 0xa5, 0xe9,
-	0x5a, 0xc7, 0x5b, 0x50, 0x16, 0x2d, 0xbd, 0x75, 0x4c, 0xf4, 0x7c, 0x6c, 0x6e, 0xfc, 0xe5, 0x59,
-	0xfd, 0xba, 0x72, 0x91, 0x75, 0xba, 0x4e, 0x44, 0xdd, 0x18, 0xf3, 0xd0, 0x79, 0x2f, 0xe1, 0x5e,
-	0xa9, 0xad, 0xa4, 0xd1, 0x37, 0xe1, 0x8a, 0x91, 0x6a, 0x0d, 0x44, 0x70, 0xd4, 0x44, 0xbe, 0x48,
-	0xb6, 0xaa, 0x65, 0xbf, 0x2f, 0xd8, 0xed, 0x77, 0xe0, 0xa6, 0x84, 0x73, 0x7f, 0x90, 0x1c, 0x89,
-	0x15, 0xf1, 0x21, 0xee, 0xf7, 0xa3, 0x24, 0x30, 0x55, 0x79, 0xb6, 0x46, 0x5a, 0xf9, 0x6b, 0xe4,
-	0x8f, 0xf5, 0xca, 0x3d, 0x23, 0xae, 0xbd, 0xda, 0x87, 0xa5, 0xe3, 0x41, 0xd2, 0x1a, 0xeb, 0xa8,
-	0x34, 0x56, 0xb3, 0x55, 0x6a, 0xe4, 0xbc, 0xf2, 0xb1, 0x3e, 0x8d, 0x95, 0x37, 0x7e, 0x5b, 0x85,
-	0x05, 0xa9, 0x1d, 0x7d, 0x62, 0x01, 0x8c, 0x3f, 0x49, 0x90, 0x9d, 0x55, 0x91, 0xff, 0xb9, 0x53,
-	0xdb, 0xb9, 0x90, 0x47, 0xc1, 0xb3, 0x5f, 0xff, 0xd9, 0x9f, 0xfe, 0xfe, 0xab, 0xf9, 0x3b, 0xe8,
-	0x15, 0x53, 0x58, 0xe6, 0xcb, 0x4d, 0x7c, 0x94, 0x28, 0x5e, 0xf7, 0xb1, 0xae, 0xce, 0x53, 0xf4,
-	0xc4, 0x82, 0x95, 0xe9, 0xaf, 0x01, 0xb4, 0x37, 0x63, 0xe7, 0x9c, 0xcf, 0x8f, 0xda, 0xab, 0x2f,
-	0xc1, 0xa9, 0x71, 0x7d, 0x4d, 0xe2, 0xda, 0x47, 0xee, 0x14, 0xae, 0xa1, 0x11, 0x18, 0xa3, 0xcb,
-	0x7e, 0xd1, 0x9c, 0xa2, 0x8f, 0xa1, 0xa4, 0x37, 0x50, 0x54, 0x9f, 0x31, 0x37, 0xf9, 0xf1, 0x50,
-	0xbb, 0x7d, 0x3e, 0x83, 0x86, 0xf1, 0xaa, 0x84, 0xb1, 0x83, 0xb6, 0xa7, 0x60, 0xe8, 0xcd, 0x91,
-	0x65, 0x62, 0xf3, 0x53, 0x28, 0xe9, 0x75, 0x2f, 0xc7, 0xf0, 0xe4, 0x56, 0x99, 0x63, 0x78, 0x6a,
-	0x53, 0xb4, 0x1d, 0x69, 0x78, 0x0f, 0xdd, 0x99, 0x32, 0xcc, 0x14, 0xdf, 0xd8, 0xae, 0xfb, 0xb8,
-	0x4b, 0x4e, 0x4e, 0x51, 0x17, 0x8a, 0x62, 0x0d, 0x44, 0xb7, 0x66, 0x34, 0x67, 0xb6, 0xca, 0xda,
-	0xe6, 0x39, 0xaf, 0xda, 0xe8, 0x1d, 0x69, 0xf4, 0x36, 0xda, 0x9a, 0x32, 0x2a, 0x96, 0xc8, 0xac,
-	0xab, 0x21, 0x2c, 0xaa, 0x35, 0x08, 0x6d, 0xcd, 0x28, 0x9c, 0xd8, 0xb0, 0x6a, 0xf5, 0x73, 0xdf,
-	0xb5, 0xc9, 0x4d, 0x69, 0x72, 0x0d, 0x5d, 0x9f, 0x32, 0xa9, 0x16, 0x2b, 0x14, 0x41, 0x49, 0xef,
-	0x55, 0xa8, 0x96, 0x55, 0x35, 0xb9, 0x6c, 0xd5, 0xb6, 0xcf, 0x9f, 0x16, 0xc6, 0x50, 0x5d, 0x1a,
-	0xda, 0x40, 0x6b, 0x39, 0x85, 0xee, 0x0b, 0xfd, 0x14, 0x2a, 0x99, 0xb5, 0xe7, 0x42, 0x73, 0x13,
-	0x5e, 0xe5, 0xec, 0x4a, 0xf6, 0x8e, 0x34, 0xb6, 0x89, 0x6e, 0x4e, 0x1b, 0xd3, 0xbc, 0xa2, 0xc3,
-	0xa2, 0x18, 0x4a, 0x7a, 0x62, 0xe6, 0x14, 0xcc, 0xe4, 0x4a, 0x95, 0x53, 0x30, 0x53, 0xc3, 0xf6,
-	0x5c, 0xff, 0xd4, 0x94, 0xe4, 0x23, 0x74, 0x02, 0x30, 0x6e, 0xdc, 0x39, 0x0d, 0x64, 0x66, 0x20,
-	0xe7, 0x34, 0x90, 0xd9, 0xce, 0x6f, 0xdb, 0xd2, 0xee, 0x2d, 0x54, 0xcb, 0xb5, 0x2b, 0xc7, 0x07,
-	0x7a, 0x04, 0x4b, 0x6a, 0x18, 0x8b, 0x38, 0xff, 0x0f, 0x7c, 0xdd, 0x96, 0x36, 0x6f, 0xa2, 0x8d,
-	0x5c, 0x9b, 0x32, 0x9b, 0xb1, 0x68, 0x03, 0x6a, 0x42, 0xe4, 0xb5, 0x81, 0xec, 0x44, 0xca, 0x6d,
-	0x03, 0x13, 0xa3, 0xe9, 0xdc, 0xe0, 0x9a, 0xc9, 0x83, 0x3e, 0xb5, 0xe0, 0xea, 0xd4, 0x04, 0x40,
-	0xbb, 0x33, 0x6a, 0xf3, 0x47, 0x4c, 0x6d, 0xef, 0x72, 0x46, 0x8d, 0x63, 0x57, 0xe2, 0xd8, 0x46,
-	0xf5, 0x29, 0x1c, 0xc7, 0x83, 0x44, 0x0e, 0x18, 0xf7, 0xb1, 0xfc, 0x39, 0x6d, 0x7e, 0xe3, 0xf3,
-	0xe7, 0x5b, 0xd6, 0xd3, 0xe7, 0x5b, 0xd6, 0xdf, 0x9e, 0x6f, 0x59, 0x9f, 0xbd, 0xd8, 0x9a, 0x7b,
-	0xfa, 0x62, 0x6b, 0xee, 0xcf, 0x2f, 0xb6, 0xe6, 0x7e, 0xf4, 0xca, 0xa5, 0x7b, 0x05, 0x19, 0xc6,
-	0xed, 0x45, 0xb9, 0xc4, 0xbc, 0xf9, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x33, 0xa2, 0x7a, 0xfb,
-	0x1d, 0x14, 0x00, 0x00,
+	// 1859 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0x94, 0x48, 0x3d, 0x52, 0xb2, 0x32, 0x92, 0xf5, 0xb1, 0x96, 0x48, 0x69, 0x95,
+	0x5a, 0x4a, 0xea, 0x70, 0x4d, 0x26, 0x68, 0x81, 0xa0, 0x69, 0x61, 0xaa, 0xb2, 0x93, 0x26, 0x4e,
+	0xd2, 0x8d, 0x9a, 0x02, 0xed, 0x81, 0x18, 0x2e, 0x47, 0xcb, 0x05, 0xb9, 0x3b, 0xf4, 0xce, 0x90,
+	0xa1, 0xea, 0xfa, 0xd2, 0xa0, 0x40, 0x81, 0x22, 0x40, 0x80, 0x5e, 0x7b, 0xf0, 0xa9, 0xed, 0x9f,
+	0xd0, 0xa2, 0xff, 0x40, 0x2e, 0x05, 0x0c, 0xf4, 0x52, 0xf4, 0xe0, 0x16, 0x76, 0x0f, 0xfd, 0x1b,
+	0x7a, 0x2a, 0xe6, 0x63, 0xc9, 0xe5, 0x97, 0xe8, 0x7e, 0xa0, 0x40, 0x81, 0x9c, 0x76, 0xe6, 0xcd,
+	0xfb, 0xf8, 0xbd, 0x37, 0x6f, 0xdf, 0x7b, 0x03, 0x5b, 0x84, 0x37, 0x6d, 0xd2, 0x0b, 0xec, 0x5e,
+	0xd9, 0x7e, 0xd0, 0x25, 0xd1, 0x65, 0xa9, 0x13, 0x51, 0x4e, 0x11, 0x10, 0xde, 0x2c, 0x91, 0x5e,
+	0x50, 0xea, 0x95, 0xcd, 0x57, 0x5d, 0xca, 0x02, 0xca, 0xec, 0x3a, 0x66, 0x44, 0x31, 0xd9, 0xbd,
+	0x72, 0x9d, 0x70, 0x5c, 0xb6, 0x3b, 0xd8, 0xf3, 0x43, 0xcc, 0x7d, 0x1a, 0x2a, 0x39, 0x73, 0x33,
+	0xa1, 0x4f, 0x88, 0x2b, 0xea, 0x46, 0x82, 0xca, 0xfb, 0x31, 0xab, 0x47, 0x3d, 0x2a, 0x97, 0xb6,
+	0x58, 0x69, 0xea, 0x9e, 0x47, 0xa9, 0xd7, 0x26, 0x36, 0xee, 0xf8, 0x36, 0x0e, 0x43, 0xca, 0xa5,
+	0x76, 0xa6, 0x4f, 0x8b, 0xfa, 0x54, 0xee, 0xea, 0xdd, 0x0b, 0x9b, 0xfb, 0x01, 0x61, 0x1c, 0x07,
+	0x1d, 0xc5, 0x60, 0x7d, 0x03, 0xb6, 0xbe, 0x2b, 0x10, 0x9e, 0xf1, 0xe6, 0x1d, 0xd7, 0xa5, 0xdd,
+	0x90, 0x3b, 0xe4, 0x41, 0x97, 0x30, 0x8e, 0x76, 0x20, 0x83, 0x1b, 0x8d, 0x88, 0x30, 0xb6, 0x63,
+	0x1c, 0x18, 0x27, 0x2b, 0x4e, 0xbc, 0x7d, 0x33, 0xfb, 0xb3, 0xc7, 0xc5, 0x85, 0xbf, 0x3f, 0x2e,
+	0x2e, 0x58, 0xbf, 0x37, 0x60, 0x7b, 0x42, 0x9c, 0x75, 0x68, 0xc8, 0x08, 0x2a, 0x42, 0xae, 0x8e,
+	0xdb, 0x38, 0x74, 0x49, 0xed, 0x13, 0xe2, 0xef, 0x2c, 0x4a, 0x1d, 0xa0, 0x49, 0xdf, 0x27, 0x3e,
+	0xba, 0x01, 0x2b, 0x2e, 0x6d, 0x90, 0x5a, 0x13, 0xb3, 0xe6, 0x4e, 0x4a, 0x1e, 0x67, 0x05, 0xe1,
+	0x6d, 0xcc, 0x9a, 0x68, 0x13, 0x96, 0x42, 0x1a, 0xba, 0x64, 0x27, 0x7d, 0x60, 0x9c, 0xa4, 0x1d,
+	0xb5, 0x11, 0x3a, 0x09, 0x6f, 0xd6, 0x62, 0x5c, 0x4b, 0x4a, 0x27, 0xe1, 0xcd, 0x3b, 0x8a, 0x82,
+	0xbe, 0x02, 0x6b, 0x75, 0xe2, 0x36, 0x5f, 0xaf, 0x0c, 0x78, 0x96, 0x25, 0xcf, 0xaa, 0xa2, 0x6a,
+	0xb6, 0xef, 0xa4, 0xb3, 0xc6, 0xfa, 0xa2, 0xf5, 0x2e, 0xec, 0x49, 0xf0, 0x1f, 0xe3, 0xb6, 0xdf,
+	0xc0, 0x9c, 0x46, 0x63, 0x11, 0x38, 0x84, 0xbc, 0x4b, 0x43, 0x56, 0x1b, 0x0d, 0x43, 0x4e, 0xd0,
+	0xee, 0x4c, 0x84, 0xe2, 0xe7, 0x06, 0xec, 0xcf, 0xd0, 0xa6, 0x03, 0x72, 0x0c, 0xd7, 0xb0, 0x22,
+	0x8d, 0x69, 0x5c, 0xd3, 0xe4, 0xd8, 0x09, 0x13, 0xb2, 0x4c, 0x40, 0x10, 0xee, 0x2f, 0x4a, 0xf7,
+	0x07, 0x7b, 0xe1, 0x60, 0xac, 0x24, 0xec, 0x06, 0x75, 0x12, 0xc9, 0xc8, 0xa5, 0x9d, 0x55, 0x4d,
+	0x7d, 0x5f, 0x12, 0xad, 0x0f, 0x60, 0x43, 0x82, 0xa9, 0xaa, 0x70, 0xcf, 0xbd, 0x53, 0x11, 0x6f,
+	0x4e, 0x5b, 0x24, 0xd4, 0xf7, 0xa4, 0x36, 0x09, 0xf7, 0x7e, 0x65, 0xc0, 0xe6, 0xa8, 0xc6, 0x17,
+	0xbd, 0xe6, 0x32, 0xa4, 0xeb, 0x38, 0x6c, 0x49, 0x9c, 0xb9, 0xca, 0x76, 0x69, 0xf8, 0xa3, 0x94,
+	0xb4, 0xae, 0x2a, 0x0e, 0x5b, 0xd5, 0xf4, 0x17, 0x4f, 0x8b, 0x86, 0x23, 0x59, 0xd1, 0x1b, 0xb0,
+	0x44, 0x22, 0xb7, 0x72, 0x5b, 0x5e, 0x7e, 0xae, 0xb2, 0x33, 0x45, 0xe6, 0xcc, 0x39, 0xad, 0xdc,
+	0xd6, 0x42, 0x8a, 0x59, 0x5f, 0xea, 0x6f, 0x0c, 0xc8, 0x25, 0xf4, 0xa2, 0x2d, 0x58, 0x66, 0x97,
+	0x41, 0x9d, 0xb6, 0xb5, 0xc7, 0x7a, 0x87, 0x8e, 0x60, 0x35, 0xc6, 0xdd, 0xec, 0x06, 0x38, 0x76,
+	0x3c, 0xaf, 0x89, 0x6f, 0x0b, 0x9a, 0xb8, 0x89, 0x06, 0x71, 0xfd, 0x00, 0xb7, 0x99, 0xc4, 0xbf,
+	0xea, 0x0c, 0xf6, 0x68, 0x1f, 0xc0, 0xa5, 0x7e, 0x58, 0x6b, 0x90, 0x90, 0x06, 0x12, 0xe9, 0x8a,
+	0xb3, 0x22, 0x28, 0xdf, 0x16, 0x04, 0x91, 0x3c, 0xb1, 0x7e, 0x51, 0x07, 0x74, 0xae, 0xc6, 0xb1,
+	0xaa, 0x62, 0x46, 0xac, 0xdf, 0x1a, 0x90, 0x4f, 0xba, 0x73, 0xc5, 0xf5, 0x0c, 0xbd, 0x58, 0xbc,
+	0xda, 0x8b, 0xd4, 0x1c, 0x2f, 0xd2, 0x63, 0x5e, 0x20, 0x48, 0x87, 0x38, 0x88, 0xe1, 0xc9, 0xf5,
+	0x04, 0xf4, 0xe5, 0x49, 0xe8, 0xef, 0xea, 0xfc, 0xfa, 0x88, 0xd3, 0x08, 0x7b, 0x2f, 0x90, 0x5f,
+	0xeb, 0x90, 0x6a, 0x91, 0x4b, 0x8d, 0x5e, 0x2c, 0x13, 0xb9, 0x75, 0x0b, 0x36, 0x47, 0x95, 0xe9,
+	0xd4, 0xda, 0x84, 0xa5, 0x1e, 0x6e, 0x77, 0x89, 0xd6, 0xa5, 0x36, 0xd6, 0xd7, 0x60, 0x5d, 0x72,
+	0x9f, 0xd2, 0x06, 0xf9, 0x57, 0x6a, 0xd5, 0x31, 0xbc, 0x94, 0x90, 0xd3, 0x26, 0x10, 0xa4, 0x45,
+	0xc9, 0x91, 0x52, 0x79, 0x47, 0xae, 0xad, 0x1f, 0x01, 0x92, 0x8c, 0xe7, 0xfd, 0xf7, 0xa8, 0xc7,
+	0x62, 0x13, 0x08, 0xd2, 0xb2, 0x50, 0x29, 0xfd, 0x72, 0x8d, 0xee, 0x02, 0x0c, 0x0b, 0xba, 0xf4,
+	0x2d, 0x57, 0xb9, 0x59, 0x52, 0xd5, 0xbf, 0x24, 0x42, 0x57, 0x52, 0x2d, 0x42, 0x57, 0xff, 0xd2,
+	0x87, 0xc3, 0x50, 0x39, 0x09, 0xc9, 0x04, 0xc8, 0x4f, 0x0d, 0xd8, 0x18, 0x31, 0xae, 0x71, 0x1e,
+	0x41, 0xba, 0x4d, 0x3d, 0xe1, 0x5d, 0xea, 0x24, 0x57, 0xb9, 0x96, 0xfc, 0x21, 0xde, 0xa3, 0x9e,
+	0x23, 0x0f, 0xd1, 0xbd, 0x29, 0x70, 0x8e, 0xe7, 0xc2, 0x51, 0x16, 0x92, 0x78, 0xac, 0x4d, 0x1d,
+	0x81, 0x0f, 0x71, 0x84, 0x83, 0x38, 0x02, 0xd6, 0x3d, 0xd8, 0x18, 0xa1, 0x6a, 0x68, 0xb7, 0x61,
+	0xb9, 0x23, 0x29, 0x32, 0x34, 0xb9, 0x0a, 0x4a, 0x82, 0x53, 0xbc, 0xf2, 0x3f, 0x5d, 0x70, 0x34,
+	0x9f, 0xf5, 0xcb, 0x45, 0x58, 0x3b, 0xe3, 0xcd, 0x53, 0xdc, 0x6e, 0x27, 0xa2, 0x8b, 0x23, 0x8f,
+	0xc5, 0xf7, 0x20, 0xd6, 0x68, 0x1b, 0x32, 0x1e, 0x66, 0x35, 0x17, 0x77, 0x74, 0x15, 0x5c, 0xf6,
+	0x30, 0x3b, 0xc5, 0x1d, 0xd4, 0x81, 0xf5, 0x4e, 0x44, 0x3b, 0x94, 0x91, 0x68, 0x50, 0x49, 0x45,
+	0xde, 0xe7, 0xab, 0x67, 0xff, 0x78, 0x5a, 0xbc, 0xe3, 0xf9, 0xbc, 0xd9, 0xad, 0x97, 0x5c, 0x1a,
+	0xd8, 0xef, 0xfb, 0x75, 0x3f, 0xea, 0x9e, 0x36, 0xb1, 0x1f, 0xda, 0xa1, 0x5c, 0xdb, 0xbd, 0x8a,
+	0xdd, 0xf6, 0xeb, 0xb6, 0x8a, 0xca, 0x6b, 0xac, 0xd1, 0xb2, 0xf9, 0x65, 0x87, 0xb0, 0xd2, 0xe9,
+	0xb0, 0xaa, 0x3b, 0xd7, 0x62, 0xf5, 0x9a, 0x80, 0x76, 0x21, 0xeb, 0x0a, 0x25, 0x35, 0xbf, 0x21,
+	0xff, 0xa0, 0x94, 0x93, 0x91, 0xfb, 0x77, 0x1a, 0xa2, 0xaa, 0x33, 0x8e, 0x39, 0xa9, 0xd1, 0x1e,
+	0x89, 0x22, 0xbf, 0x41, 0x54, 0x5b, 0xca, 0x3b, 0x6b, 0x92, 0xfc, 0x41, 0x4c, 0x15, 0x8c, 0xf5,
+	0x36, 0x75, 0x5b, 0x09, 0xc6, 0x65, 0xc5, 0x28, 0xc9, 0x03, 0x46, 0xeb, 0x18, 0x36, 0xce, 0x18,
+	0xf7, 0x03, 0xcc, 0xc9, 0x3d, 0x3c, 0x8c, 0xf3, 0x3a, 0xa4, 0x3c, 0xac, 0x22, 0x94, 0x76, 0xc4,
+	0xd2, 0xfa, 0x83, 0x01, 0x2f, 0x7d, 0xe4, 0x07, 0xdd, 0x36, 0xe6, 0xe4, 0xe3, 0x72, 0x22, 0x94,
+	0xb4, 0xc3, 0x07, 0xa1, 0x14, 0xeb, 0xff, 0x8f, 0x50, 0x5a, 0xb7, 0x00, 0x25, 0xdd, 0xd1, 0x7e,
+	0x6f, 0xc1, 0x72, 0x44, 0x58, 0xb7, 0xcd, 0xb5, 0x47, 0x7a, 0x67, 0xfd, 0x34, 0x1d, 0xff, 0x2a,
+	0x11, 0x76, 0xc9, 0x79, 0x3f, 0xf6, 0xff, 0xab, 0x90, 0x0a, 0x98, 0xa7, 0x93, 0x71, 0x37, 0x99,
+	0x8c, 0xf7, 0x99, 0x77, 0xc6, 0x9b, 0x24, 0x22, 0xdd, 0xe0, 0xbc, 0xef, 0x08, 0x2e, 0xf4, 0x26,
+	0xe4, 0xb9, 0x10, 0xaf, 0xb9, 0x34, 0xbc, 0xf0, 0xbd, 0x69, 0x4d, 0x4a, 0xaa, 0x3f, 0x95, 0xc7,
+	0x4e, 0x8e, 0x0f, 0x37, 0xe8, 0x2d, 0xc8, 0x77, 0x22, 0xd2, 0x20, 0x2e, 0x61, 0x8c, 0x46, 0xa2,
+	0xb4, 0xa6, 0xae, 0xb6, 0x38, 0xc2, 0x2e, 0xab, 0xac, 0xcc, 0x07, 0xdd, 0xc7, 0x97, 0x64, 0x30,
+	0x72, 0x92, 0xa6, 0xba, 0xb8, 0x68, 0x31, 0x8a, 0x45, 0x56, 0x1e, 0x55, 0x86, 0x57, 0x24, 0x45,
+	0xce, 0x48, 0xa7, 0xf1, 0xb1, 0x18, 0xea, 0x76, 0x32, 0x12, 0xba, 0x59, 0x52, 0x13, 0x5f, 0x29,
+	0x9e, 0xf8, 0x4a, 0xe7, 0xf1, 0xc4, 0x57, 0xcd, 0x8a, 0xbf, 0xf0, 0xf3, 0xbf, 0x14, 0x0d, 0xad,
+	0x44, 0x9c, 0x4c, 0xcd, 0x80, 0xec, 0xff, 0x2c, 0x03, 0x56, 0x46, 0x7f, 0x26, 0x0b, 0x56, 0x95,
+	0x47, 0x01, 0xee, 0xd7, 0x44, 0xb6, 0x43, 0x22, 0x28, 0xf7, 0x71, 0xff, 0x1e, 0x16, 0xb3, 0xdb,
+	0xe2, 0x7a, 0xca, 0xc9, 0xf2, 0x7e, 0xcd, 0x0f, 0x1b, 0xa4, 0x6f, 0xbd, 0xaa, 0xbb, 0xc7, 0x20,
+	0x0d, 0x86, 0xa5, 0xbd, 0x81, 0x39, 0x8e, 0xff, 0x03, 0xb1, 0xb6, 0x7e, 0x97, 0x82, 0xad, 0x21,
+	0x73, 0x55, 0x68, 0x4d, 0xa4, 0x0d, 0xef, 0xc7, 0x05, 0xf6, 0xaa, 0xb4, 0xe1, 0x7d, 0xf6, 0x1f,
+	0xa5, 0xcd, 0x97, 0xf7, 0xfe, 0x6f, 0xdd, 0xbb, 0xf5, 0x9a, 0x7e, 0x6a, 0x24, 0xaf, 0xee, 0x8a,
+	0xab, 0xbe, 0x3e, 0x98, 0x80, 0x19, 0xb9, 0x4b, 0xe2, 0xb6, 0x6b, 0x7d, 0x36, 0x9c, 0x63, 0x35,
+	0x5d, 0xeb, 0x78, 0x03, 0xb2, 0xa2, 0x45, 0xd6, 0x2e, 0x88, 0x9e, 0x37, 0xaa, 0xbb, 0x7f, 0x7e,
+	0x5a, 0xbc, 0xae, 0x5c, 0x64, 0x8d, 0x56, 0xc9, 0xa7, 0x76, 0x80, 0x79, 0xb3, 0xf4, 0x4e, 0xc8,
+	0x9d, 0x4c, 0x5d, 0x49, 0xa3, 0x6f, 0xc1, 0x5a, 0x2c, 0x55, 0xeb, 0x8a, 0xe0, 0xec, 0x2c, 0xce,
+	0x93, 0xcd, 0x6b, 0xd9, 0xef, 0x09, 0x76, 0xeb, 0x2d, 0xb8, 0x21, 0xe1, 0xdc, 0xed, 0x86, 0xe7,
+	0x62, 0xe4, 0xbe, 0x8f, 0x3b, 0x1d, 0x3f, 0xf4, 0xe2, 0xac, 0x1c, 0x8c, 0xe5, 0xc6, 0xf4, 0xb1,
+	0xfc, 0x87, 0xb0, 0x37, 0x5d, 0x5c, 0x7b, 0x55, 0x86, 0x95, 0x8b, 0x6e, 0x58, 0x1b, 0xea, 0xc8,
+	0x55, 0x36, 0x93, 0x59, 0x1a, 0xcb, 0x39, 0xd9, 0x0b, 0xbd, 0x1a, 0x2a, 0xaf, 0xfc, 0x7a, 0x15,
+	0x96, 0xa4, 0x76, 0xf4, 0xa9, 0x01, 0x30, 0x7c, 0xe2, 0x21, 0x2b, 0xa9, 0x62, 0xfa, 0xf3, 0xd1,
+	0x3c, 0xba, 0x92, 0x47, 0xc1, 0xb3, 0x6e, 0xfd, 0xe4, 0x8f, 0x7f, 0xfb, 0xc5, 0xe2, 0x4d, 0xf4,
+	0x72, 0x9c, 0x58, 0xf1, 0x4b, 0x58, 0x3c, 0xf2, 0x14, 0xaf, 0xfd, 0x50, 0x67, 0xe7, 0x23, 0xf4,
+	0xd8, 0x80, 0xf5, 0xf1, 0xd7, 0x15, 0x3a, 0x99, 0xb0, 0x33, 0xe3, 0x39, 0x67, 0xbe, 0xf2, 0x02,
+	0x9c, 0x1a, 0xd7, 0xd7, 0x25, 0xae, 0x32, 0xb2, 0xc7, 0x70, 0xf5, 0x62, 0x81, 0x21, 0xba, 0xe4,
+	0x0b, 0xf1, 0x11, 0xfa, 0x04, 0x32, 0x7a, 0xa2, 0x47, 0xc5, 0x09, 0x73, 0xa3, 0x8f, 0x31, 0xf3,
+	0x60, 0x36, 0x83, 0x86, 0xf1, 0x8a, 0x84, 0x71, 0x84, 0x0e, 0xc7, 0x60, 0xe8, 0x49, 0x9c, 0x25,
+	0x62, 0xf3, 0x63, 0xc8, 0xe8, 0xf1, 0x79, 0x8a, 0xe1, 0xd1, 0x29, 0xdd, 0x3c, 0x98, 0xcd, 0xa0,
+	0x0d, 0x97, 0xa4, 0xe1, 0x13, 0x74, 0x73, 0xcc, 0x30, 0x53, 0x7c, 0x43, 0xbb, 0xf6, 0xc3, 0x16,
+	0xb9, 0x7c, 0x84, 0x5a, 0x90, 0x16, 0x63, 0x35, 0xda, 0x9b, 0xd0, 0x9c, 0x98, 0xd2, 0xcd, 0xfd,
+	0x19, 0xa7, 0xda, 0xe8, 0x4d, 0x69, 0xf4, 0x00, 0x15, 0xc6, 0x8c, 0x8a, 0xa1, 0x3c, 0xe9, 0x6a,
+	0x13, 0x96, 0xd5, 0x58, 0x89, 0x0a, 0x13, 0x0a, 0x47, 0x26, 0x56, 0xb3, 0x38, 0xf3, 0x5c, 0x9b,
+	0xdc, 0x97, 0x26, 0xb7, 0xd1, 0xf5, 0x31, 0x93, 0x6a, 0x50, 0x45, 0x3e, 0x64, 0xf4, 0x9c, 0x8a,
+	0xcc, 0xa4, 0xaa, 0xd1, 0xe1, 0xd5, 0x3c, 0x9c, 0xdd, 0x2d, 0x62, 0x43, 0x45, 0x69, 0x68, 0x17,
+	0x6d, 0x4f, 0x49, 0x74, 0x57, 0xe8, 0xa7, 0x90, 0x4b, 0x0c, 0x7d, 0x57, 0x9a, 0x1b, 0xf1, 0x6a,
+	0xca, 0xa4, 0x68, 0x1d, 0x49, 0x63, 0xfb, 0xe8, 0xc6, 0xb8, 0x31, 0xcd, 0x2b, 0x2a, 0x2c, 0xa2,
+	0x00, 0xc3, 0x61, 0x0b, 0x8d, 0x5c, 0xcd, 0xc4, 0x4c, 0x69, 0x16, 0x66, 0x1d, 0x6b, 0x8b, 0x96,
+	0xb4, 0xb8, 0x87, 0xcc, 0xf1, 0x7c, 0xd1, 0xac, 0xb5, 0x5e, 0x19, 0x05, 0x90, 0xd1, 0x2d, 0x7a,
+	0x4a, 0x86, 0x8e, 0xce, 0x70, 0xe6, 0xc1, 0x6c, 0x86, 0x39, 0x01, 0x55, 0x6d, 0x99, 0xf7, 0xd1,
+	0x25, 0xc0, 0xb0, 0x53, 0x4c, 0xa9, 0x58, 0x13, 0x13, 0x80, 0x79, 0x74, 0x25, 0xcf, 0x1c, 0x4f,
+	0x95, 0x5d, 0xd9, 0xaf, 0xd0, 0x03, 0x58, 0x51, 0xdd, 0x5f, 0x5c, 0xec, 0x7f, 0xc1, 0xd7, 0x43,
+	0x69, 0xf3, 0x06, 0xda, 0x9d, 0x6a, 0x53, 0xa6, 0x4f, 0x20, 0xea, 0x8e, 0x6a, 0x49, 0xd3, 0xea,
+	0x4e, 0xb2, 0x05, 0x9a, 0x07, 0xb3, 0x19, 0xe6, 0x04, 0x37, 0x6e, 0x75, 0xe8, 0x33, 0x03, 0xae,
+	0x8d, 0xb5, 0x1c, 0x74, 0x3c, 0xa1, 0x76, 0x7a, 0x4f, 0x33, 0x4f, 0xe6, 0x33, 0x6a, 0x1c, 0xc7,
+	0x12, 0xc7, 0x21, 0x2a, 0x8e, 0xe1, 0xb8, 0xe8, 0x86, 0xb2, 0xa3, 0xd9, 0x0f, 0xe5, 0xe7, 0x51,
+	0xf5, 0x9b, 0x5f, 0x3c, 0x2b, 0x18, 0x4f, 0x9e, 0x15, 0x8c, 0xbf, 0x3e, 0x2b, 0x18, 0x9f, 0x3f,
+	0x2f, 0x2c, 0x3c, 0x79, 0x5e, 0x58, 0xf8, 0xd3, 0xf3, 0xc2, 0xc2, 0x0f, 0x5e, 0x9e, 0x3b, 0xc8,
+	0x90, 0x5e, 0x50, 0x5f, 0x96, 0x53, 0xd3, 0xeb, 0xff, 0x1c, 0x00, 0xc7, 0x67, 0x0a, 0x51, 0xde,
+	0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1667,6 +1818,8 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
@@ -1760,6 +1913,15 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error) {
+	out := new(SimulateV1Response)
+	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/SimulateV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/eth.evm.v1.Query/TraceTx", in, out, opts...)
@@ -1826,6 +1988,8 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and
@@ -1867,6 +2031,9 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) SimulateV1(ctx context.Context, req *SimulateV1Request) (*SimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
@@ -2031,6 +2198,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eth.evm.v1.Query/SimulateV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*SimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -2157,6 +2342,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -2842,6 +3031,20 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StateOverrides) > 0 {
+		i -= len(m.StateOverrides)
+		copy(dAtA[i:], m.StateOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateOverrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2897,6 +3100,83 @@ func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SimulateV1Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateV1Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateV1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opts) > 0 {
+		i -= len(m.Opts)
+		copy(dAtA[i:], m.Opts)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opts)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateV1Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateV1Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateV1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3590,6 +3870,14 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.StateOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3605,6 +3893,42 @@ func (m *EstimateGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *SimulateV1Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opts)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *SimulateV1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTraceTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5812,17 +6136,85 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateOverrides", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateOverrides = append(m.StateOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateOverrides == nil {
+				m.StateOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -5902,6 +6294,246 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SimulateV1Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateV1Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opts = append(m.Opts[:0], dAtA[iNdEx:postIndex]...)
+			if m.Opts == nil {
+				m.Opts = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateV1Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateV1Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,131 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evm
+
+import (
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks that a single
+	// "eth_simulateV1" request can simulate.
+	MaxSimulateBlocks = 256
+	// MaxSimulateCalls is the maximum number of calls, across all blocks, that
+	// a single "eth_simulateV1" request can simulate.
+	MaxSimulateCalls = 1_000
+	// SimulateTimestampIncrement is the number of seconds between the
+	// timestamps of consecutive simulated blocks when the block overrides do
+	// not set a time. It matches go-ethereum.
+	SimulateTimestampIncrement = 12
+
+	// SimErrCodeReverted is the JSON-RPC error code of a simulated call that
+	// reverted. The revert data is returned with the error.
+	SimErrCodeReverted = 3
+	// SimErrCodeVMError is the JSON-RPC error code of a simulated call that
+	// failed with any other EVM error, for example running out of gas.
+	SimErrCodeVMError = -32015
+)
+
+var (
+	// SimTransferAddress is the pseudo-contract that emits the "Transfer" logs
+	// of native NIBI transfers when "traceTransfers" is set. It is the address
+	// conventionally used for the native token of a chain in ERC-20 contexts.
+	SimTransferAddress = gethcommon.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+	// SimTransferTopic is the event signature hash of
+	// "Transfer(address,address,uint256)" from ERC-20.
+	SimTransferTopic = gethcommon.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+)
+
+// SimOpts are the options of "eth_simulateV1": the sequence of blocks to
+// simulate on top of the requested block.
+type SimOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	// TraceTransfers adds an ERC-20 "Transfer" log emitted by
+	// [SimTransferAddress] to the logs of a call for every transfer of native
+	// NIBI, including the value of the call itself.
+	TraceTransfers bool `json:"traceTransfers"`
+	// Validation makes the simulation check calls like txs: nonces must
+	// match the state, the fee cap must cover the base fee, and the sender
+	// pays for gas. Otherwise, nonces are filled in and the base fee is zero
+	// unless overridden.
+	Validation bool `json:"validation"`
+	// ReturnFullTransactions returns the transactions of the simulated blocks
+	// as objects instead of hashes.
+	ReturnFullTransactions bool `json:"returnFullTransactions"`
+}
+
+// SimBlock is a simulated block: the calls to execute in order after applying
+// the block and state overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides `json:"blockOverrides,omitempty"`
+	StateOverrides StateOverride   `json:"stateOverrides,omitempty"`
+	Calls          []JsonTxArgs    `json:"calls"`
+}
+
+// Validate returns an error if the options have no blocks, too many blocks,
+// or invalid overrides.
+func (opts SimOpts) Validate() error {
+	if len(opts.BlockStateCalls) == 0 {
+		return fmt.Errorf("empty input")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return fmt.Errorf("too many blocks: %d > %d", len(opts.BlockStateCalls), MaxSimulateBlocks)
+	}
+	numCalls := 0
+	for i, block := range opts.BlockStateCalls {
+		numCalls += len(block.Calls)
+		if numCalls > MaxSimulateCalls {
+			return fmt.Errorf("too many calls: more than %d", MaxSimulateCalls)
+		}
+		if err := block.StateOverrides.Validate(); err != nil {
+			return fmt.Errorf("block %d: %w", i, err)
+		}
+		if err := block.BlockOverrides.Validate(); err != nil {
+			return fmt.Errorf("block %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// SimBlockResult is a simulated block returned by the "SimulateV1" query.
+type SimBlockResult struct {
+	Number        hexutil.Uint64     `json:"number"`
+	Hash          gethcommon.Hash    `json:"hash"`
+	ParentHash    gethcommon.Hash    `json:"parentHash"`
+	Timestamp     hexutil.Uint64     `json:"timestamp"`
+	GasLimit      hexutil.Uint64     `json:"gasLimit"`
+	GasUsed       hexutil.Uint64     `json:"gasUsed"`
+	Miner         gethcommon.Address `json:"miner"`
+	BaseFeePerGas *hexutil.Big       `json:"baseFeePerGas"`
+	// Transactions are the unsigned txs built from the calls of the block.
+	Transactions []SimTx         `json:"transactions"`
+	Calls        []SimCallResult `json:"calls"`
+}
+
+// SimTx is an unsigned tx of a simulated block.
+type SimTx struct {
+	Hash gethcommon.Hash    `json:"hash"`
+	From gethcommon.Address `json:"from"`
+	// Tx is the binary encoding of the unsigned [gethcore.Transaction].
+	Tx hexutil.Bytes `json:"tx"`
+}
+
+// SimCallResult is the outcome of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*gethcore.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call. Data holds the
+// revert data if the call reverted.
+type SimCallError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Data    string `json:"data,omitempty"`
+}
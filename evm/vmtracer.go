@@ -2,11 +2,17 @@
 package evm
 
 import (
+	"encoding/json"
+	"math/big"
 	"os"
+	"sync/atomic"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	tracersnative "github.com/ethereum/go-ethereum/eth/tracers/native"
@@ -18,6 +24,9 @@ const (
 	TracerJSON       = "json"
 	TracerStruct     = "struct"
 	TracerMarkdown   = "markdown"
+	// TracerERC7562 traces the validation of an ERC-4337 user operation for
+	// the ERC-7562 rules. See [NewERC7562Tracer].
+	TracerERC7562 = "erc7562"
 )
 
 // NewTracer creates a new Logger tracer to collect execution traces from an
@@ -35,13 +44,7 @@ func NewTracer(
 
 	switch tracer {
 	case TracerAccessList:
-		precompileAddrs := PRECOMPILE_ADDRS
-		return logger.NewAccessListTracer(
-			msg.AccessList,
-			msg.From,
-			*msg.To,
-			precompileAddrs,
-		).Hooks()
+		return NewAccessListTracer(msg, PRECOMPILE_ADDRS).Hooks
 	case TracerJSON:
 		return logger.NewJSONLogger(logCfg, os.Stdout)
 	case TracerMarkdown:
@@ -55,6 +58,188 @@ func NewTracer(
 	}
 }
 
+// NewAccessListTracer returns a tracer that records the accounts and storage
+// slots accessed by msg, starting from the access list of msg. Its result is
+// the JSON-encoded access list, which excludes the sender, the
+// recipient (or the created contract), and the given precompiled contracts,
+// since they are always warm.
+func NewAccessListTracer(
+	msg core.Message, precompiles []gethcommon.Address,
+) *tracers.Tracer {
+	to := crypto.CreateAddress(msg.From, msg.Nonce)
+	if msg.To != nil {
+		to = *msg.To
+	}
+	accessListTracer := logger.NewAccessListTracer(
+		msg.AccessList, msg.From, to, precompiles,
+	)
+	return &tracers.Tracer{
+		Hooks: accessListTracer.Hooks(),
+		GetResult: func() (json.RawMessage, error) {
+			return json.Marshal(accessListTracer.AccessList())
+		},
+		Stop: func(error) {},
+	}
+}
+
+// ERC7562BannedOpcodes are the opcodes that the entities of a user operation
+// must not use during its validation (ERC-7562, OP-011 and OP-080). Their
+// results differ between the simulation and the execution in a bundle, so a
+// user operation that uses them could pass the simulation and fail on chain
+// at the expense of the bundler.
+var ERC7562BannedOpcodes = map[vm.OpCode]bool{
+	vm.GASPRICE:     true,
+	vm.GASLIMIT:     true,
+	vm.DIFFICULTY:   true,
+	vm.TIMESTAMP:    true,
+	vm.BASEFEE:      true,
+	vm.BLOCKHASH:    true,
+	vm.NUMBER:       true,
+	vm.SELFBALANCE:  true,
+	vm.BALANCE:      true,
+	vm.ORIGIN:       true,
+	vm.CREATE:       true,
+	vm.COINBASE:     true,
+	vm.SELFDESTRUCT: true,
+	vm.BLOBHASH:     true,
+	vm.BLOBBASEFEE:  true,
+	vm.INVALID:      true,
+}
+
+// ERC7562Step is a step of the trace of [NewERC7562Tracer]: the entry into a
+// call frame, the exit from one, or an opcode restricted by ERC-7562.
+type ERC7562Step struct {
+	// Op is the opcode, or the call type of a frame entry, such as CALL or
+	// DELEGATECALL.
+	Op string `json:"op"`
+	// Enter marks the entry into the call frame of To, and Exit the return
+	// from the current call frame.
+	Enter bool                `json:"enter,omitempty"`
+	Exit  bool                `json:"exit,omitempty"`
+	To    *gethcommon.Address `json:"to,omitempty"`
+	// Slot is the storage slot of SLOAD and SSTORE.
+	Slot *gethcommon.Hash `json:"slot,omitempty"`
+	// KeccakInput is the input of KECCAK256 when its first word holds an
+	// address, as in the "keccak256(A||x)" slots of a mapping keyed by A.
+	KeccakInput hexutil.Bytes `json:"keccakInput,omitempty"`
+}
+
+// NewERC7562Tracer returns a tracer that records only what the ERC-7562
+// validation rules need: the call frames, and the banned opcodes, CREATE2,
+// the storage slots accessed and the mapping keys hashed by KECCAK256. GAS is
+// recorded only when the next opcode is not a call, since ERC-7562 allows
+// GAS right before a call. Its result is the JSON-encoded []ERC7562Step.
+func NewERC7562Tracer() *tracers.Tracer {
+	t := &erc7562Tracer{steps: []ERC7562Step{}}
+	return &tracers.Tracer{
+		Hooks: &tracing.Hooks{
+			OnEnter:  t.onEnter,
+			OnExit:   t.onExit,
+			OnOpcode: t.onOpcode,
+		},
+		GetResult: t.getResult,
+		Stop:      t.stop,
+	}
+}
+
+type erc7562Tracer struct {
+	steps []ERC7562Step
+	// gas is the last GAS step until the next opcode shows whether it is
+	// used for a call.
+	gas       *ERC7562Step
+	interrupt atomic.Bool
+	reason    error
+}
+
+func (t *erc7562Tracer) onEnter(
+	depth int, typ byte, from, to gethcommon.Address, input []byte, gas uint64, value *big.Int,
+) {
+	if t.interrupt.Load() {
+		return
+	}
+	t.flushGas(false)
+	t.steps = append(t.steps, ERC7562Step{Op: vm.OpCode(typ).String(), Enter: true, To: &to})
+}
+
+func (t *erc7562Tracer) onExit(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+	if t.interrupt.Load() {
+		return
+	}
+	t.flushGas(false)
+	t.steps = append(t.steps, ERC7562Step{Exit: true})
+}
+
+func (t *erc7562Tracer) onOpcode(
+	pc uint64, op byte, gas, cost uint64, scope tracing.OpContext, rData []byte, depth int, err error,
+) {
+	if t.interrupt.Load() {
+		return
+	}
+	opcode := vm.OpCode(op)
+	switch opcode {
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		t.flushGas(true)
+	default:
+		t.flushGas(false)
+	}
+
+	step := ERC7562Step{Op: opcode.String()}
+	stack := scope.StackData()
+	switch {
+	case opcode == vm.GAS:
+		t.gas = &step
+		return
+	case opcode == vm.SLOAD || opcode == vm.SSTORE:
+		if len(stack) < 1 {
+			return
+		}
+		slot := gethcommon.Hash(stack[len(stack)-1].Bytes32())
+		step.Slot = &slot
+	case opcode == vm.KECCAK256:
+		if len(stack) < 2 {
+			return
+		}
+		offset, size := stack[len(stack)-1], stack[len(stack)-2]
+		memory := scope.MemoryData()
+		if !offset.IsUint64() || !size.IsUint64() || size.Uint64() < gethcommon.HashLength ||
+			offset.Uint64() > uint64(len(memory)) || size.Uint64() > uint64(len(memory))-offset.Uint64() {
+			return
+		}
+		input := memory[offset.Uint64() : offset.Uint64()+size.Uint64()]
+		key := gethcommon.BytesToHash(input[:gethcommon.HashLength])
+		if key != gethcommon.BytesToHash(gethcommon.BytesToAddress(key.Bytes()).Bytes()) {
+			return
+		}
+		step.KeccakInput = gethcommon.CopyBytes(input)
+	case opcode == vm.CREATE2 || ERC7562BannedOpcodes[opcode]:
+	default:
+		return
+	}
+	t.steps = append(t.steps, step)
+}
+
+// flushGas records the pending GAS step unless it is used for a call.
+func (t *erc7562Tracer) flushGas(call bool) {
+	if t.gas != nil && !call {
+		t.steps = append(t.steps, *t.gas)
+	}
+	t.gas = nil
+}
+
+func (t *erc7562Tracer) getResult() (json.RawMessage, error) {
+	t.flushGas(false)
+	res, err := json.Marshal(t.steps)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+func (t *erc7562Tracer) stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
+
 func NewDefaultTracer() *tracers.Tracer {
 	logCfg := &logger.Config{
 		Debug: false,
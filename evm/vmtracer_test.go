@@ -0,0 +1,74 @@
+// Copyright (c) 2023-2024 Nibi, Inc.
+package evm_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/evm"
+)
+
+// fakeOpContext is a [tracing.OpContext] with only a stack and a memory.
+type fakeOpContext struct {
+	stack  []uint256.Int
+	memory []byte
+}
+
+func (c fakeOpContext) MemoryData() []byte          { return c.memory }
+func (c fakeOpContext) StackData() []uint256.Int    { return c.stack }
+func (c fakeOpContext) Caller() gethcommon.Address  { return gethcommon.Address{} }
+func (c fakeOpContext) Address() gethcommon.Address { return gethcommon.Address{} }
+func (c fakeOpContext) CallValue() *uint256.Int     { return new(uint256.Int) }
+func (c fakeOpContext) CallInput() []byte           { return nil }
+func (c fakeOpContext) ContractCode() []byte        { return nil }
+
+func TestERC7562Tracer(t *testing.T) {
+	tracer := evm.NewERC7562Tracer()
+	account := gethcommon.BytesToAddress([]byte{0xa})
+	opcode := func(op vm.OpCode, scope fakeOpContext) {
+		tracer.OnOpcode(0, byte(op), 0, 0, scope, nil, 2, nil)
+	}
+	words := func(xs ...uint64) []uint256.Int {
+		stack := make([]uint256.Int, len(xs))
+		for i, x := range xs {
+			stack[i].SetUint64(x)
+		}
+		return stack
+	}
+
+	mappingKey := append(gethcommon.LeftPadBytes(account.Bytes(), 32), make([]byte, 32)...)
+	notAddress := append(gethcommon.MaxHash.Bytes(), make([]byte, 32)...)
+
+	tracer.OnEnter(1, byte(vm.CALL), gethcommon.Address{}, account, nil, 0, nil)
+	opcode(vm.GAS, fakeOpContext{})
+	opcode(vm.CALL, fakeOpContext{})
+	opcode(vm.GAS, fakeOpContext{})
+	opcode(vm.ADD, fakeOpContext{})
+	opcode(vm.SLOAD, fakeOpContext{stack: words(5)})
+	// The stack top is last: the offset 0 above the size 64.
+	opcode(vm.KECCAK256, fakeOpContext{stack: words(64, 0), memory: mappingKey})
+	opcode(vm.KECCAK256, fakeOpContext{stack: words(64, 0), memory: notAddress})
+	opcode(vm.TIMESTAMP, fakeOpContext{})
+	tracer.OnExit(1, nil, 0, nil, false)
+
+	result, err := tracer.GetResult()
+	require.NoError(t, err)
+	var steps []evm.ERC7562Step
+	require.NoError(t, json.Unmarshal(result, &steps))
+
+	slot := gethcommon.BigToHash(uint256.NewInt(5).ToBig())
+	require.Equal(t, []evm.ERC7562Step{
+		{Op: "CALL", Enter: true, To: &account},
+		{Op: "GAS"},
+		{Op: "SLOAD", Slot: &slot},
+		{Op: "KECCAK256", KeccakInput: hexutil.Bytes(mappingKey)},
+		{Op: "TIMESTAMP"},
+		{Exit: true},
+	}, steps)
+}
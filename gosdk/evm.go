@@ -0,0 +1,169 @@
+package gosdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+
+	srvconfig "github.com/NibiruChain/nibiru/v2/app/server/config"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/evm"
+)
+
+// EVMClient signs Ethereum txs ([evm.MsgEthereumTx]) with the keys of the
+// NibiruSDK keyring and broadcasts them. The signing keys must be
+// "eth_secp256k1" keys.
+type EVMClient struct {
+	nc *NibiruSDK
+}
+
+// EVM returns the client for Ethereum txs.
+func (nc *NibiruSDK) EVM() EVMClient {
+	return EVMClient{nc: nc}
+}
+
+// EthTxArgs are the arguments of an Ethereum tx sent with
+// [EVMClient.SendTx]. Unset fields are filled in from the chain.
+type EthTxArgs struct {
+	// To is the recipient. A nil To deploys a contract with Data as its
+	// creation bytecode.
+	To *gethcommon.Address
+	// Value is the amount of NIBI to send in wei.
+	Value *big.Int
+	Data  []byte
+	// Nonce defaults to the current nonce of the sender.
+	Nonce *uint64
+	// GasLimit defaults to the gas estimated with "EstimateGas".
+	GasLimit uint64
+	// GasFeeCap defaults to the base fee.
+	GasFeeCap *big.Int
+	// GasTipCap defaults to zero.
+	GasTipCap *big.Int
+}
+
+// EthTxResponse is the result of broadcasting an Ethereum tx.
+type EthTxResponse struct {
+	*sdk.TxResponse
+	// EthTxHash is the Ethereum hash of the tx, used by the JSON-RPC API.
+	EthTxHash gethcommon.Hash
+	// ContractAddr is the address of the deployed contract if the tx creates
+	// one.
+	ContractAddr *gethcommon.Address
+}
+
+// BuildTx fills in the unset fields of args and returns the signed
+// [evm.MsgEthereumTx].
+func (c EVMClient) BuildTx(
+	from gethcommon.Address, args EthTxArgs,
+) (*evm.MsgEthereumTx, error) {
+	ctx := context.Background()
+	queryClient := c.nc.Querier.EVM
+	chainID := eth.ParseEthChainID(c.nc.ChainId)
+
+	value := args.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	var nonce uint64
+	if args.Nonce != nil {
+		nonce = *args.Nonce
+	} else {
+		accResp, err := queryClient.EthAccount(ctx, &evm.QueryEthAccountRequest{Address: from.Hex()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the nonce of %s: %w", from.Hex(), err)
+		}
+		nonce = accResp.Nonce
+	}
+
+	gasLimit := args.GasLimit
+	if gasLimit == 0 {
+		callArgs, err := json.Marshal(evm.JsonTxArgs{
+			From:  &from,
+			To:    args.To,
+			Value: (*hexutil.Big)(value),
+			Input: (*hexutil.Bytes)(&args.Data),
+		})
+		if err != nil {
+			return nil, err
+		}
+		gasResp, err := queryClient.EstimateGas(ctx, &evm.EthCallRequest{
+			Args:    callArgs,
+			GasCap:  srvconfig.DefaultEthCallGasLimit,
+			ChainId: chainID.Int64(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas: %w", err)
+		}
+		gasLimit = gasResp.Gas
+	}
+
+	gasFeeCap := args.GasFeeCap
+	if gasFeeCap == nil {
+		gasFeeCap = evm.BASE_FEE_WEI
+		if feeResp, err := queryClient.BaseFee(ctx, &evm.QueryBaseFeeRequest{}); err == nil &&
+			feeResp.BaseFee != nil && feeResp.BaseFee.IsPositive() {
+			gasFeeCap = feeResp.BaseFee.BigInt()
+		}
+	}
+	gasTipCap := args.GasTipCap
+	if gasTipCap == nil {
+		gasTipCap = big.NewInt(0)
+	}
+
+	msg := evm.NewTx(&evm.EvmTxArgs{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		To:        args.To,
+		Amount:    value,
+		GasLimit:  gasLimit,
+		GasFeeCap: gasFeeCap,
+		GasTipCap: gasTipCap,
+		Input:     args.Data,
+	})
+	msg.From = from.Hex()
+	if err := msg.Sign(gethcore.LatestSignerForChainID(chainID), c.nc.Keyring); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// SendTx signs an Ethereum tx from "from" and broadcasts it.
+func (c EVMClient) SendTx(
+	from gethcommon.Address, args EthTxArgs,
+) (*EthTxResponse, error) {
+	msg, err := c.BuildTx(from, args)
+	if err != nil {
+		return nil, err
+	}
+	cosmosTx, err := msg.BuildTx(c.nc.EncCfg.TxConfig.NewTxBuilder(), evm.EVMBankDenom)
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := c.nc.EncCfg.TxConfig.TxEncoder()(cosmosTx)
+	if err != nil {
+		return nil, err
+	}
+
+	txResp, err := BroadcasterTmRpc{RPC: c.nc.CometRPC}.BroadcastTxSync(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	resp := &EthTxResponse{
+		TxResponse: txResp,
+		EthTxHash:  msg.AsTransaction().Hash(),
+	}
+	if args.To == nil {
+		contractAddr := crypto.CreateAddress(from, msg.AsTransaction().Nonce())
+		resp.ContractAddr = &contractAddr
+	}
+	return resp, nil
+}
@@ -1,9 +1,11 @@
 package gosdk_test
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
@@ -100,9 +102,17 @@ func (s *Suite) TestNewNibiruSdk() {
 		_, err := s.localnetCLI.WaitForTx(txHashHex)
 		s.Require().NoError(err)
 	})
+	s.Run("DoTestBroadcastMsgsWithOpts", s.DoTestBroadcastMsgsWithOpts)
 	s.Run("DoTestNewQueryClient", func() {
 		s.NotNil(s.nibiruSdk.Querier)
 		s.NotNil(s.nibiruSdk.Querier.ClientConn)
+
+		balResp, err := s.nibiruSdk.Querier.Bank.Balance(
+			context.Background(),
+			banktypes.NewQueryBalanceRequest(s.from, appconst.DENOM_UNIBI),
+		)
+		s.Require().NoError(err)
+		s.True(balResp.Balance.IsPositive())
 	})
 }
 
@@ -151,6 +161,34 @@ func (s *Suite) DoTestBroadcastMsgsGrpc() (txHashHex string) {
 	return txHashHex
 }
 
+func (s *Suite) DoTestBroadcastMsgsWithOpts() {
+	from, to, amt, msgSend := s.msgSendVars()
+
+	gasUsed, err := s.nibiruSdk.EstimateGas(from, 1, msgSend)
+	s.Require().NoError(err)
+	s.Positive(gasUsed)
+
+	txResp, err := s.nibiruSdk.BroadcastMsgsWithOpts(
+		from,
+		gosdk.TxOptions{
+			Memo:        "gosdk",
+			WaitTimeout: 15 * time.Second,
+		},
+		msgSend,
+	)
+	s.Require().NoError(err)
+	s.AssertTxResponseSuccess(txResp)
+	s.Positive(txResp.Height, "tx should be included in a block")
+	s.Greater(txResp.GasWanted, gasUsed)
+
+	balResp, err := s.nibiruSdk.Querier.Bank.Balance(
+		context.Background(),
+		banktypes.NewQueryBalanceRequest(to, appconst.DENOM_UNIBI),
+	)
+	s.Require().NoError(err)
+	s.Equal(amt.AmountOf(appconst.DENOM_UNIBI), balResp.Balance.Amount)
+}
+
 func (s *Suite) TearDownSuite() {
 	s.Require().NoError(s.localnetCLI.Close())
 	if s.grpcConn != nil {
@@ -12,8 +12,22 @@ import (
 	devgas "github.com/NibiruChain/nibiru/v2/x/devgas/v1/types"
 	epochs "github.com/NibiruChain/nibiru/v2/x/epochs"
 	inflation "github.com/NibiruChain/nibiru/v2/x/mint"
+	oracle "github.com/NibiruChain/nibiru/v2/x/oracle/types"
+	"github.com/NibiruChain/nibiru/v2/x/sudo"
 	tokenfactory "github.com/NibiruChain/nibiru/v2/x/tokenfactory/types"
 
+	sdktypestx "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types/tx"
+	auth "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/auth/types"
+	bank "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/bank/types"
+	distribution "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/distribution/types"
+	gov "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/gov/types/v1"
+	staking "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/staking/types"
+
+	ibctransfer "github.com/NibiruChain/nibiru/v2/lib/ibc-go/modules/apps/transfer/types"
+	ibcclient "github.com/NibiruChain/nibiru/v2/lib/ibc-go/modules/core/02-client/types"
+	ibcconnection "github.com/NibiruChain/nibiru/v2/lib/ibc-go/modules/core/03-connection/types"
+	ibcchannel "github.com/NibiruChain/nibiru/v2/lib/ibc-go/modules/core/04-channel/types"
+
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	cmtcoretypes "github.com/cometbft/cometbft/rpc/core/types"
 )
@@ -27,11 +41,32 @@ type Querier struct {
 	EVM  evm.QueryClient
 	Wasm wasm.QueryClient
 
+	// Cosmos-SDK Modules
+	Auth         auth.QueryClient
+	Bank         bank.QueryClient
+	Staking      staking.QueryClient
+	Distribution distribution.QueryClient
+	Gov          gov.QueryClient
+	// Tx is the tx service, used to simulate txs and query them by events.
+	Tx sdktypestx.ServiceClient
+
 	// Other Modules
 	Devgas       devgas.QueryClient
 	Epoch        epochs.QueryClient
 	Inflation    inflation.QueryClient
+	Oracle       oracle.QueryClient
+	Sudo         sudo.QueryClient
 	TokenFactory tokenfactory.QueryClient
+
+	IBC IBCQuerier
+}
+
+// IBCQuerier groups the query clients of the IBC core and transfer modules.
+type IBCQuerier struct {
+	Client     ibcclient.QueryClient
+	Connection ibcconnection.QueryClient
+	Channel    ibcchannel.QueryClient
+	Transfer   ibctransfer.QueryClient
 }
 
 func NewQuerier(
@@ -50,10 +85,26 @@ func NewQuerier(
 		EVM:  evm.NewQueryClient(grpcConn),
 		Wasm: wasm.NewQueryClient(grpcConn),
 
+		Auth:         auth.NewQueryClient(grpcConn),
+		Bank:         bank.NewQueryClient(grpcConn),
+		Staking:      staking.NewQueryClient(grpcConn),
+		Distribution: distribution.NewQueryClient(grpcConn),
+		Gov:          gov.NewQueryClient(grpcConn),
+		Tx:           sdktypestx.NewServiceClient(grpcConn),
+
 		Devgas:       devgas.NewQueryClient(grpcConn),
 		Epoch:        epochs.NewQueryClient(grpcConn),
 		Inflation:    inflation.NewQueryClient(grpcConn),
+		Oracle:       oracle.NewQueryClient(grpcConn),
+		Sudo:         sudo.NewQueryClient(grpcConn),
 		TokenFactory: tokenfactory.NewQueryClient(grpcConn),
+
+		IBC: IBCQuerier{
+			Client:     ibcclient.NewQueryClient(grpcConn),
+			Connection: ibcconnection.NewQueryClient(grpcConn),
+			Channel:    ibcchannel.NewQueryClient(grpcConn),
+			Transfer:   ibctransfer.NewQueryClient(grpcConn),
+		},
 	}, nil
 }
 
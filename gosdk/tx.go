@@ -0,0 +1,242 @@
+package gosdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	cmtcoretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	sdkclient "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/client"
+	sdkclienttx "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/client/tx"
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	sdktypestx "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types/tx"
+	authtypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/auth/types"
+
+	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/x/nutil"
+)
+
+const (
+	// DefaultGasAdjustment is the multiplier applied to the simulated gas of a
+	// tx when [TxOptions.GasLimit] is not set.
+	DefaultGasAdjustment = 1.5
+	// DefaultMaxSeqRetries is the number of times a tx is re-signed and
+	// broadcast after an account sequence mismatch.
+	DefaultMaxSeqRetries = 2
+	// txPollInterval is how often [NibiruSDK.WaitForTx] queries for a tx.
+	txPollInterval = 500 * time.Millisecond
+)
+
+// TxOptions configures how [NibiruSDK.BroadcastMsgsWithOpts] builds and
+// broadcasts a tx. The zero value simulates the tx to estimate gas, pays the
+// global minimum gas price, and returns without waiting for inclusion.
+type TxOptions struct {
+	// GasLimit is the gas limit of the tx. If zero, it is estimated by
+	// simulating the tx and multiplying the gas used by GasAdjustment.
+	GasLimit uint64
+	// GasAdjustment defaults to [DefaultGasAdjustment].
+	GasAdjustment float64
+	// GasPrice is the price paid per unit of gas. If unset, it is the global
+	// minimum gas price. See [NibiruSDK.GlobalMinGasPrice].
+	GasPrice sdk.DecCoin
+	// Fees sets the fees of the tx directly instead of deriving them from the
+	// gas price.
+	Fees sdk.Coins
+	Memo string
+	// WaitTimeout, if positive, makes the broadcast wait up to that long for
+	// the tx to be included in a block.
+	WaitTimeout time.Duration
+	// MaxSeqRetries defaults to [DefaultMaxSeqRetries]. Set it to a negative
+	// value to disable retries.
+	MaxSeqRetries int
+}
+
+// CalcFee returns the fee for a gas limit at the given gas price, rounded up
+// the same way as the minimum gas price check of the ante handler:
+// fee = ceil(gasPrice * gasLimit).
+func CalcFee(gasLimit uint64, gasPrice sdk.DecCoin) sdk.Coins {
+	fee := gasPrice.Amount.Mul(sdkmath.LegacyNewDec(int64(gasLimit)))
+	return sdk.NewCoins(sdk.NewCoin(gasPrice.Denom, fee.Ceil().RoundInt()))
+}
+
+// GlobalMinGasPrice returns the minimum gas price for all nodes in "unibi",
+// which is the base fee of the EVM module. Validators may require a higher
+// gas price for Cosmos txs with their own "minimum-gas-prices".
+func (nc *NibiruSDK) GlobalMinGasPrice() (sdk.DecCoin, error) {
+	resp, err := nc.Querier.EVM.BaseFee(context.Background(), &evm.QueryBaseFeeRequest{})
+	if err != nil {
+		return sdk.DecCoin{}, fmt.Errorf("failed to query the base fee: %w", err)
+	}
+	baseFee := sdkmath.NewIntFromBigInt(evm.BASE_FEE_MICRONIBI)
+	if resp.BaseFeeUnibi != nil {
+		baseFee = *resp.BaseFeeUnibi
+	}
+	return sdk.NewDecCoin(appconst.DENOM_UNIBI, baseFee), nil
+}
+
+// Simulate executes msgs as a tx signed by "from" without committing it.
+// The key of "from" must be in the keyring so that the simulation uses its
+// public key type.
+func (nc *NibiruSDK) Simulate(
+	from sdk.AccAddress, msgs ...sdk.Msg,
+) (*sdktypestx.SimulateResponse, error) {
+	nums, err := nc.GetAccountNumbers(from.String())
+	if err != nil {
+		return nil, err
+	}
+	txf, err := nc.txFactory(from, nums.Number, nums.Sequence)
+	if err != nil {
+		return nil, err
+	}
+	simResp, _, err := sdkclienttx.CalculateGas(nc.Querier.ClientConn, txf, msgs...)
+	return simResp, err
+}
+
+// EstimateGas returns the gas used by msgs in a simulation multiplied by the
+// gas adjustment. A non-positive gasAdjustment means [DefaultGasAdjustment].
+func (nc *NibiruSDK) EstimateGas(
+	from sdk.AccAddress, gasAdjustment float64, msgs ...sdk.Msg,
+) (uint64, error) {
+	simResp, err := nc.Simulate(from, msgs...)
+	if err != nil {
+		return 0, err
+	}
+	if gasAdjustment <= 0 {
+		gasAdjustment = DefaultGasAdjustment
+	}
+	return uint64(gasAdjustment * float64(simResp.GasInfo.GasUsed)), nil
+}
+
+// WaitForTx polls for a tx until it is included in a block or the timeout
+// elapses.
+func (nc *NibiruSDK) WaitForTx(
+	txHashHex string, timeout time.Duration,
+) (*cmtcoretypes.ResultTx, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		resTx, err := nc.TxByHash(txHashHex)
+		if err == nil {
+			return resTx, nil
+		}
+		if time.Now().Add(txPollInterval).After(deadline) {
+			return nil, fmt.Errorf("tx %s was not included in a block after %s: %w", txHashHex, timeout, err)
+		}
+		time.Sleep(txPollInterval)
+	}
+}
+
+// BroadcastMsgsWithOpts signs msgs with the key of "from" and broadcasts them
+// in a tx configured by opts. If the node rejects the tx because of an
+// account sequence mismatch, for example because another tx of the same
+// account was broadcast concurrently, the tx is signed again with the
+// expected sequence.
+func (nc *NibiruSDK) BroadcastMsgsWithOpts(
+	from sdk.AccAddress, opts TxOptions, msgs ...sdk.Msg,
+) (*sdk.TxResponse, error) {
+	nums, err := nc.GetAccountNumbers(from.String())
+	if err != nil {
+		return nil, err
+	}
+	txf, err := nc.txFactory(from, nums.Number, nums.Sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := opts.GasLimit
+	if gasLimit == 0 {
+		if opts.GasAdjustment > 0 {
+			txf = txf.WithGasAdjustment(opts.GasAdjustment)
+		}
+		if _, gasLimit, err = sdkclienttx.CalculateGas(nc.Querier.ClientConn, txf, msgs...); err != nil {
+			return nil, fmt.Errorf("failed to simulate tx: %w", err)
+		}
+	}
+
+	fees := opts.Fees
+	if fees == nil {
+		gasPrice := opts.GasPrice
+		if gasPrice.Denom == "" {
+			if gasPrice, err = nc.GlobalMinGasPrice(); err != nil {
+				return nil, err
+			}
+		}
+		fees = CalcFee(gasLimit, gasPrice)
+	}
+
+	maxRetries := opts.MaxSeqRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultMaxSeqRetries
+	}
+	broadcaster := BroadcasterTmRpc{RPC: nc.CometRPC}
+
+	var txResp *sdk.TxResponse
+	for attempt := 0; ; attempt++ {
+		txBuilder := nc.EncCfg.TxConfig.NewTxBuilder()
+		if err := txBuilder.SetMsgs(msgs...); err != nil {
+			return nil, err
+		}
+		txBuilder.SetFeeAmount(fees)
+		txBuilder.SetGasLimit(gasLimit)
+		txBuilder.SetMemo(opts.Memo)
+
+		overwriteSig := true
+		if err := sdkclienttx.Sign(txf, txf.FromName(), txBuilder, overwriteSig); err != nil {
+			return nil, err
+		}
+		txBytes, err := nc.EncCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return nil, err
+		}
+		if txResp, err = broadcaster.BroadcastTxSync(txBytes); err != nil {
+			return nil, err
+		}
+		if txResp.Code == 0 {
+			break
+		}
+
+		expectedSeq, _, ok := nutil.ParseAccountSequenceMismatch(txResp.RawLog)
+		if !ok || attempt >= maxRetries {
+			return txResp, nil
+		}
+		txf = txf.WithSequence(expectedSeq)
+	}
+
+	if opts.WaitTimeout <= 0 {
+		return txResp, nil
+	}
+	resTx, err := nc.WaitForTx(txResp.TxHash, opts.WaitTimeout)
+	if err != nil {
+		return txResp, err
+	}
+	return sdk.NewResponseResultTx(resTx, nil, ""), nil
+}
+
+// txFactory returns a tx factory that signs with the key of "from" in the
+// keyring.
+func (nc *NibiruSDK) txFactory(
+	from sdk.AccAddress, accNum, seq uint64,
+) (sdkclienttx.Factory, error) {
+	if nc.Keyring == nil {
+		return sdkclienttx.Factory{}, errors.New("the NibiruSDK has no keyring to sign with")
+	}
+	info, err := nc.Keyring.KeyByAddress(from)
+	if err != nil {
+		return sdkclienttx.Factory{}, err
+	}
+
+	var accRetriever sdkclient.AccountRetriever = authtypes.AccountRetriever{}
+	return sdkclienttx.Factory{}.
+		WithChainID(nc.ChainId).
+		WithKeybase(nc.Keyring).
+		WithFromName(info.Name).
+		WithTxConfig(nc.EncCfg.TxConfig).
+		WithAccountRetriever(accRetriever).
+		WithAccountNumber(accNum).
+		WithSequence(seq).
+		WithGasAdjustment(DefaultGasAdjustment).
+		WithSimulateAndExecute(true), nil
+}
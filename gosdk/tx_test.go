@@ -0,0 +1,33 @@
+package gosdk_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	"github.com/NibiruChain/nibiru/v2/gosdk"
+)
+
+func TestCalcFee(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		gasLimit uint64
+		gasPrice string
+		wantFee  string
+	}{
+		{name: "whole gas price", gasLimit: 200_000, gasPrice: "1", wantFee: "200000"},
+		{name: "fractional gas price", gasLimit: 200_000, gasPrice: "0.025", wantFee: "5000"},
+		{name: "rounds up", gasLimit: 3, gasPrice: "0.5", wantFee: "2"},
+		{name: "zero gas price", gasLimit: 200_000, gasPrice: "0", wantFee: "0"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			gasPrice := sdk.NewDecCoinFromDec(appconst.DENOM_UNIBI, sdkmath.LegacyMustNewDecFromStr(tc.gasPrice))
+			fee := gosdk.CalcFee(tc.gasLimit, gasPrice)
+			require.Equal(t, tc.wantFee, fee.AmountOf(appconst.DENOM_UNIBI).String())
+		})
+	}
+}
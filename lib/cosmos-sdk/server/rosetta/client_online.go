@@ -32,6 +32,7 @@ import (
 	bank "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/bank/types"
 
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types/query"
 )
@@ -88,6 +89,9 @@ func NewClient(cfg *Config) (*Client, error) {
 		bank.EventTypeCoinReceived,
 		bank.EventTypeCoinBurn,
 	)
+	if cfg.Extension != nil {
+		supportedOperations = append(supportedOperations, cfg.Extension.SupportedOperations()...)
+	}
 
 	return &Client{
 		supportedOperations: supportedOperations,
@@ -96,7 +100,7 @@ func NewClient(cfg *Config) (*Client, error) {
 		bank:                nil,
 		tmRPC:               nil,
 		version:             fmt.Sprintf("%s/%s", info.AppName, v),
-		converter:           NewConverter(cfg.Codec, cfg.InterfaceRegistry, txConfig),
+		converter:           newConverter(cfg.Codec, cfg.InterfaceRegistry, txConfig, cfg.Extension),
 	}, nil
 }
 
@@ -121,6 +125,9 @@ func (c *Client) Bootstrap() error {
 	c.bank = bankClient
 	c.tmRPC = tmRPC
 
+	if c.config.Extension != nil {
+		return c.config.Extension.Bootstrap(grpcConn, tmRPC)
+	}
 	return nil
 }
 
@@ -183,7 +190,11 @@ func (c *Client) Balances(ctx context.Context, addr string, height *int64) ([]*r
 		return nil, err
 	}
 
-	return c.converter.ToRosetta().Amounts(balance.Balances, availableCoins), nil
+	amounts := c.converter.ToRosetta().Amounts(balance.Balances, availableCoins)
+	if c.config.Extension != nil {
+		return c.config.Extension.Balances(ctx, addr, height, amounts)
+	}
+	return amounts, nil
 }
 
 func (c *Client) BlockByHash(ctx context.Context, hash string) (crgtypes.BlockResponse, error) {
@@ -314,7 +325,7 @@ func (c *Client) GetTx(ctx context.Context, hash string) (*rosettatypes.Transact
 		if err != nil {
 			return nil, crgerrs.WrapError(crgerrs.ErrUnknown, err.Error())
 		}
-		return c.converter.ToRosetta().Tx(rawTx.Tx, &rawTx.TxResult)
+		return c.tx(rawTx.Tx, &rawTx.TxResult)
 	// handle end block hash
 	case EndBlockTx:
 		// get block height by hash
@@ -365,7 +376,7 @@ func (c *Client) GetUnconfirmedTx(ctx context.Context, hash string) (*rosettatyp
 			continue
 		}
 
-		return c.converter.ToRosetta().Tx(unconfirmedTx, nil)
+		return c.tx(unconfirmedTx, nil)
 	}
 	return nil, crgerrs.WrapError(crgerrs.ErrNotFound, "transaction not found in mempool: "+hash)
 }
@@ -530,12 +541,34 @@ func (c *Client) blockTxs(ctx context.Context, height *int64) (crgtypes.BlockTra
 	finalTxs = append(finalTxs, deliverTx...)
 	finalTxs = append(finalTxs, endBlockTx)
 
+	if ext := c.config.Extension; ext != nil {
+		for _, tx := range finalTxs {
+			ext.Transaction(tx)
+		}
+		if err := ext.BlockTransactions(ctx, blockInfo, blockResults, finalTxs); err != nil {
+			return crgtypes.BlockTransactionsResponse{}, crgerrs.WrapError(crgerrs.ErrInternal, err.Error())
+		}
+	}
+
 	return crgtypes.BlockTransactionsResponse{
 		BlockResponse: c.converter.ToRosetta().BlockResponse(blockInfo),
 		Transactions:  finalTxs,
 	}, nil
 }
 
+// tx converts a tendermint transaction to a rosetta transaction, applying the
+// extension if there is one.
+func (c *Client) tx(rawTx cmttypes.Tx, txResult *abcitypes.ResponseDeliverTx) (*rosettatypes.Transaction, error) {
+	rosTx, err := c.converter.ToRosetta().Tx(rawTx, txResult)
+	if err != nil {
+		return nil, err
+	}
+	if c.config.Extension != nil {
+		c.config.Extension.Transaction(rosTx)
+	}
+	return rosTx, nil
+}
+
 func (c *Client) getHeight(ctx context.Context, height *int64) (realHeight *int64, err error) {
 	if height != nil && *height == -1 {
 		genesisChunk, err := c.tmRPC.GenesisChunked(ctx, 0)
@@ -89,6 +89,9 @@ type Config struct {
 	Codec *codec.ProtoCodec
 	// InterfaceRegistry overrides the default data and construction api interface registry
 	InterfaceRegistry codectypes.InterfaceRegistry
+	// Extension optionally customizes the data and construction api for
+	// application specific state and key types
+	Extension Extension
 }
 
 // NetworkIdentifier returns the network identifier given the configuration
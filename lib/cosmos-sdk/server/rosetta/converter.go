@@ -105,24 +105,25 @@ type converter struct {
 	bytesToSign     func(tx authsigning.Tx, signerData authsigning.SignerData) (b []byte, err error)
 	ir              codectypes.InterfaceRegistry
 	cdc             *codec.ProtoCodec
+	ext             Extension
 }
 
 func NewConverter(cdc *codec.ProtoCodec, ir codectypes.InterfaceRegistry, cfg sdkclient.TxConfig) Converter {
+	return newConverter(cdc, ir, cfg, nil)
+}
+
+func newConverter(cdc *codec.ProtoCodec, ir codectypes.InterfaceRegistry, cfg sdkclient.TxConfig, ext Extension) converter {
 	return converter{
 		newTxBuilder:    cfg.NewTxBuilder,
 		txBuilderFromTx: cfg.WrapTxBuilder,
 		txDecode:        cfg.TxDecoder(),
 		txEncode:        cfg.TxEncoder(),
 		bytesToSign: func(tx authsigning.Tx, signerData authsigning.SignerData) (b []byte, err error) {
-			bytesToSign, err := cfg.SignModeHandler().GetSignBytes(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, tx)
-			if err != nil {
-				return nil, err
-			}
-
-			return crypto.Sha256(bytesToSign), nil
+			return cfg.SignModeHandler().GetSignBytes(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, tx)
 		},
 		ir:  ir,
 		cdc: cdc,
+		ext: ext,
 	}
 }
 
@@ -643,7 +644,44 @@ func (c converter) SignedTx(txBytes []byte, signatures []*rosettatypes.Signature
 	return txBytes, nil
 }
 
+// PubKey converts a rosetta public key to the default account key type of
+// the application, which is secp256k1 unless an [Extension] overrides it.
 func (c converter) PubKey(pubKey *rosettatypes.PublicKey) (cryptotypes.PubKey, error) {
+	if c.ext != nil {
+		pk, err := c.ext.PubKey(pubKey)
+		if err != nil || pk != nil {
+			return pk, err
+		}
+	}
+	return secp256k1PubKey(pubKey)
+}
+
+// signerPubKey converts the rosetta public key of a signer, trying the
+// secp256k1 key type if the default key type of the application does not
+// match the signer address.
+func (c converter) signerPubKey(pubKey *rosettatypes.PublicKey, signer sdk.AccAddress) (cryptotypes.PubKey, error) {
+	pk, err := c.PubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if c.ext == nil || bytes.Equal(pk.Address().Bytes(), signer.Bytes()) {
+		return pk, nil
+	}
+	return secp256k1PubKey(pubKey)
+}
+
+// signDigest returns the digest of the sign bytes that the signature of
+// pubKey commits to.
+func (c converter) signDigest(pubKey cryptotypes.PubKey, signBytes []byte) []byte {
+	if c.ext != nil {
+		if digest := c.ext.SignDigest(pubKey, signBytes); digest != nil {
+			return digest
+		}
+	}
+	return crypto.Sha256(signBytes)
+}
+
+func secp256k1PubKey(pubKey *rosettatypes.PublicKey) (cryptotypes.PubKey, error) {
 	if pubKey.CurveType != "secp256k1" {
 		return nil, crgerrs.WrapError(crgerrs.ErrUnsupportedCurve, "only secp256k1 supported")
 	}
@@ -693,7 +731,7 @@ func (c converter) SigningComponents(tx authsigning.Tx, metadata *ConstructionMe
 	for i, signer := range signers {
 		// assert that the provided public keys are correctly ordered
 		// by checking if the signer at index i matches the pubkey at index
-		pubKey, err := c.ToSDK().PubKey(rosPubKeys[0])
+		pubKey, err := c.signerPubKey(rosPubKeys[i], signer)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -722,7 +760,7 @@ func (c converter) SigningComponents(tx authsigning.Tx, metadata *ConstructionMe
 		// set payload
 		payloadsToSign[i] = &rosettatypes.SigningPayload{
 			AccountIdentifier: &rosettatypes.AccountIdentifier{Address: signer.String()},
-			Bytes:             signBytes,
+			Bytes:             c.signDigest(pubKey, signBytes),
 			SignatureType:     rosettatypes.Ecdsa,
 		}
 
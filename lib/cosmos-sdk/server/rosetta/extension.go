@@ -0,0 +1,53 @@
+package rosetta
+
+import (
+	"context"
+
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
+	tmcoretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"google.golang.org/grpc"
+
+	cryptotypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/crypto/types"
+)
+
+// Extension lets an application customize the rosetta Data and Construction
+// APIs for state that the x/bank events do not describe, such as balances
+// moved by a virtual machine, or for account key types other than secp256k1.
+// All methods are optional in the sense that an implementation may return its
+// inputs unchanged.
+type Extension interface {
+	// Bootstrap is called once the client has connected to the node.
+	Bootstrap(grpcConn grpc.ClientConnInterface, tmRPC cmtrpcclient.Client) error
+
+	// SupportedOperations returns the operation types added by the extension.
+	SupportedOperations() []string
+
+	// Balances adjusts the x/bank balances of addr at the given height. A nil
+	// height means the latest block.
+	Balances(
+		ctx context.Context, addr string, height *int64, amounts []*rosettatypes.Amount,
+	) ([]*rosettatypes.Amount, error)
+
+	// Transaction adjusts the operations converted from the messages and
+	// events of a single transaction.
+	Transaction(tx *rosettatypes.Transaction)
+
+	// BlockTransactions adds operations that can only be derived from a whole
+	// block. The first and last transactions are the begin and end block
+	// pseudo transactions. Transaction has already been applied to all of them.
+	BlockTransactions(
+		ctx context.Context,
+		block *tmcoretypes.ResultBlock,
+		results *tmcoretypes.ResultBlockResults,
+		txs []*rosettatypes.Transaction,
+	) error
+
+	// PubKey converts a rosetta public key to the default account key type of
+	// the application. It returns nil to fall back to secp256k1.
+	PubKey(pk *rosettatypes.PublicKey) (cryptotypes.PubKey, error)
+
+	// SignDigest returns the digest of signBytes that a signature by pubKey
+	// commits to, or nil to fall back to sha256.
+	SignDigest(pubKey cryptotypes.PubKey, signBytes []byte) []byte
+}
@@ -87,10 +87,7 @@ func (a DevGasPayoutDecorator) devGasPayout(
 	)
 }
 
-type FeeSharePayoutEventOutput struct {
-	WithdrawAddress sdk.AccAddress `json:"withdraw_address"`
-	FeesPaid        sdk.Coins      `json:"fees_paid"`
-}
+type FeeSharePayoutEventOutput = devgastypes.FeeSharePayoutEventOutput
 
 // settleFeePayments sends the funds to the contract developers
 func (a DevGasPayoutDecorator) settleFeePayments(
@@ -33,14 +33,25 @@ func NewTxCmd() *cobra.Command {
 	return txCmd
 }
 
+const (
+	FlagEvmCreationNonce = "evm-creation-nonce"
+	FlagEvmCreate2Salt   = "evm-create2-salt"
+	FlagEvmInitCodeHash  = "evm-init-code-hash"
+	FlagEvmFactoryNonces = "evm-factory-nonces"
+)
+
 // CmdRegisterFeeShare returns a CLI command handler for registering a
 // contract for fee distribution
 func CmdRegisterFeeShare() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "register [contract_bech32] [withdraw_bech32]",
+		Use:   "register [contract_bech32_or_hex] [withdraw_bech32]",
 		Short: "Register a contract for fee distribution. Only the contract admin can register a contract.",
-		Long:  "Register a contract for feeshare distribution. **NOTE** Please ensure, that the admin of the contract (or the DAO/factory that deployed the contract) is an account that is owned by your project, to avoid that an individual admin who leaves your project becomes malicious.",
-		Args:  cobra.ExactArgs(2),
+		Long: "Register a contract for feeshare distribution. **NOTE** Please ensure, that the admin of the contract (or the DAO/factory that deployed the contract) is an account that is owned by your project, to avoid that an individual admin who leaves your project becomes malicious." +
+			"\nEVM contracts (0x...) can only be registered by the account that created them, proven with --" + FlagEvmCreationNonce +
+			" for CREATE or with --" + FlagEvmCreate2Salt + " and --" + FlagEvmInitCodeHash + " for CREATE2." +
+			" Contracts created by a factory are registered by the account that created the factory, with --" +
+			FlagEvmFactoryNonces + " giving the CREATE nonces from that account to the factory.",
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -57,6 +68,22 @@ func CmdRegisterFeeShare() *cobra.Command {
 				DeployerAddress:   deployer.String(),
 				WithdrawerAddress: withdrawer,
 			}
+			if msg.EvmCreationNonce, err = cmd.Flags().GetUint64(FlagEvmCreationNonce); err != nil {
+				return err
+			}
+			if msg.EvmCreate2Salt, err = cmd.Flags().GetString(FlagEvmCreate2Salt); err != nil {
+				return err
+			}
+			if msg.EvmInitCodeHash, err = cmd.Flags().GetString(FlagEvmInitCodeHash); err != nil {
+				return err
+			}
+			factoryNonces, err := cmd.Flags().GetUintSlice(FlagEvmFactoryNonces)
+			if err != nil {
+				return err
+			}
+			for _, nonce := range factoryNonces {
+				msg.EvmFactoryNonces = append(msg.EvmFactoryNonces, uint64(nonce))
+			}
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -66,6 +93,10 @@ func CmdRegisterFeeShare() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagEvmCreationNonce, 0, "EVM contracts created with CREATE: nonce of the deployer when it created the contract")
+	cmd.Flags().String(FlagEvmCreate2Salt, "", "EVM contracts created with CREATE2: hex-encoded 32-byte salt")
+	cmd.Flags().String(FlagEvmInitCodeHash, "", "EVM contracts created with CREATE2: hex-encoded keccak256 hash of the init code")
+	cmd.Flags().UintSlice(FlagEvmFactoryNonces, nil, "EVM contracts created by a factory: comma-separated CREATE nonces from the deployer to the factory")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+	"slices"
+
+	sdkmath "cosmossdk.io/math"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	sdkerrors "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types/errors"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/x/devgas/v1/types"
+)
+
+// GetEvmContractDeployer ensures the deployer of a [types.MsgRegisterFeeShare]
+// created the EVM contract, either directly or through factory contracts.
+//
+// The creator of the contract is the deployer, or, for contracts created by a
+// factory, the last factory of the chain that starts at the deployer and
+// follows the CREATE nonces of "EvmFactoryNonces". Each factory must be a
+// contract in state. The contract address is then either:
+//   - the CREATE address of the creator at "EvmCreationNonce", or
+//   - the CREATE2 address of the creator for "EvmCreate2Salt" and
+//     "EvmInitCodeHash", which requires a factory.
+func (k Keeper) GetEvmContractDeployer(
+	ctx sdk.Context, contract gethcommon.Address, msg *types.MsgRegisterFeeShare,
+) (sdk.AccAddress, error) {
+	deployer, err := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid deployer address %s", msg.DeployerAddress)
+	}
+
+	if k.evmKeeper == nil || !k.evmKeeper.IsContract(ctx, contract) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"EVM contract with address %s not found in state", contract.Hex(),
+		)
+	}
+
+	salt, initCodeHash, isCreate2, err := msg.EvmCreate2()
+	if err != nil {
+		return nil, err
+	}
+	creator := eth.NibiruAddrToEthAddr(deployer)
+	for _, factoryNonce := range msg.EvmFactoryNonces {
+		creator = crypto.CreateAddress(creator, factoryNonce)
+		if !k.evmKeeper.IsContract(ctx, creator) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf(
+				"factory contract %s at nonce %d not found in state", creator.Hex(), factoryNonce,
+			)
+		}
+	}
+	var created gethcommon.Address
+	if isCreate2 {
+		created = crypto.CreateAddress2(creator, salt, initCodeHash.Bytes())
+	} else {
+		created = crypto.CreateAddress(creator, msg.EvmCreationNonce)
+	}
+	if created != contract {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"you are not the deployer of this contract %s", contract.Hex(),
+		)
+	}
+	return deployer, nil
+}
+
+// PayoutEvmFeeShare pays the developer share of the gas fees settled by an
+// Ethereum tx, "feesWei", to the withdrawer registered for the EVM contract the
+// tx called. The fees are what the sender paid after the refund of leftover
+// gas, and they are paid out of the fee collector in the EVM bank denom, so
+// amounts below 1 unibi round down.
+func (k Keeper) PayoutEvmFeeShare(
+	ctx sdk.Context, contract gethcommon.Address, feesWei *big.Int,
+) error {
+	params := k.GetParams(ctx)
+	if !params.EnableFeeShare {
+		return nil
+	}
+	if len(params.AllowedDenoms) > 0 && !slices.Contains(params.AllowedDenoms, evm.EVMBankDenom) {
+		return nil
+	}
+
+	feeshare, found := k.GetFeeShare(ctx, eth.EthAddrToNibiruAddr(contract))
+	if !found {
+		return nil
+	}
+	withdrawer := feeshare.GetWithdrawerAddr()
+	if withdrawer.Empty() {
+		return nil
+	}
+
+	fees := sdkmath.NewIntFromBigInt(evm.WeiToNative(feesWei))
+	payoutAmount := params.DeveloperShares.MulInt(fees).RoundInt()
+	if !payoutAmount.IsPositive() {
+		return nil
+	}
+	payout := sdk.NewCoins(sdk.NewCoin(evm.EVMBankDenom, payoutAmount))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, k.feeCollectorName, withdrawer, payout,
+	); err != nil {
+		return types.ErrFeeSharePayment.Wrapf("failed to pay fees to contract developer: %s", err)
+	}
+
+	bz, err := json.Marshal([]types.FeeSharePayoutEventOutput{{
+		WithdrawAddress: withdrawer,
+		FeesPaid:        payout,
+	}})
+	if err != nil {
+		return types.ErrFeeSharePayment.Wrapf("failed to marshal feesPaidOutput: %s", err)
+	}
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventPayoutDevGas{Payouts: string(bz)},
+	)
+}
@@ -0,0 +1,259 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/testutil/testdata"
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	authtypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/auth/types"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/evm"
+	"github.com/NibiruChain/nibiru/v2/x/devgas/v1/types"
+	"github.com/NibiruChain/nibiru/v2/x/nutil/testutil"
+)
+
+// setEvmCode stores "code" as the bytecode of an EVM contract at "addr".
+func (s *KeeperTestSuite) setEvmCode(addr gethcommon.Address, code []byte) {
+	sdb := s.app.EvmKeeper.NewSDB(s.ctx, s.app.EvmKeeper.TxConfig(s.ctx, gethcommon.Hash{}))
+	sdb.SetCode(addr, code)
+	sdb.Commit()
+}
+
+func (s *KeeperTestSuite) TestEvmFeeShare() {
+	s.SetupTest()
+	_, _, deployer := testdata.KeyTestPubAddr()
+	_, _, withdrawer := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+	deployerEth := eth.NibiruAddrToEthAddr(deployer)
+
+	// Runtime code that returns nothing: PUSH0 PUSH0 RETURN
+	code := []byte{0x5f, 0x5f, 0xf3}
+	createdAddr := crypto.CreateAddress(deployerEth, 7)
+	s.setEvmCode(createdAddr, code)
+
+	// Contracts created by a factory that the deployer created.
+	factoryAddr := crypto.CreateAddress(deployerEth, 3)
+	s.setEvmCode(factoryAddr, code)
+	factoryCreatedAddr := crypto.CreateAddress(factoryAddr, 1)
+	s.setEvmCode(factoryCreatedAddr, code)
+
+	salt := gethcommon.HexToHash("0x01")
+	initCodeHash := crypto.Keccak256Hash([]byte("init code"))
+	create2Addr := crypto.CreateAddress2(factoryAddr, salt, initCodeHash.Bytes())
+	s.setEvmCode(create2Addr, code)
+
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	for _, tc := range []struct {
+		desc    string
+		msg     *types.MsgRegisterFeeShare
+		wantErr string
+	}{
+		{
+			desc: "wrong creation nonce",
+			msg: &types.MsgRegisterFeeShare{
+				ContractAddress:   createdAddr.Hex(),
+				DeployerAddress:   deployer.String(),
+				WithdrawerAddress: withdrawer.String(),
+				EvmCreationNonce:  6,
+			},
+			wantErr: "you are not the deployer",
+		},
+		{
+			desc: "not the deployer",
+			msg: &types.MsgRegisterFeeShare{
+				ContractAddress:   createdAddr.Hex(),
+				DeployerAddress:   other.String(),
+				WithdrawerAddress: withdrawer.String(),
+				EvmCreationNonce:  7,
+			},
+			wantErr: "you are not the deployer",
+		},
+		{
+			desc: "not a contract",
+			msg: &types.MsgRegisterFeeShare{
+				ContractAddress:   crypto.CreateAddress(deployerEth, 9).Hex(),
+				DeployerAddress:   deployer.String(),
+				WithdrawerAddress: withdrawer.String(),
+				EvmCreationNonce:  9,
+			},
+			wantErr: "not found in state",
+		},
+		{
+			desc: "success CREATE",
+			msg: &types.MsgRegisterFeeShare{
+				ContractAddress:   createdAddr.Hex(),
+				DeployerAddress:   deployer.String(),
+				WithdrawerAddress: withdrawer.String(),
+				EvmCreationNonce:  7,
+			},
+		},
+		{
+			desc: "already registered",
+			msg: &types.MsgRegisterFeeShare{
+				ContractAddress:   createdAddr.Hex(),
+				DeployerAddress:   deployer.String(),
+				WithdrawerAddress: withdrawer.String(),
+				EvmCreationNonce:  7,
+			},
+			wantErr: "already registered",
+		},
+		{
+			desc: "wrong init code hash",
+			msg: &types.MsgRegisterFeeShare{
+				ContractAddress:   create2Addr.Hex(),
+				DeployerAddress:   deployer.String(),
+				WithdrawerAddress: withdrawer.String(),
+				EvmCreate2Salt:    salt.Hex(),
+				EvmInitCodeHash:   salt.Hex(),
+				EvmFactoryNonces:  []uint64{3},
+			},
+			wantErr: "you are not the deployer",
+		},
+		{
+			desc: "factory not in state",
+			msg: &types.MsgRegisterFeeShare{
+				ContractAddress:   create2Addr.Hex(),
+				DeployerAddress:   deployer.String(),
+				WithdrawerAddress: withdrawer.String(),
+				EvmCreate2Salt:    salt.Hex(),
+				EvmInitCodeHash:   initCodeHash.Hex(),
+				EvmFactoryNonces:  []uint64{4},
+			},
+			wantErr: "factory contract",
+		},
+		{
+			desc: "success CREATE2",
+			msg: &types.MsgRegisterFeeShare{
+				ContractAddress:   create2Addr.Hex(),
+				DeployerAddress:   deployer.String(),
+				WithdrawerAddress: withdrawer.String(),
+				EvmCreate2Salt:    salt.Hex(),
+				EvmInitCodeHash:   initCodeHash.Hex(),
+				EvmFactoryNonces:  []uint64{3},
+			},
+		},
+		{
+			desc: "factory CREATE, not the factory deployer",
+			msg: &types.MsgRegisterFeeShare{
+				ContractAddress:   factoryCreatedAddr.Hex(),
+				DeployerAddress:   other.String(),
+				WithdrawerAddress: withdrawer.String(),
+				EvmCreationNonce:  1,
+				EvmFactoryNonces:  []uint64{3},
+			},
+			wantErr: "factory contract",
+		},
+		{
+			desc: "success factory CREATE",
+			msg: &types.MsgRegisterFeeShare{
+				ContractAddress:   factoryCreatedAddr.Hex(),
+				DeployerAddress:   deployer.String(),
+				WithdrawerAddress: withdrawer.String(),
+				EvmCreationNonce:  1,
+				EvmFactoryNonces:  []uint64{3},
+			},
+		},
+	} {
+		s.Run(tc.desc, func() {
+			resp, err := s.devgasMsgServer.RegisterFeeShare(goCtx, tc.msg)
+			if tc.wantErr != "" {
+				s.Require().ErrorContains(err, tc.wantErr)
+				s.Require().Nil(resp)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	wantFeeShare := types.FeeShare{
+		ContractAddress:   eth.EthAddrToNibiruAddr(createdAddr).String(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+
+	s.Run("query by EVM address", func() {
+		resp, err := s.queryClient.FeeShare(goCtx, &types.QueryFeeShareRequest{
+			ContractAddress: createdAddr.Hex(),
+		})
+		s.Require().NoError(err)
+		s.Equal(wantFeeShare, resp.Feeshare)
+
+		sharesResp, err := s.queryClient.FeeShares(goCtx, &types.QueryFeeSharesRequest{
+			Deployer: deployerEth.Hex(),
+		})
+		s.Require().NoError(err)
+		s.Len(sharesResp.Feeshare, 2)
+
+		withdrawerResp, err := s.queryClient.FeeSharesByWithdrawer(goCtx, &types.QueryFeeSharesByWithdrawerRequest{
+			WithdrawerAddress: eth.NibiruAddrToEthAddr(withdrawer).Hex(),
+		})
+		s.Require().NoError(err)
+		s.Len(withdrawerResp.Feeshare, 2)
+	})
+
+	s.Run("payout", func() {
+		feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		s.Require().NoError(s.FundAccount(s.ctx, feeCollector,
+			sdk.NewCoins(sdk.NewCoin(evm.EVMBankDenom, sdkmath.NewInt(1_000)))))
+
+		params := s.app.DevGasKeeper.GetParams(s.ctx)
+		feesUnibi := sdkmath.NewInt(100)
+		feesWei := evm.NativeToWei(feesUnibi.BigInt())
+		feesWei.Add(feesWei, big.NewInt(1)) // sub-unibi remainder rounds down
+
+		s.Require().NoError(s.app.DevGasKeeper.PayoutEvmFeeShare(s.ctx, createdAddr, feesWei))
+		wantPayout := sdk.NewCoin(evm.EVMBankDenom, params.DeveloperShares.MulInt(feesUnibi).RoundInt())
+		s.Equal(wantPayout, s.app.BankKeeper.GetBalance(s.ctx, withdrawer, evm.EVMBankDenom))
+
+		payoutsJSON, err := json.Marshal([]types.FeeSharePayoutEventOutput{{
+			WithdrawAddress: withdrawer,
+			FeesPaid:        sdk.NewCoins(wantPayout),
+		}})
+		s.Require().NoError(err)
+		testutil.RequireContainsTypedEvent(s.T(), s.ctx,
+			&types.EventPayoutDevGas{Payouts: string(payoutsJSON)},
+		)
+
+		// Unregistered contracts pay nothing.
+		s.Require().NoError(s.app.DevGasKeeper.PayoutEvmFeeShare(s.ctx, delegatedAddr, feesWei))
+		s.Equal(wantPayout, s.app.BankKeeper.GetBalance(s.ctx, withdrawer, evm.EVMBankDenom))
+	})
+
+	s.Run("update and cancel by deployer", func() {
+		_, err := s.devgasMsgServer.UpdateFeeShare(goCtx, &types.MsgUpdateFeeShare{
+			ContractAddress:   createdAddr.Hex(),
+			DeployerAddress:   other.String(),
+			WithdrawerAddress: other.String(),
+		})
+		s.Require().ErrorContains(err, "you are not the deployer")
+
+		_, err = s.devgasMsgServer.UpdateFeeShare(goCtx, &types.MsgUpdateFeeShare{
+			ContractAddress:   createdAddr.Hex(),
+			DeployerAddress:   deployer.String(),
+			WithdrawerAddress: other.String(),
+		})
+		s.Require().NoError(err)
+		feeShare, found := s.app.DevGasKeeper.GetFeeShare(s.ctx, eth.EthAddrToNibiruAddr(createdAddr))
+		s.Require().True(found)
+		s.Equal(other.String(), feeShare.WithdrawerAddress)
+
+		_, err = s.devgasMsgServer.CancelFeeShare(goCtx, &types.MsgCancelFeeShare{
+			ContractAddress: createdAddr.Hex(),
+			DeployerAddress: other.String(),
+		})
+		s.Require().ErrorContains(err, "you are not the deployer")
+
+		_, err = s.devgasMsgServer.CancelFeeShare(goCtx, &types.MsgCancelFeeShare{
+			ContractAddress: createdAddr.Hex(),
+			DeployerAddress: deployer.String(),
+		})
+		s.Require().NoError(err)
+		s.False(s.app.DevGasKeeper.IsFeeShareRegistered(s.ctx, eth.EthAddrToNibiruAddr(createdAddr)))
+	})
+}
@@ -35,7 +35,7 @@ func (q Querier) FeeShares(
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	iter := q.DevGasStore.Indexes.Deployer.ExactMatch(ctx, req.Deployer)
+	iter := q.DevGasStore.Indexes.Deployer.ExactMatch(ctx, bech32Addr(req.Deployer))
 	return &types.QueryFeeSharesResponse{
 		Feeshare: q.DevGasStore.Collect(ctx, iter),
 	}, nil
@@ -53,12 +53,12 @@ func (q Querier) FeeShare(
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// check if the contract is a non-zero hex address
-	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	// check if the contract is a valid bech32 or hex address
+	contract, err := types.ParseAddress(req.ContractAddress)
 	if err != nil {
 		return nil, status.Errorf(
 			codes.InvalidArgument,
-			"invalid format for contract %s, should be bech32 ('nibi...')", req.ContractAddress,
+			"invalid format for contract %s, should be bech32 ('nibi...') or hex ('0x...')", req.ContractAddress,
 		)
 	}
 
@@ -93,8 +93,18 @@ func (q Querier) FeeSharesByWithdrawer( // nolint: dupl
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	iter := q.DevGasStore.Indexes.Withdrawer.ExactMatch(ctx, req.WithdrawerAddress)
+	iter := q.DevGasStore.Indexes.Withdrawer.ExactMatch(ctx, bech32Addr(req.WithdrawerAddress))
 	return &types.QueryFeeSharesByWithdrawerResponse{
 		Feeshare: q.DevGasStore.Collect(ctx, iter),
 	}, nil
 }
+
+// bech32Addr converts an EVM address ("0x...") to the bech32 format that
+// indexes the FeeShares. Other addresses are returned unchanged.
+func bech32Addr(addr string) string {
+	if !types.IsEvmAddress(addr) {
+		return addr
+	}
+	nibiAddr, _ := types.ParseAddress(addr)
+	return nibiAddr.String()
+}
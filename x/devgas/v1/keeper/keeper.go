@@ -24,6 +24,7 @@ type Keeper struct {
 
 	bankKeeper    devgastypes.BankKeeper
 	wasmKeeper    wasmkeeper.Keeper
+	evmKeeper     devgastypes.EvmKeeper
 	accountKeeper devgastypes.AccountKeeper
 
 	// feeCollectorName is the name of x/auth module's fee collector module
@@ -54,6 +55,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	bk devgastypes.BankKeeper,
 	wk wasmkeeper.Keeper,
+	ek devgastypes.EvmKeeper,
 	ak devgastypes.AccountKeeper,
 	feeCollector string,
 	authority string,
@@ -63,6 +65,7 @@ func NewKeeper(
 		cdc:              cdc,
 		bankKeeper:       bk,
 		wasmKeeper:       wk,
+		evmKeeper:        ek,
 		accountKeeper:    ak,
 		feeCollectorName: feeCollector,
 		authority:        authority,
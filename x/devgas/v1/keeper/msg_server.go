@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
 	wasmTypes "github.com/NibiruChain/nibiru/v2/x/wasm/types"
 
 	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
@@ -105,6 +107,40 @@ func (k Keeper) GetContractAdminOrCreatorAddress(
 	return contractAdmin, err
 }
 
+// authorizeDeployer ensures the deployer controls the FeeShare of a contract.
+// For Wasm contracts, the deployer is the contract's admin, or creator if no
+// admin is set. For EVM contracts, the deployer is the one proven when the
+// FeeShare was registered.
+func (k Keeper) authorizeDeployer(
+	ctx sdk.Context, contract sdk.AccAddress, feeshare types.FeeShare, deployer string,
+) error {
+	if k.wasmKeeper.HasContractInfo(ctx, contract) {
+		_, err := k.GetContractAdminOrCreatorAddress(ctx, contract, deployer)
+		return err
+	}
+	if deployer != feeshare.DeployerAddress {
+		return sdkerrors.ErrUnauthorized.Wrapf(
+			"you are not the deployer of this contract %s", deployer,
+		)
+	}
+	return nil
+}
+
+// IsContractOwner returns true if owner controls contract. For Wasm contracts,
+// the owner is the contract's admin, or creator if no admin is set. For EVM
+// contracts, the owner is the deployer proven when the contract's FeeShare was
+// registered.
+func (k Keeper) IsContractOwner(
+	ctx sdk.Context, contract, owner sdk.AccAddress,
+) bool {
+	if k.wasmKeeper.HasContractInfo(ctx, contract) {
+		_, err := k.GetContractAdminOrCreatorAddress(ctx, contract, owner.String())
+		return err == nil
+	}
+	feeshare, found := k.GetFeeShare(ctx, contract)
+	return found && feeshare.DeployerAddress == owner.String()
+}
+
 // RegisterFeeShare registers a contract to receive transaction fees
 func (k Keeper) RegisterFeeShare(
 	goCtx context.Context,
@@ -118,7 +154,7 @@ func (k Keeper) RegisterFeeShare(
 	}
 
 	// Get Contract
-	contract, err := sdk.AccAddressFromBech32(msg.ContractAddress)
+	contract, err := types.ParseAddress(msg.ContractAddress)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address (%s)", err)
 	}
@@ -142,7 +178,13 @@ func (k Keeper) RegisterFeeShare(
 
 	var deployer sdk.AccAddress
 
-	if k.isContractCreatedFromFactory(ctx, k.wasmKeeper.GetContractInfo(ctx, contract), msgSender) {
+	if types.IsEvmAddress(msg.ContractAddress) {
+		// Check that the person who signed the message created the EVM contract
+		deployer, err = k.GetEvmContractDeployer(ctx, gethcommon.BytesToAddress(contract), msg)
+		if err != nil {
+			return nil, err
+		}
+	} else if k.isContractCreatedFromFactory(ctx, k.wasmKeeper.GetContractInfo(ctx, contract), msgSender) {
 		// Anyone is allowed to register the dev gas withdrawer for a smart
 		// contract to be the contract itself, so long as the contract was
 		// created from the "factory" (gov module or if contract admin or creator is another contract)
@@ -202,7 +244,7 @@ func (k Keeper) UpdateFeeShare(
 		return nil, types.ErrFeeShareDisabled
 	}
 
-	contract, err := sdk.AccAddressFromBech32(msg.ContractAddress)
+	contract, err := types.ParseAddress(msg.ContractAddress)
 	if err != nil {
 		return nil,
 			sdkerrors.ErrInvalidAddress.Wrapf(
@@ -225,9 +267,9 @@ func (k Keeper) UpdateFeeShare(
 		)
 	}
 
-	// Check that the person who signed the message is the wasm contract admin, if so return the deployer address
-	_, err = k.GetContractAdminOrCreatorAddress(ctx, contract, msg.DeployerAddress)
-	if err != nil {
+	// Check that the person who signed the message is the contract admin or
+	// deployer
+	if err := k.authorizeDeployer(ctx, contract, feeshare, msg.DeployerAddress); err != nil {
 		return nil, err
 	}
 
@@ -263,7 +305,7 @@ func (k Keeper) CancelFeeShare(
 		return nil, types.ErrFeeShareDisabled
 	}
 
-	contract, err := sdk.AccAddressFromBech32(msg.ContractAddress)
+	contract, err := types.ParseAddress(msg.ContractAddress)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid contract address (%s)", err)
 	}
@@ -275,9 +317,9 @@ func (k Keeper) CancelFeeShare(
 		)
 	}
 
-	// Check that the person who signed the message is the wasm contract admin, if so return the deployer address
-	_, err = k.GetContractAdminOrCreatorAddress(ctx, contract, msg.DeployerAddress)
-	if err != nil {
+	// Check that the person who signed the message is the contract admin or
+	// deployer
+	if err := k.authorizeDeployer(ctx, contract, fee, msg.DeployerAddress); err != nil {
 		return nil, err
 	}
 
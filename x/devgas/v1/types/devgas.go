@@ -2,9 +2,12 @@ package types
 
 import (
 	sdkioerrors "cosmossdk.io/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
 	sdkerrors "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types/errors"
+
+	"github.com/NibiruChain/nibiru/v2/eth"
 )
 
 // NewFeeShare returns an instance of FeeShare.
@@ -16,6 +19,28 @@ func NewFeeShare(contract sdk.Address, deployer, withdrawer sdk.AccAddress) FeeS
 	}
 }
 
+// ParseAddress parses an address in bech32 format ("nibi...") or in hex format
+// ("0x..."), which is how EVM contracts and accounts are addressed. Both forms
+// refer to the same account.
+func ParseAddress(addr string) (sdk.AccAddress, error) {
+	if IsEvmAddress(addr) {
+		return eth.EthAddrToNibiruAddr(gethcommon.HexToAddress(addr)), nil
+	}
+	return sdk.AccAddressFromBech32(addr)
+}
+
+// IsEvmAddress returns true if the address is in hex format ("0x...").
+func IsEvmAddress(addr string) bool {
+	return gethcommon.IsHexAddress(addr)
+}
+
+// FeeSharePayoutEventOutput is an entry of the "payouts" JSON array of an
+// [EventPayoutDevGas].
+type FeeSharePayoutEventOutput struct {
+	WithdrawAddress sdk.AccAddress `json:"withdraw_address"`
+	FeesPaid        sdk.Coins      `json:"fees_paid"`
+}
+
 // GetContractAddr returns the contract address
 func (fs FeeShare) GetContractAddr() sdk.Address {
 	contract, err := sdk.AccAddressFromBech32(fs.ContractAddress)
@@ -1,6 +1,8 @@
 package types
 
 import (
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
 	wasmtypes "github.com/NibiruChain/nibiru/v2/x/wasm/types"
 
 	// "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/client"
@@ -30,3 +32,9 @@ type BankKeeper interface {
 type WasmKeeper interface {
 	GetContractInfo(ctx sdk.Context, contractAddr sdk.AccAddress) (wasmtypes.ContractInfo, error)
 }
+
+// EvmKeeper defines the expected interface needed to verify EVM contracts.
+type EvmKeeper interface {
+	// IsContract returns true if the account at "addr" has contract bytecode.
+	IsContract(ctx sdk.Context, addr gethcommon.Address) bool
+}
@@ -1,9 +1,14 @@
 package types
 
 import (
+	"fmt"
+
 	sdkioerrors "cosmossdk.io/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	sdkerrors "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types/errors"
 )
 
 var (
@@ -48,7 +53,7 @@ func (msg MsgRegisterFeeShare) ValidateBasic() error {
 		return sdkioerrors.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
 	}
 
-	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+	if _, err := ParseAddress(msg.ContractAddress); err != nil {
 		return sdkioerrors.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
 	}
 
@@ -58,7 +63,60 @@ func (msg MsgRegisterFeeShare) ValidateBasic() error {
 		}
 	}
 
-	return nil
+	if !IsEvmAddress(msg.ContractAddress) {
+		if msg.EvmCreationNonce != 0 || msg.EvmCreate2Salt != "" || msg.EvmInitCodeHash != "" ||
+			len(msg.EvmFactoryNonces) > 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"EVM creation fields are only valid for EVM contracts: contract %s", msg.ContractAddress,
+			)
+		}
+		return nil
+	}
+	_, _, _, err := msg.EvmCreate2()
+	return err
+}
+
+// EvmCreate2 returns the salt and init code hash of an EVM contract created
+// with CREATE2. If "isCreate2" is false, the contract was created with CREATE
+// by its creator at nonce "EvmCreationNonce". CREATE2 runs from contract code,
+// so it requires the factory path of "EvmFactoryNonces".
+func (msg MsgRegisterFeeShare) EvmCreate2() (
+	salt, initCodeHash gethcommon.Hash, isCreate2 bool, err error,
+) {
+	if msg.EvmCreate2Salt == "" && msg.EvmInitCodeHash == "" {
+		return salt, initCodeHash, false, nil
+	}
+	if msg.EvmCreationNonce != 0 {
+		return salt, initCodeHash, false, sdkerrors.ErrInvalidRequest.Wrap(
+			"evm_creation_nonce must be empty for contracts created with CREATE2",
+		)
+	}
+	if len(msg.EvmFactoryNonces) == 0 {
+		return salt, initCodeHash, false, sdkerrors.ErrInvalidRequest.Wrap(
+			"contracts created with CREATE2 need the evm_factory_nonces of the factory that created them",
+		)
+	}
+	if salt, err = parseHash(msg.EvmCreate2Salt); err != nil {
+		return salt, initCodeHash, false, sdkioerrors.Wrapf(err, "invalid evm_create2_salt %q", msg.EvmCreate2Salt)
+	}
+	if initCodeHash, err = parseHash(msg.EvmInitCodeHash); err != nil {
+		return salt, initCodeHash, false, sdkioerrors.Wrapf(err, "invalid evm_init_code_hash %q", msg.EvmInitCodeHash)
+	}
+	return salt, initCodeHash, true, nil
+}
+
+// parseHash parses a hex-encoded ("0x...") 32-byte hash.
+func parseHash(hex string) (hash gethcommon.Hash, err error) {
+	bz, err := hexutil.Decode(hex)
+	if err != nil {
+		return hash, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if len(bz) != gethcommon.HashLength {
+		return hash, sdkerrors.ErrInvalidRequest.Wrap(
+			fmt.Sprintf("expected %d bytes, got %d", gethcommon.HashLength, len(bz)),
+		)
+	}
+	return gethcommon.BytesToHash(bz), nil
 }
 
 // GetSignBytes encodes the message for signing
@@ -95,7 +153,7 @@ func (msg MsgCancelFeeShare) ValidateBasic() error {
 		return sdkioerrors.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
 	}
 
-	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+	if _, err := ParseAddress(msg.ContractAddress); err != nil {
 		return sdkioerrors.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
 	}
 
@@ -138,7 +196,7 @@ func (msg MsgUpdateFeeShare) ValidateBasic() error {
 		return sdkioerrors.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
 	}
 
-	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+	if _, err := ParseAddress(msg.ContractAddress); err != nil {
 		return sdkioerrors.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
 	}
 
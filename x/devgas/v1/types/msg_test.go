@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -111,6 +112,99 @@ func (suite *MsgsTestSuite) TestMsgRegisterFeeShareNew() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgRegisterFeeShareEvm() {
+	evmContract := "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+	hash := "0x" + strings.Repeat("ab", 32)
+	testCases := []struct {
+		name    string
+		msg     MsgRegisterFeeShare
+		wantErr string
+	}{
+		{
+			name: "pass - CREATE",
+			msg: MsgRegisterFeeShare{
+				ContractAddress:  evmContract,
+				EvmCreationNonce: 4,
+			},
+		},
+		{
+			name: "pass - CREATE2",
+			msg: MsgRegisterFeeShare{
+				ContractAddress:  evmContract,
+				EvmCreate2Salt:   hash,
+				EvmInitCodeHash:  hash,
+				EvmFactoryNonces: []uint64{1},
+			},
+		},
+		{
+			name: "CREATE2 without a factory",
+			msg: MsgRegisterFeeShare{
+				ContractAddress: evmContract,
+				EvmCreate2Salt:  hash,
+				EvmInitCodeHash: hash,
+			},
+			wantErr: "need the evm_factory_nonces",
+		},
+		{
+			name: "factory nonces on a Wasm contract",
+			msg: MsgRegisterFeeShare{
+				ContractAddress:  suite.contract.String(),
+				EvmFactoryNonces: []uint64{1},
+			},
+			wantErr: "only valid for EVM contracts",
+		},
+		{
+			name: "EVM fields on a Wasm contract",
+			msg: MsgRegisterFeeShare{
+				ContractAddress:  suite.contract.String(),
+				EvmCreationNonce: 4,
+			},
+			wantErr: "only valid for EVM contracts",
+		},
+		{
+			name: "nonce with CREATE2",
+			msg: MsgRegisterFeeShare{
+				ContractAddress:  evmContract,
+				EvmCreationNonce: 4,
+				EvmCreate2Salt:   hash,
+				EvmInitCodeHash:  hash,
+				EvmFactoryNonces: []uint64{1},
+			},
+			wantErr: "evm_creation_nonce must be empty",
+		},
+		{
+			name: "missing init code hash",
+			msg: MsgRegisterFeeShare{
+				ContractAddress: evmContract,
+				EvmCreate2Salt:  hash,
+			},
+			wantErr: "invalid evm_init_code_hash",
+		},
+		{
+			name: "short salt",
+			msg: MsgRegisterFeeShare{
+				ContractAddress: evmContract,
+				EvmCreate2Salt:  "0xabcd",
+				EvmInitCodeHash: hash,
+			},
+			wantErr: "invalid evm_create2_salt",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.msg.DeployerAddress = suite.deployerStr
+			tc.msg.WithdrawerAddress = suite.withdrawerStr
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr != "" {
+				suite.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+}
+
 func (suite *MsgsTestSuite) TestMsgCancelFeeShareGetters() {
 	msgInvalid := MsgCancelFeeShare{}
 	msg := NewMsgCancelFeeShare(
@@ -34,6 +34,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type QueryFeeSharesRequest struct {
 	// TODO feat(devgas): re-implement the paginated version
 	// TODO feat(colletions): add automatic pagination generation
+	// deployer address in bech32 or hex ("0x...") format
 	Deployer string `protobuf:"bytes,1,opt,name=deployer,proto3" json:"deployer,omitempty"`
 }
 
@@ -126,7 +127,8 @@ func (m *QueryFeeSharesResponse) GetFeeshare() []FeeShare {
 
 // QueryFeeShareRequest is the request type for the Query/FeeShare RPC method.
 type QueryFeeShareRequest struct {
-	// contract_address of a registered contract in bech32 format
+	// contract_address of a registered contract in bech32 format, or in hex
+	// format ("0x...") for EVM contracts
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
@@ -302,7 +304,7 @@ func (m *QueryParamsResponse) GetParams() ModuleParams {
 // QueryFeeSharesByWithdrawerRequest is the request type for the
 // Query/FeeSharesByWithdrawer RPC method.
 type QueryFeeSharesByWithdrawerRequest struct {
-	// withdrawer_address in bech32 format
+	// withdrawer_address in bech32 or hex ("0x...") format
 	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
 }
 
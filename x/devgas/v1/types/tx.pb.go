@@ -33,14 +33,34 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MsgRegisterFeeShare defines a message that registers a FeeShare
 type MsgRegisterFeeShare struct {
-	// contract_address in bech32 format
+	// contract_address in bech32 format for Wasm contracts or in hex format
+	// ("0x...") for EVM contracts
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	// deployer_address is the bech32 address of message sender. It must be the
-	// same the contract's admin address
+	// same the contract's admin address. For EVM contracts, it must be the
+	// address that created the contract, or that created the factory contract
+	// that created it (see evm_factory_nonces).
 	DeployerAddress string `protobuf:"bytes,2,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
 	// withdrawer_address is the bech32 address of account receiving the
 	// transaction fees
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// evm_creation_nonce: For EVM contracts created with CREATE, the nonce of
+	// the creator (the deployer or the last factory) when it created the
+	// contract.
+	EvmCreationNonce uint64 `protobuf:"varint,4,opt,name=evm_creation_nonce,json=evmCreationNonce,proto3" json:"evm_creation_nonce,omitempty"`
+	// evm_create2_salt: For EVM contracts created with CREATE2 by a factory, the
+	// hex-encoded 32-byte salt. When set, the contract is proven with the
+	// CREATE2 address instead of the creation nonce.
+	EvmCreate2Salt string `protobuf:"bytes,5,opt,name=evm_create2_salt,json=evmCreate2Salt,proto3" json:"evm_create2_salt,omitempty"`
+	// evm_init_code_hash: For EVM contracts created with CREATE2 by a factory,
+	// the hex-encoded keccak256 hash of the init code.
+	EvmInitCodeHash string `protobuf:"bytes,6,opt,name=evm_init_code_hash,json=evmInitCodeHash,proto3" json:"evm_init_code_hash,omitempty"`
+	// evm_factory_nonces: For EVM contracts created by factory contracts, the
+	// CREATE nonces that lead from the deployer to the factory that created the
+	// contract. The deployer created the first factory at nonce
+	// evm_factory_nonces[0], which created the next factory at nonce
+	// evm_factory_nonces[1], and so on.
+	EvmFactoryNonces []uint64 `protobuf:"varint,7,rep,packed,name=evm_factory_nonces,json=evmFactoryNonces,proto3" json:"evm_factory_nonces,omitempty"`
 }
 
 func (m *MsgRegisterFeeShare) Reset()         { *m = MsgRegisterFeeShare{} }
@@ -97,6 +117,34 @@ func (m *MsgRegisterFeeShare) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *MsgRegisterFeeShare) GetEvmCreationNonce() uint64 {
+	if m != nil {
+		return m.EvmCreationNonce
+	}
+	return 0
+}
+
+func (m *MsgRegisterFeeShare) GetEvmCreate2Salt() string {
+	if m != nil {
+		return m.EvmCreate2Salt
+	}
+	return ""
+}
+
+func (m *MsgRegisterFeeShare) GetEvmInitCodeHash() string {
+	if m != nil {
+		return m.EvmInitCodeHash
+	}
+	return ""
+}
+
+func (m *MsgRegisterFeeShare) GetEvmFactoryNonces() []uint64 {
+	if m != nil {
+		return m.EvmFactoryNonces
+	}
+	return nil
+}
+
 // MsgRegisterFeeShareResponse defines the MsgRegisterFeeShare response type
 type MsgRegisterFeeShareResponse struct {
 }
@@ -445,43 +493,49 @@ func init() {
 func init() { proto.RegisterFile("nibiru/devgas/v1/tx.proto", fileDescriptor_72949c99a02cd615) }
 
 var fileDescriptor_72949c99a02cd615 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xc1, 0x6a, 0x13, 0x41,
-	0x18, 0xc7, 0xb3, 0x6d, 0x28, 0x74, 0x94, 0x36, 0x5d, 0x0b, 0x6d, 0x52, 0xdd, 0xea, 0xaa, 0xa5,
-	0x55, 0xb3, 0x43, 0x23, 0x78, 0x28, 0x5e, 0x4c, 0xc1, 0x93, 0x11, 0x49, 0xf1, 0x22, 0x42, 0x98,
-	0xec, 0x0e, 0x93, 0x81, 0x64, 0x67, 0x99, 0x99, 0xa4, 0xcd, 0xb5, 0x4f, 0x50, 0xf0, 0xa0, 0x27,
-	0xe9, 0xc1, 0x07, 0xf0, 0xe0, 0x43, 0xf4, 0x58, 0xf4, 0xe2, 0x49, 0x24, 0x11, 0xf4, 0x31, 0x24,
-	0x33, 0xb3, 0x9b, 0x6e, 0xb2, 0xad, 0xbd, 0x08, 0xbd, 0x25, 0xf3, 0xff, 0x7d, 0xdf, 0xf7, 0xdb,
-	0xe5, 0x9b, 0x05, 0xc5, 0x90, 0x36, 0x29, 0xef, 0xc2, 0x00, 0xf7, 0x08, 0x12, 0xb0, 0xb7, 0x0d,
-	0xe5, 0x81, 0x17, 0x71, 0x26, 0x99, 0x5d, 0xd0, 0x91, 0xa7, 0x23, 0xaf, 0xb7, 0x5d, 0x5a, 0x26,
-	0x8c, 0x30, 0x15, 0xc2, 0xd1, 0x2f, 0xcd, 0x95, 0x6e, 0x12, 0xc6, 0x48, 0x1b, 0x43, 0x14, 0x51,
-	0x88, 0xc2, 0x90, 0x49, 0x24, 0x29, 0x0b, 0x85, 0x49, 0x57, 0x7c, 0x26, 0x3a, 0x4c, 0xc0, 0x8e,
-	0x20, 0xa3, 0xee, 0x1d, 0x41, 0x4c, 0x50, 0xd4, 0x41, 0x43, 0xf7, 0xd3, 0x7f, 0x4c, 0xe4, 0x4c,
-	0x49, 0x11, 0x1c, 0x62, 0x41, 0x4d, 0xee, 0x1e, 0x5b, 0xe0, 0x46, 0x4d, 0x90, 0x3a, 0x26, 0x54,
-	0x48, 0xcc, 0x9f, 0x63, 0xbc, 0xd7, 0x42, 0x1c, 0xdb, 0x5b, 0xa0, 0xe0, 0xb3, 0x50, 0x72, 0xe4,
-	0xcb, 0x06, 0x0a, 0x02, 0x8e, 0x85, 0x58, 0xb5, 0x6e, 0x5b, 0x9b, 0xf3, 0xf5, 0xc5, 0xf8, 0xfc,
-	0x99, 0x3e, 0x1e, 0xa1, 0x01, 0x8e, 0xda, 0xac, 0x8f, 0x79, 0x82, 0xce, 0x68, 0x34, 0x3e, 0x8f,
-	0xd1, 0x32, 0xb0, 0xf7, 0xa9, 0x6c, 0x05, 0x1c, 0xed, 0x9f, 0x81, 0x67, 0x15, 0xbc, 0x34, 0x4e,
-	0x0c, 0xbe, 0x93, 0xff, 0x73, 0xbc, 0x9e, 0x73, 0x6f, 0x81, 0xb5, 0x0c, 0xc3, 0x3a, 0x16, 0x11,
-	0x0b, 0x05, 0x76, 0x3f, 0x5a, 0x60, 0xa9, 0x26, 0xc8, 0xeb, 0x28, 0x40, 0x12, 0x5f, 0x45, 0xff,
-	0x35, 0x50, 0x9c, 0xf2, 0x4b, 0xec, 0x99, 0x92, 0xdf, 0x45, 0xa1, 0x8f, 0xdb, 0xff, 0x57, 0x3e,
-	0x65, 0x93, 0x1e, 0x98, 0xd8, 0xbc, 0xb7, 0xc0, 0x62, 0xe2, 0xfa, 0x0a, 0x71, 0xd4, 0x11, 0xf6,
-	0x13, 0x30, 0x8f, 0xba, 0xb2, 0xc5, 0x38, 0x95, 0x7d, 0x6d, 0x51, 0x5d, 0xfd, 0xfa, 0xa5, 0xbc,
-	0x6c, 0xd6, 0xcc, 0x74, 0xdf, 0x93, 0x9c, 0x86, 0xa4, 0x3e, 0x46, 0xed, 0xa7, 0x60, 0x2e, 0x52,
-	0x1d, 0x94, 0xcf, 0xb5, 0x8a, 0xe3, 0x4d, 0x5e, 0x02, 0xaf, 0xc6, 0x82, 0x6e, 0xdb, 0xcc, 0xa9,
-	0xe6, 0x4f, 0x7e, 0xac, 0xe7, 0xea, 0xa6, 0x66, 0x67, 0xe1, 0xf0, 0xf7, 0xe7, 0x07, 0xe3, 0x6e,
-	0x6e, 0x11, 0xac, 0x4c, 0x88, 0xc5, 0xd2, 0x95, 0x4f, 0x79, 0x30, 0x5b, 0x13, 0xc4, 0xfe, 0x60,
-	0x81, 0xc2, 0xd4, 0x1e, 0xdf, 0xcf, 0x98, 0x3a, 0xbd, 0x4c, 0xa5, 0xf2, 0xa5, 0xb0, 0xe4, 0x3d,
-	0x79, 0x87, 0xdf, 0x7e, 0xbd, 0x9b, 0xd9, 0x74, 0x37, 0x60, 0xc6, 0x9d, 0x87, 0xdc, 0x94, 0x35,
-	0x12, 0x8b, 0x23, 0x0b, 0x2c, 0x4c, 0x2c, 0xe8, 0xdd, 0xcc, 0x89, 0x69, 0xa8, 0xf4, 0xf0, 0x12,
-	0x50, 0x22, 0xf5, 0x48, 0x49, 0x6d, 0xb8, 0xf7, 0x32, 0xa5, 0xba, 0xaa, 0x28, 0xad, 0x34, 0xb1,
-	0x76, 0xd9, 0x4a, 0x69, 0xe8, 0x1c, 0xa5, 0x73, 0xf6, 0xe9, 0x62, 0x25, 0x5f, 0x15, 0x8d, 0x95,
-	0xde, 0x82, 0xeb, 0xa9, 0xcd, 0xbb, 0x73, 0xc1, 0xd3, 0x6b, 0xa4, 0xb4, 0xf5, 0x4f, 0x24, 0x76,
-	0xa9, 0xbe, 0x38, 0x19, 0x38, 0xd6, 0xe9, 0xc0, 0xb1, 0x7e, 0x0e, 0x1c, 0xeb, 0x68, 0xe8, 0xe4,
-	0x4e, 0x87, 0x4e, 0xee, 0xfb, 0xd0, 0xc9, 0xbd, 0xa9, 0x10, 0x2a, 0x5b, 0xdd, 0xa6, 0xe7, 0xb3,
-	0x0e, 0x7c, 0xa9, 0xda, 0xed, 0xb6, 0x10, 0x0d, 0x63, 0xe7, 0x5e, 0x05, 0x1e, 0x9c, 0x15, 0xef,
-	0x47, 0x58, 0x34, 0xe7, 0xd4, 0xe7, 0xf3, 0xf1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x64, 0x69,
-	0x77, 0x65, 0xf5, 0x05, 0x00, 0x00,
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xcf, 0x6a, 0x13, 0x41,
+	0x00, 0xc6, 0xb3, 0x6d, 0xac, 0x74, 0x94, 0x36, 0x5d, 0x0b, 0x4d, 0x52, 0xdd, 0xd6, 0xa8, 0x25,
+	0xb5, 0xed, 0x2e, 0x8d, 0xe0, 0xa1, 0x78, 0xb1, 0x81, 0xa2, 0x60, 0x8a, 0xa4, 0x78, 0x11, 0x61,
+	0x99, 0xee, 0x8e, 0xb3, 0x03, 0xd9, 0x99, 0x65, 0x66, 0xb2, 0x6d, 0xae, 0x7d, 0x82, 0x82, 0x07,
+	0x3d, 0x89, 0x07, 0x1f, 0xc0, 0x83, 0x0f, 0xd1, 0x63, 0xb1, 0x17, 0x4f, 0x22, 0xad, 0xa0, 0x8f,
+	0x21, 0x99, 0xd9, 0xdd, 0x34, 0x7f, 0xd0, 0x5c, 0x04, 0x6f, 0xc9, 0x7c, 0xbf, 0xf9, 0xe6, 0xb7,
+	0xc3, 0xcc, 0x80, 0x12, 0x25, 0xfb, 0x84, 0xb7, 0x1d, 0x1f, 0xc5, 0x18, 0x0a, 0x27, 0xde, 0x74,
+	0xe4, 0xa1, 0x1d, 0x71, 0x26, 0x99, 0x59, 0xd0, 0x91, 0xad, 0x23, 0x3b, 0xde, 0x2c, 0xcf, 0x63,
+	0x86, 0x99, 0x0a, 0x9d, 0xee, 0x2f, 0xcd, 0x95, 0x6f, 0x62, 0xc6, 0x70, 0x0b, 0x39, 0x30, 0x22,
+	0x0e, 0xa4, 0x94, 0x49, 0x28, 0x09, 0xa3, 0x22, 0x49, 0x17, 0x3c, 0x26, 0x42, 0x26, 0x9c, 0x50,
+	0xe0, 0x6e, 0x7b, 0x28, 0x70, 0x12, 0x94, 0x74, 0xe0, 0xea, 0x3e, 0xfd, 0x27, 0x89, 0xac, 0x21,
+	0x29, 0x8c, 0x28, 0x12, 0x24, 0xc9, 0x2b, 0x67, 0x13, 0xe0, 0x46, 0x43, 0xe0, 0x26, 0xc2, 0x44,
+	0x48, 0xc4, 0x77, 0x10, 0xda, 0x0b, 0x20, 0x47, 0xe6, 0x2a, 0x28, 0x78, 0x8c, 0x4a, 0x0e, 0x3d,
+	0xe9, 0x42, 0xdf, 0xe7, 0x48, 0x88, 0xa2, 0xb1, 0x6c, 0x54, 0xa7, 0x9b, 0xb3, 0xe9, 0xf8, 0x63,
+	0x3d, 0xdc, 0x45, 0x7d, 0x14, 0xb5, 0x58, 0x07, 0xf1, 0x0c, 0x9d, 0xd0, 0x68, 0x3a, 0x9e, 0xa2,
+	0x1b, 0xc0, 0x3c, 0x20, 0x32, 0xf0, 0x39, 0x3c, 0xb8, 0x04, 0x4f, 0x2a, 0x78, 0xae, 0x97, 0xa4,
+	0xf8, 0x3a, 0x30, 0x51, 0x1c, 0xba, 0x1e, 0x47, 0x6a, 0x1f, 0x5c, 0xca, 0xa8, 0x87, 0x8a, 0xf9,
+	0x65, 0xa3, 0x9a, 0x6f, 0x16, 0x50, 0x1c, 0xd6, 0x93, 0x60, 0xb7, 0x3b, 0x6e, 0x56, 0x41, 0x21,
+	0xa3, 0x51, 0xcd, 0x15, 0xb0, 0x25, 0x8b, 0x57, 0x54, 0xf5, 0x4c, 0xca, 0xa2, 0xda, 0x1e, 0x6c,
+	0x49, 0x73, 0x4d, 0xf7, 0x12, 0x4a, 0xa4, 0xeb, 0x31, 0x1f, 0xb9, 0x01, 0x14, 0x41, 0x71, 0x4a,
+	0x3b, 0xa3, 0x38, 0x7c, 0x4a, 0x89, 0xac, 0x33, 0x1f, 0x3d, 0x81, 0x22, 0x48, 0x25, 0x5e, 0x43,
+	0x4f, 0x32, 0xde, 0xd1, 0x0e, 0xa2, 0x78, 0x75, 0x79, 0x32, 0x91, 0xd8, 0xd1, 0x81, 0x72, 0x10,
+	0x5b, 0xf9, 0x5f, 0x1f, 0x96, 0x72, 0x95, 0x5b, 0x60, 0x71, 0xc4, 0xa6, 0x36, 0x91, 0x88, 0x18,
+	0x15, 0xa8, 0xf2, 0xde, 0x00, 0x73, 0x0d, 0x81, 0x5f, 0x44, 0x3e, 0x94, 0xe8, 0xbf, 0xda, 0xf2,
+	0xc4, 0x7f, 0x11, 0x94, 0x86, 0xfc, 0x32, 0x7b, 0xa6, 0xe4, 0xeb, 0x90, 0x7a, 0xa8, 0xf5, 0x6f,
+	0xe5, 0xfb, 0x6c, 0xfa, 0x17, 0xcc, 0x6c, 0xde, 0x1a, 0x60, 0x36, 0x73, 0x7d, 0x0e, 0x39, 0x0c,
+	0x85, 0xf9, 0x10, 0x4c, 0xc3, 0xb6, 0x0c, 0x18, 0x27, 0xb2, 0xa3, 0x2d, 0xb6, 0x8b, 0x5f, 0x3e,
+	0x6f, 0xcc, 0x27, 0x37, 0x23, 0x69, 0xdf, 0x93, 0x9c, 0x50, 0xdc, 0xec, 0xa1, 0xe6, 0x23, 0x30,
+	0x15, 0xa9, 0x06, 0xe5, 0x73, 0xad, 0x66, 0xd9, 0x83, 0xf7, 0xd6, 0x6e, 0x30, 0xbf, 0xdd, 0x4a,
+	0xd6, 0xd9, 0xce, 0x9f, 0x7c, 0x5b, 0xca, 0x35, 0x93, 0x39, 0x5b, 0x33, 0x47, 0x3f, 0x3f, 0xdd,
+	0xef, 0xb5, 0x55, 0x4a, 0x60, 0x61, 0x40, 0x2c, 0x95, 0xae, 0x7d, 0xcc, 0x83, 0xc9, 0x86, 0xc0,
+	0xe6, 0x3b, 0x03, 0x14, 0x86, 0xae, 0xde, 0xbd, 0x11, 0xab, 0x0e, 0x1f, 0xa6, 0xf2, 0xc6, 0x58,
+	0x58, 0xb6, 0x4f, 0xf6, 0xd1, 0xd9, 0x8f, 0x37, 0x13, 0xd5, 0xca, 0x8a, 0x33, 0xe2, 0x99, 0x72,
+	0x78, 0x32, 0xcd, 0xcd, 0x2c, 0x8e, 0x0d, 0x30, 0x33, 0x70, 0x40, 0xef, 0x8c, 0x5c, 0xb1, 0x1f,
+	0x2a, 0xaf, 0x8d, 0x01, 0x65, 0x52, 0xeb, 0x4a, 0x6a, 0xa5, 0x72, 0x77, 0xa4, 0x54, 0x5b, 0x4d,
+	0xea, 0x57, 0x1a, 0x38, 0x76, 0xa3, 0x95, 0xfa, 0xa1, 0xf2, 0xda, 0x18, 0xd0, 0x98, 0x4a, 0x9e,
+	0x9a, 0xd4, 0x53, 0x7a, 0x05, 0xae, 0xf7, 0x9d, 0xbc, 0xdb, 0x7f, 0xf8, 0x7a, 0x8d, 0x94, 0x57,
+	0xff, 0x8a, 0xa4, 0x2e, 0xdb, 0xcf, 0x4e, 0xce, 0x2d, 0xe3, 0xf4, 0xdc, 0x32, 0xbe, 0x9f, 0x5b,
+	0xc6, 0xf1, 0x85, 0x95, 0x3b, 0xbd, 0xb0, 0x72, 0x5f, 0x2f, 0xac, 0xdc, 0xcb, 0x1a, 0x26, 0x32,
+	0x68, 0xef, 0xdb, 0x1e, 0x0b, 0x9d, 0x5d, 0x55, 0x57, 0x0f, 0x20, 0xa1, 0xa9, 0x73, 0x5c, 0x73,
+	0x0e, 0x2f, 0x8b, 0x77, 0x22, 0x24, 0xf6, 0xa7, 0xd4, 0x8b, 0xff, 0xe0, 0xf7, 0x00, 0x6c, 0x11,
+	0x6a, 0x18, 0xa8, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -702,6 +756,43 @@ func (m *MsgRegisterFeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmFactoryNonces) > 0 {
+		dAtA2 := make([]byte, len(m.EvmFactoryNonces)*10)
+		var j1 int
+		for _, num := range m.EvmFactoryNonces {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EvmInitCodeHash) > 0 {
+		i -= len(m.EvmInitCodeHash)
+		copy(dAtA[i:], m.EvmInitCodeHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvmInitCodeHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EvmCreate2Salt) > 0 {
+		i -= len(m.EvmCreate2Salt)
+		copy(dAtA[i:], m.EvmCreate2Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EvmCreate2Salt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EvmCreationNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EvmCreationNonce))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -968,6 +1059,24 @@ func (m *MsgRegisterFeeShare) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.EvmCreationNonce != 0 {
+		n += 1 + sovTx(uint64(m.EvmCreationNonce))
+	}
+	l = len(m.EvmCreate2Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EvmInitCodeHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EvmFactoryNonces) > 0 {
+		l = 0
+		for _, e := range m.EvmFactoryNonces {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1191,6 +1300,165 @@ func (m *MsgRegisterFeeShare) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmCreationNonce", wireType)
+			}
+			m.EvmCreationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmCreationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmCreate2Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmCreate2Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmInitCodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmInitCodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EvmFactoryNonces = append(m.EvmFactoryNonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EvmFactoryNonces) == 0 {
+					m.EvmFactoryNonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EvmFactoryNonces = append(m.EvmFactoryNonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmFactoryNonces", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -72,6 +72,8 @@ type EvmRpcAPI struct {
 	Net     *rpcapi.NetAPI
 	Debug   *rpcapi.DebugAPI
 	Filters *rpcapi.FiltersAPI
+	TxPool  *rpcapi.TxPoolAPI
+	Trace   *rpcapi.TraceAPI
 }
 
 type TxOption func(*txOptions)
@@ -145,12 +147,14 @@ func NewCLI() (out CLI, err error) {
 		tmWSClient,
 		false,
 		nil,
+		nil,
 		[]string{
 			rpcapi.NamespaceEth,
 			rpcapi.NamespaceNet,
 			rpcapi.NamespaceDebug,
 			rpcapi.NamespaceWeb3,
 			rpcapi.NamespaceTxPool,
+			rpcapi.NamespaceTrace,
 		},
 	)
 	evmRpcAPI, err := buildEvmRpcAPI(apis)
@@ -201,6 +205,10 @@ func buildEvmRpcAPI(apis []gethrpc.API) (EvmRpcAPI, error) {
 			out.Net = svc
 		case *rpcapi.DebugAPI:
 			out.Debug = svc
+		case *rpcapi.TxPoolAPI:
+			out.TxPool = svc
+		case *rpcapi.TraceAPI:
+			out.Trace = svc
 		}
 	}
 
@@ -216,6 +224,12 @@ func buildEvmRpcAPI(apis []gethrpc.API) (EvmRpcAPI, error) {
 	if out.Debug == nil {
 		return EvmRpcAPI{}, errors.New("localnet RPC APIs missing debug service")
 	}
+	if out.TxPool == nil {
+		return EvmRpcAPI{}, errors.New("localnet RPC APIs missing txpool service")
+	}
+	if out.Trace == nil {
+		return EvmRpcAPI{}, errors.New("localnet RPC APIs missing trace service")
+	}
 
 	return out, nil
 }
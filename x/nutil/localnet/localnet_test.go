@@ -111,6 +111,7 @@ func (s *Suite) TestNewCLIExposesTypedEvmRpcAPIs() {
 	s.Require().NotNil(cli.EvmRpc.Filters)
 	s.Require().NotNil(cli.EvmRpc.Net)
 	s.Require().NotNil(cli.EvmRpc.Debug)
+	s.Require().NotNil(cli.EvmRpc.TxPool)
 }
 
 func (s *Suite) TestLocalnetCLIQueryTxAndWaitHappyPath() {
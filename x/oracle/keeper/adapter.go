@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/evm"
+	xoracle "github.com/NibiruChain/nibiru/v2/x/oracle"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+// QueryAdapterLegacyExchangeRates queries every legacy exchange rate of the
+// Sai x-oracle Wasm adapter, which is the "x-oracle" Wasm plugin in the EVM
+// params.
+func (k Keeper) QueryAdapterLegacyExchangeRates(
+	ctx sdk.Context,
+) ([]xoracle.XOracleAdapterLegacyExchangeRateResp, error) {
+	if k.WasmKeeper == nil || k.EvmKeeper == nil {
+		return nil, fmt.Errorf("x-oracle adapter keepers are not set")
+	}
+	adapterAddr, err := k.EvmKeeper.GetWasmPluginAddr(ctx, evm.WasmPluginNameXOracle)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := json.Marshal(xoracle.XOracleAdapterQueryMsg{
+		LegacyExchangeRates: &xoracle.XOracleAdapterLegacyExchangeRatesQuery{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	respBz, err := k.WasmKeeper.QuerySmart(ctx, adapterAddr, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp xoracle.XOracleAdapterLegacyExchangeRatesResp
+	if err := json.Unmarshal(respBz, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Rates, nil
+}
+
+// QueryAdapterLegacyExchangeRate queries the legacy exchange rate of symbol
+// from the Sai x-oracle Wasm adapter.
+func (k Keeper) QueryAdapterLegacyExchangeRate(
+	ctx sdk.Context, symbol string,
+) (xoracle.XOracleAdapterLegacyExchangeRateResp, error) {
+	if k.WasmKeeper == nil || k.EvmKeeper == nil {
+		return xoracle.XOracleAdapterLegacyExchangeRateResp{}, fmt.Errorf("x-oracle adapter keepers are not set")
+	}
+	adapterAddr, err := k.EvmKeeper.GetWasmPluginAddr(ctx, evm.WasmPluginNameXOracle)
+	if err != nil {
+		return xoracle.XOracleAdapterLegacyExchangeRateResp{}, err
+	}
+
+	req, err := json.Marshal(xoracle.XOracleAdapterQueryMsg{
+		LegacyExchangeRate: &xoracle.XOracleAdapterLegacyExchangeRateQuery{Symbol: symbol},
+	})
+	if err != nil {
+		return xoracle.XOracleAdapterLegacyExchangeRateResp{}, err
+	}
+	respBz, err := k.WasmKeeper.QuerySmart(ctx, adapterAddr, req)
+	if err != nil {
+		return xoracle.XOracleAdapterLegacyExchangeRateResp{}, err
+	}
+
+	var resp xoracle.XOracleAdapterLegacyExchangeRateResp
+	if err := json.Unmarshal(respBz, &resp); err != nil {
+		return xoracle.XOracleAdapterLegacyExchangeRateResp{}, err
+	}
+	return resp, nil
+}
+
+// GetAdapterPrice returns the current price of symbol reported by the x-oracle
+// adapter. Symbols the adapter never priced, prices updated more than
+// maxAgeSeconds before the block time, and zero prices are errors, so the
+// result is safe to divide by.
+func (k Keeper) GetAdapterPrice(
+	ctx sdk.Context, symbol string, maxAgeSeconds uint64,
+) (sdkmath.LegacyDec, error) {
+	rate, err := k.QueryAdapterLegacyExchangeRate(ctx, symbol)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if rate.UpdateTimeSeconds == nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("x-oracle adapter has no price for %s", symbol)
+	}
+	if blockTime := ctx.BlockTime().Unix(); blockTime > 0 &&
+		uint64(blockTime) > *rate.UpdateTimeSeconds &&
+		uint64(blockTime)-*rate.UpdateTimeSeconds > maxAgeSeconds {
+		return sdkmath.LegacyDec{}, fmt.Errorf(
+			"x-oracle adapter price for %s is stale: updated %ds ago, max age is %ds",
+			symbol, uint64(blockTime)-*rate.UpdateTimeSeconds, maxAgeSeconds,
+		)
+	}
+	price, err := adapterPrice18ToDec(rate.Price18)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if !price.IsPositive() {
+		return sdkmath.LegacyDec{}, fmt.Errorf("x-oracle adapter price for %s is zero", symbol)
+	}
+	return price, nil
+}
+
+// SampleAdapterPrices samples the prices of the x-oracle adapter at the end of
+// every block. For each pair, it:
+//   - Sets the exchange rate and a price snapshot (see [Keeper.SetPrice]), which
+//     back the TWAP queries, and prunes snapshots older than the TWAP retention.
+//   - Records a price round if the price was updated after the latest round.
+//
+// Errors are logged and never halt the chain.
+func (k Keeper) SampleAdapterPrices(ctx sdk.Context) {
+	rates, err := k.QueryAdapterLegacyExchangeRates(ctx)
+	if err != nil {
+		// Expected on chains and tests without an x-oracle adapter.
+		k.Logger(ctx).Debug("skipped x-oracle adapter price sampling", "error", err)
+		return
+	}
+	snapshotRetention := types.MaxTwapLookbackWindow
+	if params, err := k.ModuleParams.Get(ctx); err == nil &&
+		params.TwapLookbackWindow > snapshotRetention {
+		snapshotRetention = params.TwapLookbackWindow
+	}
+
+	for _, rate := range rates {
+		pair, err := types.TryNewPair(rate.Symbol)
+		if err != nil || rate.UpdateTimeSeconds == nil {
+			continue
+		}
+		price, err := adapterPrice18ToDec(rate.Price18)
+		if err != nil {
+			k.Logger(ctx).Error("invalid x-oracle adapter price", "pair", pair, "error", err)
+			continue
+		}
+		k.SetPrice(ctx, pair, price)
+		k.PrunePriceSnapshots(ctx, pair, snapshotRetention)
+
+		timestampMs := int64(*rate.UpdateTimeSeconds) * 1000
+
+		if _, latest, err := k.GetLatestPriceRound(ctx, pair); err == nil &&
+			timestampMs <= latest.TimestampMs {
+			continue
+		}
+		k.AppendPriceRound(ctx, pair, price, timestampMs)
+	}
+}
+
+// adapterPrice18ToDec converts an 18-decimal fixed point price from the
+// x-oracle adapter to a decimal without loss of precision.
+func adapterPrice18ToDec(price18 string) (sdkmath.LegacyDec, error) {
+	price, ok := new(big.Int).SetString(price18, 10)
+	if !ok {
+		return sdkmath.LegacyDec{}, fmt.Errorf("invalid adapter price_18 %q", price18)
+	}
+	if price.Sign() < 0 {
+		return sdkmath.LegacyDec{}, fmt.Errorf("adapter price_18 must be non-negative: %s", price18)
+	}
+	return sdkmath.LegacyNewDecFromBigIntWithPrec(price, sdkmath.LegacyPrecision), nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -82,6 +83,32 @@ func (k Keeper) ExchangeRateTwap(c context.Context, req *types.QueryExchangeRate
 	return &types.QueryExchangeRateResponse{ExchangeRate: twap}, nil
 }
 
+// ExchangeRateTwapWindow queries the twap exchange rate of a pair over a
+// caller-chosen lookback window of at most [types.MaxTwapLookbackWindow].
+func (k Keeper) ExchangeRateTwapWindow(
+	c context.Context, req *types.QueryExchangeRateTwapWindowRequest,
+) (response *types.QueryExchangeRateResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	maxLookbackSeconds := uint64(types.MaxTwapLookbackWindow / time.Second)
+	if req.LookbackSeconds == 0 || req.LookbackSeconds > maxLookbackSeconds {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"lookback_seconds must be in (0, %d], got %d", maxLookbackSeconds, req.LookbackSeconds)
+	}
+	lookbackWindow := time.Duration(req.LookbackSeconds) * time.Second
+	if _, err = k.ExchangeRate(c, &types.QueryExchangeRateRequest{Pair: req.Pair}); err != nil {
+		return
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	twap, err := k.GetExchangeRateTwapWindow(ctx, req.Pair, lookbackWindow)
+	if err != nil {
+		return &types.QueryExchangeRateResponse{}, err
+	}
+	return &types.QueryExchangeRateResponse{ExchangeRate: twap}, nil
+}
+
 // ExchangeRates queries exchange rates of all pairs
 func (k Keeper) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -145,6 +145,53 @@ func TestQueryExchangeRateTwap(t *testing.T) {
 	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("1700"), res.ExchangeRate)
 }
 
+func TestQueryExchangeRateTwapWindow(t *testing.T) {
+	input := CreateTestFixture(t)
+	querier := input.OracleKeeper
+	pair := types.NewPair(denoms.BTC, denoms.NUSD)
+	start := input.Ctx.BlockTime()
+
+	// 1000 for 10s, then 2000 for 10s
+	input.OracleKeeper.SetPrice(input.Ctx, pair, sdkmath.LegacyNewDec(1000))
+	input.OracleKeeper.SetPrice(input.Ctx.WithBlockTime(start.Add(10*time.Second)), pair, sdkmath.LegacyNewDec(2000))
+	ctx := sdk.WrapSDKContext(input.Ctx.WithBlockTime(start.Add(20 * time.Second)))
+
+	for _, tc := range []struct {
+		lookbackSeconds uint64
+		want            sdkmath.LegacyDec
+	}{
+		{lookbackSeconds: 15, want: sdkmath.LegacyNewDec(2000)},
+		{lookbackSeconds: 20, want: sdkmath.LegacyNewDec(1500)},
+		{lookbackSeconds: 3600, want: sdkmath.LegacyNewDec(1500)},
+	} {
+		res, err := querier.ExchangeRateTwapWindow(ctx, &types.QueryExchangeRateTwapWindowRequest{
+			Pair: pair, LookbackSeconds: tc.lookbackSeconds,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tc.want, res.ExchangeRate, "lookback %ds", tc.lookbackSeconds)
+	}
+
+	for _, lookbackSeconds := range []uint64{0, uint64(types.MaxTwapLookbackWindow/time.Second) + 1} {
+		_, err := querier.ExchangeRateTwapWindow(ctx, &types.QueryExchangeRateTwapWindowRequest{
+			Pair: pair, LookbackSeconds: lookbackSeconds,
+		})
+		require.ErrorContains(t, err, "lookback_seconds")
+	}
+
+	_, err := querier.ExchangeRateTwapWindow(ctx, &types.QueryExchangeRateTwapWindowRequest{
+		Pair: types.NewPair(denoms.ETH, denoms.NUSD), LookbackSeconds: 20,
+	})
+	require.Error(t, err)
+
+	// Pruning drops the snapshot at 0s, leaving only the 2000 price.
+	input.OracleKeeper.PrunePriceSnapshots(sdk.UnwrapSDKContext(ctx), pair, 15*time.Second)
+	res, err := querier.ExchangeRateTwapWindow(ctx, &types.QueryExchangeRateTwapWindowRequest{
+		Pair: pair, LookbackSeconds: 20,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(2000), res.ExchangeRate)
+}
+
 func TestQueryDatedExchangeRate(t *testing.T) {
 	input := CreateTestFixture(t)
 	querier := input.OracleKeeper
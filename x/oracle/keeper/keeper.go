@@ -20,6 +20,10 @@ import (
 // Keeper of the oracle store
 type Keeper struct {
 	AccountKeeper types.AccountKeeper
+	// WasmKeeper and EvmKeeper are used to query the Sai x-oracle Wasm adapter.
+	// See [Keeper.SetAdapterKeepers].
+	WasmKeeper types.WasmKeeper
+	EvmKeeper  types.EvmKeeper
 
 	// Module parameters
 	ModuleParams      collections.Item[types.Params]
@@ -36,6 +40,16 @@ type Keeper struct {
 	WhitelistedPairs collections.KeySet[types.Pair]
 	Rewards          collections.Map[uint64, types.Rewards]
 	RewardsID        collections.Sequence
+
+	// PriceRounds maps a types.PriceSnapshot to the types.Pair and round ID of
+	// each price update reported by the x-oracle adapter. Only the most recent
+	// [types.PriceRoundHistoryLimit] rounds of each pair are kept.
+	PriceRounds collections.Map[
+		collections.Pair[types.Pair, uint64],
+		types.PriceSnapshot]
+	// LatestPriceRound maps each types.Pair to the ID of its most recent round
+	// in PriceRounds.
+	LatestPriceRound collections.Map[types.Pair, uint64]
 }
 
 // NewKeeper constructs a new keeper for oracle
@@ -64,10 +78,25 @@ func NewKeeper(
 			storeKey, 7,
 			collections.Uint64KeyEncoder, collections.ProtoValueEncoder[types.Rewards](cdc)),
 		RewardsID: collections.NewSequence(storeKey, 9),
+		PriceRounds: collections.NewMap(
+			storeKey, 12,
+			collections.PairKeyEncoder(types.PairKeyEncoder, collections.Uint64KeyEncoder),
+			collections.ProtoValueEncoder[types.PriceSnapshot](cdc)),
+		LatestPriceRound: collections.NewMap(
+			storeKey, 13,
+			types.PairKeyEncoder, collections.Uint64ValueEncoder),
 	}
 	return k
 }
 
+// SetAdapterKeepers sets the keepers used to query the Sai x-oracle Wasm
+// adapter. The Wasm keeper is created after the oracle keeper, so these can't
+// be passed to [NewKeeper].
+func (k *Keeper) SetAdapterKeepers(wasmKeeper types.WasmKeeper, evmKeeper types.EvmKeeper) {
+	k.WasmKeeper = wasmKeeper
+	k.EvmKeeper = evmKeeper
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -78,13 +107,20 @@ func (k Keeper) GetExchangeRateTwap(ctx sdk.Context, pair types.Pair) (price sdk
 	if err != nil {
 		return sdkmath.LegacyOneDec().Neg(), err
 	}
+	return k.GetExchangeRateTwapWindow(ctx, pair, params.TwapLookbackWindow)
+}
 
+// GetExchangeRateTwapWindow returns the time-weighted average price of the
+// pair over the given lookback window, which ends at the current block time.
+func (k Keeper) GetExchangeRateTwapWindow(
+	ctx sdk.Context, pair types.Pair, lookbackWindow time.Duration,
+) (price sdkmath.LegacyDec, err error) {
 	snapshots := k.PriceSnapshots.Iterate(
 		ctx,
 		collections.PairRange[types.Pair, time.Time]{}.
 			Prefix(pair).
 			StartInclusive(
-				ctx.BlockTime().Add(-1*params.TwapLookbackWindow)).
+				ctx.BlockTime().Add(-1*lookbackWindow)).
 			EndInclusive(
 				ctx.BlockTime()),
 	).Values()
@@ -128,6 +164,20 @@ func (k Keeper) GetExchangeRateTwap(ctx sdk.Context, pair types.Pair) (price sdk
 	return cumulativePrice.QuoInt64(ctx.BlockTime().UnixMilli() - firstTimestampMs), nil
 }
 
+// PrunePriceSnapshots deletes the price snapshots of the pair that are older
+// than the retention window, counted back from the current block time.
+func (k Keeper) PrunePriceSnapshots(ctx sdk.Context, pair types.Pair, retention time.Duration) {
+	keys := k.PriceSnapshots.Iterate(
+		ctx,
+		collections.PairRange[types.Pair, time.Time]{}.
+			Prefix(pair).
+			EndExclusive(ctx.BlockTime().Add(-1*retention)),
+	).Keys()
+	for _, key := range keys {
+		_ = k.PriceSnapshots.Delete(ctx, key)
+	}
+}
+
 // SetPrice sets the price for a pair as well as the price snapshot.
 func (k Keeper) SetPrice(ctx sdk.Context, pair types.Pair, price sdkmath.LegacyDec) {
 	blockTimestampMs := ctx.BlockTime().UnixMilli()
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/collections"
+
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+// AppendPriceRound records a new price round for the pair and returns its ID.
+// Round IDs start at 1 and increase by one for each round of the pair. Once a
+// pair has more than [types.PriceRoundHistoryLimit] rounds, the oldest one is
+// pruned.
+func (k Keeper) AppendPriceRound(
+	ctx sdk.Context, pair types.Pair, price sdkmath.LegacyDec, timestampMs int64,
+) (roundId uint64) {
+	roundId = k.LatestPriceRound.GetOr(ctx, pair, 0) + 1
+	k.PriceRounds.Insert(ctx, collections.Join(pair, roundId), types.PriceSnapshot{
+		Pair:        pair,
+		Price:       price,
+		TimestampMs: timestampMs,
+	})
+	k.LatestPriceRound.Insert(ctx, pair, roundId)
+
+	if roundId > types.PriceRoundHistoryLimit {
+		_ = k.PriceRounds.Delete(ctx, collections.Join(pair, roundId-types.PriceRoundHistoryLimit))
+	}
+	return roundId
+}
+
+// GetPriceRound returns the price round of the pair with the given ID. It
+// errors if the round was never recorded or has been pruned.
+func (k Keeper) GetPriceRound(
+	ctx sdk.Context, pair types.Pair, roundId uint64,
+) (types.PriceSnapshot, error) {
+	round, err := k.PriceRounds.Get(ctx, collections.Join(pair, roundId))
+	if err != nil {
+		return types.PriceSnapshot{}, types.ErrPriceRoundNotFound.Wrapf(
+			"pair %s, round %d", pair, roundId)
+	}
+	return round, nil
+}
+
+// GetLatestPriceRound returns the most recent price round of the pair and its
+// ID.
+func (k Keeper) GetLatestPriceRound(
+	ctx sdk.Context, pair types.Pair,
+) (roundId uint64, round types.PriceSnapshot, err error) {
+	roundId, err = k.LatestPriceRound.Get(ctx, pair)
+	if err != nil {
+		return 0, round, types.ErrPriceRoundNotFound.Wrapf("no rounds for pair %s", pair)
+	}
+	round, err = k.GetPriceRound(ctx, pair, roundId)
+	return roundId, round, err
+}
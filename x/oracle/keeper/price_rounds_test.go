@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/v2/x/nutil/denoms"
+	"github.com/NibiruChain/nibiru/v2/x/oracle/types"
+)
+
+func TestPriceRounds(t *testing.T) {
+	input := CreateTestFixture(t)
+	ctx := input.Ctx
+	pair := types.NewPair(denoms.ETH, denoms.NUSD)
+
+	_, _, err := input.OracleKeeper.GetLatestPriceRound(ctx, pair)
+	require.ErrorIs(t, err, types.ErrPriceRoundNotFound)
+
+	for i := int64(1); i <= 3; i++ {
+		roundId := input.OracleKeeper.AppendPriceRound(ctx, pair, sdkmath.LegacyNewDec(i), i*1000)
+		require.EqualValues(t, i, roundId)
+	}
+
+	round, err := input.OracleKeeper.GetPriceRound(ctx, pair, 2)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(2), round.Price)
+	require.EqualValues(t, 2000, round.TimestampMs)
+
+	latestId, latest, err := input.OracleKeeper.GetLatestPriceRound(ctx, pair)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, latestId)
+	require.Equal(t, sdkmath.LegacyNewDec(3), latest.Price)
+
+	_, err = input.OracleKeeper.GetPriceRound(ctx, pair, 4)
+	require.ErrorIs(t, err, types.ErrPriceRoundNotFound)
+	_, err = input.OracleKeeper.GetPriceRound(ctx, types.NewPair(denoms.BTC, denoms.NUSD), 1)
+	require.ErrorIs(t, err, types.ErrPriceRoundNotFound)
+
+	t.Run("history is bounded", func(t *testing.T) {
+		for i := uint64(4); i <= types.PriceRoundHistoryLimit+2; i++ {
+			input.OracleKeeper.AppendPriceRound(ctx, pair, sdkmath.LegacyOneDec(), int64(i)*1000)
+		}
+		for _, pruned := range []uint64{1, 2} {
+			_, err := input.OracleKeeper.GetPriceRound(ctx, pair, pruned)
+			require.ErrorIs(t, err, types.ErrPriceRoundNotFound)
+		}
+		_, err := input.OracleKeeper.GetPriceRound(ctx, pair, 3)
+		require.NoError(t, err)
+		latestId, _, err := input.OracleKeeper.GetLatestPriceRound(ctx, pair)
+		require.NoError(t, err)
+		require.EqualValues(t, types.PriceRoundHistoryLimit+2, latestId)
+	})
+}
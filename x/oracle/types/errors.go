@@ -37,4 +37,5 @@ var (
 	ErrNoValidTWAP            = registerError("TWA price not found")
 	ErrOracleDeprecated       = registerError("module x/oracle is deprecated as of v2.12; transaction messages are disabled")
 	ErrInvalidTokenPair       = registerError("invalid token pair")
+	ErrPriceRoundNotFound     = registerError("price round not found")
 )
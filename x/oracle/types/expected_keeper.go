@@ -11,3 +11,15 @@ type AccountKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI // only used for simulation
 }
+
+// WasmKeeper is expected keeper for the wasm module, used to query the Sai
+// x-oracle adapter contract.
+type WasmKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+}
+
+// EvmKeeper is expected keeper for the evm module, used to look up the
+// address of the x-oracle Wasm plugin set in the EVM params.
+type EvmKeeper interface {
+	GetWasmPluginAddr(ctx sdk.Context, name string) (sdk.AccAddress, error)
+}
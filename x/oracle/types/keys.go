@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 const (
 	// ModuleName is the name of the oracle module
 	ModuleName = "oracle"
@@ -13,3 +15,12 @@ const (
 	// QuerierRoute is the query router key for the oracle module
 	QuerierRoute = ModuleName
 )
+
+// PriceRoundHistoryLimit is the number of most recent price rounds kept per
+// pair. Older rounds are pruned as new ones are recorded.
+const PriceRoundHistoryLimit uint64 = 1_000
+
+// MaxTwapLookbackWindow is the longest lookback window a caller can choose for
+// a TWAP query. Price snapshots sampled from the x-oracle adapter are kept for
+// this long, or for the TwapLookbackWindow param if that is longer.
+const MaxTwapLookbackWindow = 24 * time.Hour
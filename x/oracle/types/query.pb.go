@@ -988,6 +988,49 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryExchangeRateTwapWindowRequest is the request type for the
+// Query/ExchangeRateTwapWindow RPC method.
+type QueryExchangeRateTwapWindowRequest struct {
+	// pair defines the pair to query for.
+	Pair Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=Pair" json:"pair"`
+	// lookback_seconds is the length of the TWAP window, in seconds, that ends
+	// at the current block time.
+	LookbackSeconds uint64 `protobuf:"varint,2,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
+}
+
+func (m *QueryExchangeRateTwapWindowRequest) Reset()         { *m = QueryExchangeRateTwapWindowRequest{} }
+func (m *QueryExchangeRateTwapWindowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateTwapWindowRequest) ProtoMessage()    {}
+func (*QueryExchangeRateTwapWindowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16aef2382d1249a8, []int{22}
+}
+func (m *QueryExchangeRateTwapWindowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateTwapWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateTwapWindowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateTwapWindowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateTwapWindowRequest.Merge(m, src)
+}
+func (m *QueryExchangeRateTwapWindowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateTwapWindowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateTwapWindowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateTwapWindowRequest proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryExchangeRateRequest)(nil), "nibiru.oracle.v1.QueryExchangeRateRequest")
 	proto.RegisterType((*QueryExchangeRateResponse)(nil), "nibiru.oracle.v1.QueryExchangeRateResponse")
@@ -1011,87 +1054,92 @@ func init() {
 	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "nibiru.oracle.v1.QueryAggregateVotesResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "nibiru.oracle.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.oracle.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryExchangeRateTwapWindowRequest)(nil), "nibiru.oracle.v1.QueryExchangeRateTwapWindowRequest")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/query.proto", fileDescriptor_16aef2382d1249a8) }
 
 var fileDescriptor_16aef2382d1249a8 = []byte{
-	// 1188 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x8d, 0x49, 0xdb, 0x67, 0x3b, 0x75, 0x86, 0x20, 0x1c, 0x27, 0xb1, 0xdd, 0xa5,
-	0x89, 0xd2, 0x26, 0xf1, 0xe2, 0x04, 0x15, 0x85, 0xdf, 0xf9, 0x41, 0x44, 0x21, 0x81, 0x60, 0x45,
-	0x11, 0xea, 0xc5, 0x1a, 0xaf, 0xa7, 0xeb, 0x55, 0x6d, 0xaf, 0xbb, 0x33, 0x36, 0x8d, 0x80, 0x4b,
-	0x05, 0x88, 0x23, 0x12, 0x42, 0xdc, 0xa0, 0x17, 0x24, 0xc4, 0xb9, 0x70, 0x87, 0x53, 0x8f, 0x15,
-	0x5c, 0x10, 0x87, 0x82, 0x12, 0x0e, 0xfc, 0x19, 0xc8, 0x33, 0xe3, 0xcd, 0xae, 0xd7, 0xab, 0x2c,
-	0x46, 0xdc, 0xe2, 0xf7, 0xde, 0xbc, 0xf7, 0x99, 0xef, 0x8c, 0xe7, 0x1b, 0xc3, 0x6c, 0xcb, 0xaa,
-	0x5a, 0x4e, 0x47, 0xb7, 0x1d, 0x62, 0x34, 0xa8, 0xde, 0x2d, 0xe9, 0x77, 0x3a, 0xd4, 0x39, 0x2a,
-	0xb6, 0x1d, 0x9b, 0xdb, 0x38, 0x2d, 0xb3, 0x45, 0x99, 0x2d, 0x76, 0x4b, 0xd9, 0x9c, 0x61, 0xb3,
-	0xa6, 0xcd, 0xf4, 0x2a, 0x61, 0xbd, 0xea, 0x2a, 0xe5, 0xa4, 0xa4, 0x1b, 0xb6, 0xd5, 0x92, 0x2b,
-	0xb2, 0xd3, 0x32, 0x5f, 0x11, 0x9f, 0x74, 0xf9, 0x41, 0xa5, 0xa6, 0x4c, 0xdb, 0xb4, 0x65, 0xbc,
-	0xf7, 0x97, 0x8a, 0xce, 0x9a, 0xb6, 0x6d, 0x36, 0xa8, 0x4e, 0xda, 0x96, 0x4e, 0x5a, 0x2d, 0x9b,
-	0x13, 0x6e, 0xd9, 0xad, 0xfe, 0x9a, 0xb9, 0x00, 0x9e, 0x42, 0x11, 0x69, 0x6d, 0x07, 0x32, 0xef,
-	0xf6, 0x70, 0x5f, 0xbf, 0x6b, 0xd4, 0x49, 0xcb, 0xa4, 0x65, 0xc2, 0x69, 0x99, 0xde, 0xe9, 0x50,
-	0xc6, 0x71, 0x01, 0xe2, 0x6d, 0x62, 0x39, 0x19, 0x54, 0x40, 0x8b, 0x17, 0x37, 0x93, 0x0f, 0x1f,
-	0xe7, 0x63, 0xbf, 0x3f, 0xce, 0xc7, 0xf7, 0x89, 0xe5, 0x94, 0x45, 0xe6, 0x85, 0x0b, 0x9f, 0xdd,
-	0xcf, 0xc7, 0xfe, 0xbe, 0x9f, 0x8f, 0x69, 0x27, 0x08, 0xa6, 0x87, 0x34, 0x62, 0x6d, 0xbb, 0xc5,
-	0x28, 0x3e, 0x84, 0x14, 0x55, 0xf1, 0x8a, 0x43, 0x38, 0x55, 0x2d, 0x4b, 0xaa, 0xe5, 0x8c, 0xdc,
-	0x25, 0xab, 0xdd, 0x2e, 0x5a, 0xb6, 0xde, 0x24, 0xbc, 0x5e, 0xdc, 0xa5, 0x26, 0x31, 0x8e, 0xb6,
-	0xa9, 0xf1, 0xcb, 0x83, 0x15, 0x50, 0x22, 0x6c, 0x53, 0xa3, 0x9c, 0xa4, 0x9e, 0xfe, 0x78, 0x19,
-	0x70, 0xb5, 0x61, 0x1b, 0xb7, 0x2b, 0xdc, 0x6a, 0x52, 0xc6, 0x49, 0xb3, 0x5d, 0x69, 0xb2, 0xcc,
-	0xb9, 0x02, 0x5a, 0x1c, 0x2b, 0xa7, 0x45, 0xe6, 0xa0, 0x9f, 0xd8, 0x63, 0xf8, 0x32, 0x24, 0x65,
-	0x75, 0x9d, 0x5a, 0x66, 0x9d, 0x67, 0xc6, 0x0a, 0x68, 0x31, 0x5e, 0x4e, 0x88, 0xd8, 0x1b, 0x22,
-	0x84, 0xe7, 0x00, 0x2c, 0x56, 0xe9, 0x5a, 0x2d, 0x4e, 0x4c, 0x9a, 0x89, 0x17, 0xd0, 0xe2, 0x85,
-	0xf2, 0x45, 0x8b, 0x1d, 0xca, 0x80, 0x36, 0x33, 0x64, 0x93, 0x4c, 0xc9, 0xa5, 0x7d, 0x8c, 0x20,
-	0x3b, 0x2c, 0xab, 0x34, 0xb8, 0x05, 0x13, 0x3e, 0x0d, 0x58, 0x06, 0x15, 0xc6, 0x16, 0x13, 0xab,
-	0xcf, 0x14, 0x07, 0xaf, 0x48, 0xd1, 0xdb, 0xe0, 0xa0, 0xd3, 0x6e, 0xd0, 0xcd, 0x6c, 0x4f, 0xa9,
-	0xef, 0xff, 0xc8, 0xe3, 0x40, 0x8a, 0x95, 0x53, 0x5e, 0x49, 0x98, 0xf6, 0x14, 0x3c, 0x29, 0x28,
-	0x36, 0x0c, 0x6e, 0x75, 0x4f, 0xe9, 0x5e, 0x81, 0x29, 0x7f, 0x58, 0x61, 0x2d, 0xc0, 0x79, 0x22,
-	0x43, 0x82, 0x67, 0xf0, 0x9c, 0xfb, 0x49, 0x6d, 0x1a, 0x9e, 0x16, 0xeb, 0x0f, 0x6d, 0x4e, 0x0f,
-	0x88, 0x63, 0x52, 0xee, 0xb6, 0x7e, 0x4b, 0xdd, 0x21, 0x5f, 0x4a, 0xb5, 0xd7, 0x21, 0xd9, 0xb5,
-	0x39, 0xad, 0x70, 0x19, 0x1f, 0x3a, 0x23, 0xd1, 0x3d, 0x5d, 0xa8, 0xbd, 0x03, 0xb3, 0xa2, 0xd9,
-	0x0e, 0xa5, 0x35, 0xea, 0x6c, 0xd3, 0x06, 0x35, 0xc5, 0x7d, 0xee, 0x5f, 0xca, 0x79, 0x98, 0xe8,
-	0x92, 0x86, 0x55, 0x23, 0xdc, 0x76, 0x2a, 0xa4, 0x56, 0x53, 0xd7, 0xb3, 0x9c, 0x72, 0xa3, 0x1b,
-	0xb5, 0x9a, 0xf7, 0x66, 0xbe, 0x06, 0x73, 0x21, 0x0d, 0x15, 0x62, 0x1e, 0x12, 0xb7, 0x44, 0xce,
-	0xdb, 0x0e, 0x64, 0xa8, 0xd7, 0x4b, 0x7b, 0x53, 0x6d, 0x7d, 0xcf, 0x62, 0x6c, 0xcb, 0xee, 0xb4,
-	0x38, 0x75, 0x46, 0xa6, 0x79, 0x59, 0x69, 0xe5, 0xeb, 0xa5, 0x40, 0x2e, 0x43, 0xb2, 0x69, 0x31,
-	0x56, 0x31, 0x64, 0x5c, 0xb4, 0x8a, 0x97, 0x13, 0xcd, 0xd3, 0x52, 0x57, 0x9d, 0x0d, 0xd3, 0x74,
-	0x7a, 0xfb, 0xa0, 0xfb, 0x0e, 0xed, 0xa9, 0x37, 0x32, 0xcf, 0x3d, 0xa4, 0xe4, 0x09, 0x76, 0x54,
-	0x54, 0x04, 0x26, 0x49, 0x3f, 0x57, 0x69, 0xcb, 0xa4, 0xe8, 0x9a, 0x58, 0x2d, 0x06, 0xaf, 0xae,
-	0xdb, 0xc6, 0x7b, 0x51, 0x55, 0xcb, 0xcd, 0x78, 0xef, 0xd8, 0xcb, 0x69, 0x32, 0x30, 0x4a, 0xcb,
-	0x87, 0x30, 0xb8, 0x37, 0xec, 0x13, 0x04, 0xb9, 0xb0, 0x0a, 0x85, 0x69, 0x00, 0x0e, 0x60, 0xf6,
-	0xbf, 0x62, 0xa3, 0x71, 0x4e, 0x0e, 0x72, 0x32, 0x6d, 0x57, 0x7d, 0xff, 0xdd, 0xd5, 0x87, 0xff,
-	0x45, 0xfb, 0xae, 0x7a, 0x2f, 0x06, 0xba, 0xa9, 0x0d, 0xbd, 0x07, 0x13, 0xa7, 0x1b, 0xf2, 0x88,
-	0xbe, 0x14, 0x71, 0x33, 0x87, 0xa7, 0x3b, 0x49, 0x11, 0xef, 0x04, 0x6d, 0x76, 0xd8, 0x5c, 0x57,
-	0xeb, 0x23, 0x98, 0x19, 0x9a, 0x55, 0x58, 0x37, 0xe1, 0x92, 0x1f, 0xab, 0x2f, 0xf2, 0x08, 0x5c,
-	0x13, 0x3e, 0x2e, 0xa6, 0x4d, 0x01, 0x16, 0xa3, 0xf7, 0x89, 0x43, 0x9a, 0x2e, 0xd0, 0x9e, 0x7a,
-	0xd0, 0xfa, 0x51, 0x05, 0x72, 0x1d, 0xc6, 0xdb, 0x22, 0xa2, 0x74, 0xc9, 0x04, 0xe7, 0xcb, 0x15,
-	0x6a, 0x98, 0xaa, 0x5e, 0xfd, 0xf9, 0x12, 0x3c, 0x21, 0xfa, 0xe1, 0x2f, 0x11, 0x24, 0xbd, 0x64,
-	0xf8, 0x5a, 0xb0, 0x45, 0x98, 0x39, 0x66, 0x97, 0x22, 0xd5, 0x4a, 0x56, 0x6d, 0xf9, 0xde, 0xaf,
-	0x7f, 0x7d, 0x71, 0x6e, 0x01, 0x5f, 0xd1, 0x07, 0xdd, 0x58, 0xda, 0xbf, 0xcf, 0x18, 0xf0, 0xd7,
-	0x08, 0xd2, 0xbe, 0x77, 0xfe, 0x7d, 0xd2, 0xfe, 0xff, 0xd8, 0x4a, 0x82, 0x6d, 0x09, 0x5f, 0x8d,
-	0xc2, 0x56, 0xe1, 0x3d, 0x96, 0x6f, 0x10, 0xa4, 0x7c, 0x26, 0x87, 0xa3, 0x4c, 0xec, 0x1f, 0x68,
-	0x76, 0x39, 0x5a, 0xb1, 0xe2, 0x5b, 0x13, 0x7c, 0x2b, 0x78, 0x29, 0x84, 0xaf, 0xf7, 0x8f, 0x08,
-	0xf3, 0x53, 0x32, 0xfc, 0x29, 0x82, 0xf3, 0xca, 0xe9, 0xf0, 0x7c, 0xc8, 0x38, 0xbf, 0x41, 0x66,
-	0x17, 0xce, 0x2a, 0x8b, 0x78, 0x96, 0x92, 0x47, 0xd9, 0x26, 0xfe, 0x0a, 0x41, 0xc2, 0xe3, 0x8b,
-	0xf8, 0x6a, 0xc8, 0x94, 0xa0, 0xad, 0x66, 0xaf, 0x45, 0x29, 0x8d, 0x78, 0x88, 0x12, 0xca, 0xeb,
-	0xc4, 0xf8, 0x47, 0x04, 0xe9, 0x41, 0x4f, 0xc4, 0xc5, 0x90, 0x99, 0x21, 0x6e, 0x9c, 0xd5, 0x23,
-	0xd7, 0x2b, 0xd0, 0x0d, 0x01, 0xfa, 0x22, 0x5e, 0x0f, 0x01, 0x75, 0xdf, 0x4a, 0xa6, 0x7f, 0xe0,
-	0x7f, 0x4d, 0x3f, 0xd2, 0xa5, 0x25, 0xe3, 0x6f, 0x11, 0x24, 0x3c, 0xf6, 0x19, 0x2a, 0x69, 0xd0,
-	0xae, 0x43, 0x25, 0x1d, 0xe2, 0xc6, 0xda, 0xab, 0x82, 0x74, 0x1d, 0x3f, 0x3f, 0x02, 0x69, 0xcf,
-	0xb2, 0xf1, 0x4f, 0x08, 0xd2, 0x83, 0x7e, 0x15, 0x2a, 0x70, 0x88, 0xa1, 0x87, 0x0a, 0x1c, 0x66,
-	0xd7, 0xda, 0xae, 0xc0, 0xde, 0xc1, 0xdb, 0x23, 0x60, 0x07, 0x0c, 0x14, 0x3f, 0x40, 0x30, 0x19,
-	0xf0, 0x5c, 0x1c, 0x15, 0xca, 0xbd, 0xca, 0xcf, 0x46, 0x5f, 0xa0, 0xb6, 0xf1, 0x92, 0xd8, 0xc6,
-	0x75, 0xfc, 0xdc, 0xd9, 0xdb, 0x08, 0xda, 0x3e, 0xfe, 0x01, 0x41, 0xca, 0xe7, 0x5f, 0xa1, 0x0f,
-	0xd4, 0x30, 0x27, 0x0f, 0x7d, 0xa0, 0x86, 0x1a, 0xb5, 0x76, 0x43, 0xa0, 0x6e, 0xe1, 0x8d, 0x70,
-	0xd4, 0x9a, 0x75, 0xa6, 0xe2, 0x42, 0xee, 0xef, 0x10, 0x4c, 0xf8, 0x7d, 0x17, 0x47, 0x62, 0x71,
-	0x85, 0x5e, 0x89, 0x58, 0xad, 0xd0, 0xd7, 0x05, 0xfa, 0x1a, 0x2e, 0xfd, 0x1b, 0x95, 0xa5, 0xc4,
-	0x1f, 0xc2, 0xb8, 0xb4, 0x57, 0x7c, 0x25, 0x64, 0xa6, 0xcf, 0xc5, 0xb3, 0xf3, 0x67, 0x54, 0x29,
-	0xa2, 0x79, 0x41, 0x94, 0xc7, 0x73, 0xa1, 0x0f, 0x99, 0xb0, 0xf4, 0x1b, 0x0f, 0x8f, 0x73, 0xe8,
-	0xd1, 0x71, 0x0e, 0xfd, 0x79, 0x9c, 0x43, 0x9f, 0x9f, 0xe4, 0x62, 0x8f, 0x4e, 0x72, 0xb1, 0xdf,
-	0x4e, 0x72, 0xb1, 0x9b, 0xba, 0x69, 0xf1, 0x7a, 0xa7, 0x5a, 0x34, 0xec, 0xa6, 0xfe, 0xb6, 0x68,
-	0xb1, 0x55, 0x27, 0x56, 0xab, 0xdf, 0xae, 0xbb, 0xaa, 0xdf, 0xed, 0xf7, 0xe4, 0x47, 0x6d, 0xca,
-	0xaa, 0xe3, 0xe2, 0x87, 0xf0, 0xda, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe9, 0xdd, 0xd4, 0x59,
-	0xc8, 0x0f, 0x00, 0x00,
+	// 1257 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0xad, 0xe9, 0x8f, 0xe7, 0x24, 0x75, 0xa7, 0x05, 0xdc, 0x6d, 0x62, 0xa7, 0x4b,
+	0x53, 0xa5, 0x4d, 0xea, 0xad, 0xd3, 0xaa, 0x50, 0x7e, 0xa7, 0x0d, 0x15, 0x85, 0x16, 0xca, 0x12,
+	0x05, 0xd4, 0xcb, 0x6a, 0xbc, 0x9e, 0xae, 0x57, 0xb1, 0x77, 0xdc, 0x9d, 0xb5, 0xdb, 0xa8, 0x70,
+	0xa9, 0x00, 0x71, 0x44, 0x42, 0x88, 0x1b, 0xf4, 0x82, 0x84, 0x38, 0x17, 0xee, 0xdc, 0x7a, 0xac,
+	0xe0, 0x82, 0x38, 0x14, 0x94, 0x70, 0xe0, 0x6f, 0xe0, 0x84, 0x76, 0x66, 0x6c, 0xef, 0x7a, 0xbd,
+	0xca, 0xd6, 0x88, 0x5b, 0xfc, 0xde, 0x9b, 0xf7, 0x3e, 0xef, 0xbb, 0x6f, 0x77, 0x9e, 0x02, 0x33,
+	0x9e, 0x5b, 0x73, 0xfd, 0x8e, 0xc1, 0x7c, 0x62, 0x37, 0xa9, 0xd1, 0xad, 0x1a, 0xb7, 0x3a, 0xd4,
+	0xdf, 0xac, 0xb4, 0x7d, 0x16, 0x30, 0x5c, 0x90, 0xde, 0x8a, 0xf4, 0x56, 0xba, 0x55, 0xad, 0x64,
+	0x33, 0xde, 0x62, 0xdc, 0xa8, 0x11, 0x1e, 0x46, 0xd7, 0x68, 0x40, 0xaa, 0x86, 0xcd, 0x5c, 0x4f,
+	0x9e, 0xd0, 0x8e, 0x48, 0xbf, 0x25, 0x7e, 0x19, 0xf2, 0x87, 0x72, 0x1d, 0x76, 0x98, 0xc3, 0xa4,
+	0x3d, 0xfc, 0x4b, 0x59, 0x67, 0x1c, 0xc6, 0x9c, 0x26, 0x35, 0x48, 0xdb, 0x35, 0x88, 0xe7, 0xb1,
+	0x80, 0x04, 0x2e, 0xf3, 0x7a, 0x67, 0x66, 0x13, 0x78, 0x0a, 0x45, 0xb8, 0xf5, 0xcb, 0x50, 0x7c,
+	0x2f, 0xc4, 0x7d, 0xe3, 0x8e, 0xdd, 0x20, 0x9e, 0x43, 0x4d, 0x12, 0x50, 0x93, 0xde, 0xea, 0x50,
+	0x1e, 0xe0, 0x39, 0xc8, 0xb5, 0x89, 0xeb, 0x17, 0xd1, 0x1c, 0x5a, 0xd8, 0x7f, 0x71, 0xf2, 0xe1,
+	0xe3, 0xf2, 0xc4, 0xef, 0x8f, 0xcb, 0xb9, 0xeb, 0xc4, 0xf5, 0x4d, 0xe1, 0x79, 0x71, 0xdf, 0xe7,
+	0xf7, 0xcb, 0x13, 0x7f, 0xdf, 0x2f, 0x4f, 0xe8, 0xdb, 0x08, 0x8e, 0x8c, 0x48, 0xc4, 0xdb, 0xcc,
+	0xe3, 0x14, 0xaf, 0xc3, 0x14, 0x55, 0x76, 0xcb, 0x27, 0x01, 0x55, 0x29, 0xab, 0x2a, 0xe5, 0x51,
+	0xd9, 0x25, 0xaf, 0x6f, 0x54, 0x5c, 0x66, 0xb4, 0x48, 0xd0, 0xa8, 0x5c, 0xa5, 0x0e, 0xb1, 0x37,
+	0x57, 0xa9, 0xfd, 0xcb, 0x83, 0xd3, 0xa0, 0x44, 0x58, 0xa5, 0xb6, 0x39, 0x49, 0x23, 0xf9, 0xf1,
+	0x12, 0xe0, 0x5a, 0x93, 0xd9, 0x1b, 0x56, 0xe0, 0xb6, 0x28, 0x0f, 0x48, 0xab, 0x6d, 0xb5, 0x78,
+	0x71, 0xd7, 0x1c, 0x5a, 0xd8, 0x6d, 0x16, 0x84, 0x67, 0xad, 0xe7, 0xb8, 0xc6, 0xf1, 0x31, 0x98,
+	0x94, 0xd1, 0x0d, 0xea, 0x3a, 0x8d, 0xa0, 0xb8, 0x7b, 0x0e, 0x2d, 0xe4, 0xcc, 0xbc, 0xb0, 0xbd,
+	0x29, 0x4c, 0x78, 0x16, 0xc0, 0xe5, 0x56, 0xd7, 0xf5, 0x02, 0xe2, 0xd0, 0x62, 0x6e, 0x0e, 0x2d,
+	0xec, 0x33, 0xf7, 0xbb, 0x7c, 0x5d, 0x1a, 0xf4, 0xa3, 0x23, 0x9a, 0xe4, 0x4a, 0x2e, 0xfd, 0x13,
+	0x04, 0xda, 0x28, 0xaf, 0xd2, 0xe0, 0x26, 0x4c, 0xc7, 0x34, 0xe0, 0x45, 0x34, 0xb7, 0x7b, 0x21,
+	0xbf, 0xfc, 0x5c, 0x65, 0x78, 0x44, 0x2a, 0xd1, 0x04, 0x6b, 0x9d, 0x76, 0x93, 0x5e, 0xd4, 0x42,
+	0xa5, 0x7e, 0xf8, 0xa3, 0x8c, 0x13, 0x2e, 0x6e, 0x4e, 0x45, 0x25, 0xe1, 0xfa, 0xd3, 0x70, 0x48,
+	0x50, 0xac, 0xd8, 0x81, 0xdb, 0x1d, 0xd0, 0xbd, 0x0a, 0x87, 0xe3, 0x66, 0x85, 0x75, 0x02, 0xf6,
+	0x12, 0x69, 0x12, 0x3c, 0xc3, 0xcf, 0xb9, 0xe7, 0xd4, 0x8f, 0xc0, 0xb3, 0xe2, 0xfc, 0x3a, 0x0b,
+	0xe8, 0x1a, 0xf1, 0x1d, 0x1a, 0xf4, 0x53, 0xbf, 0x0d, 0xc5, 0xa4, 0x4b, 0xa5, 0x37, 0x60, 0xb2,
+	0xcb, 0x02, 0x6a, 0x05, 0xd2, 0x3e, 0xb2, 0x46, 0xbe, 0x3b, 0x38, 0xa8, 0xbf, 0x0b, 0x33, 0x22,
+	0xd9, 0x65, 0x4a, 0xeb, 0xd4, 0x5f, 0xa5, 0x4d, 0xea, 0x88, 0x79, 0xee, 0x0d, 0xe5, 0x3c, 0x4c,
+	0x77, 0x49, 0xd3, 0xad, 0x93, 0x80, 0xf9, 0x16, 0xa9, 0xd7, 0xd5, 0x78, 0x9a, 0x53, 0x7d, 0xeb,
+	0x4a, 0xbd, 0x1e, 0x9d, 0xcc, 0xd7, 0x61, 0x36, 0x25, 0xa1, 0x42, 0x2c, 0x43, 0xfe, 0xa6, 0xf0,
+	0x45, 0xd3, 0x81, 0x34, 0x85, 0xb9, 0xf4, 0xb7, 0x54, 0xeb, 0xd7, 0x5c, 0xce, 0x2f, 0xb1, 0x8e,
+	0x17, 0x50, 0x7f, 0x6c, 0x9a, 0x57, 0xa0, 0x98, 0xcc, 0xa5, 0x40, 0x8e, 0xc1, 0x64, 0xcb, 0xe5,
+	0xdc, 0xb2, 0xa5, 0x5d, 0xa4, 0xca, 0x99, 0xf9, 0xd6, 0x20, 0xb4, 0xaf, 0xce, 0x8a, 0xe3, 0xf8,
+	0x61, 0x1f, 0xf4, 0xba, 0x4f, 0x43, 0xf5, 0xc6, 0xe6, 0xb9, 0x87, 0x60, 0x36, 0x25, 0xa3, 0xa2,
+	0x22, 0x70, 0x90, 0xf4, 0x7c, 0x56, 0x5b, 0x3a, 0x45, 0xd6, 0xfc, 0x72, 0x25, 0x39, 0xba, 0xfd,
+	0x34, 0xd1, 0x41, 0x55, 0x29, 0x2f, 0xe6, 0xc2, 0xc7, 0x6e, 0x16, 0xc8, 0x50, 0x29, 0xbd, 0x9c,
+	0xc2, 0xd0, 0x9f, 0xb0, 0x4f, 0x11, 0x94, 0xd2, 0x22, 0x14, 0xa6, 0x0d, 0x38, 0x81, 0xd9, 0x7b,
+	0xc5, 0xc6, 0xe3, 0x3c, 0x38, 0xcc, 0xc9, 0xf5, 0xab, 0xea, 0xfd, 0xef, 0x9f, 0x5e, 0xff, 0x2f,
+	0xda, 0x77, 0x41, 0x1b, 0x95, 0x4d, 0x35, 0xf4, 0x21, 0x4c, 0x0f, 0x1a, 0x8a, 0x88, 0xbe, 0x98,
+	0xb1, 0x99, 0xf5, 0x41, 0x27, 0x53, 0x24, 0x5a, 0x41, 0x9f, 0x19, 0x55, 0xb7, 0xaf, 0xf5, 0x26,
+	0x1c, 0x1d, 0xe9, 0x55, 0x58, 0x37, 0xe0, 0x40, 0x1c, 0xab, 0x27, 0xf2, 0x18, 0x5c, 0xd3, 0x31,
+	0x2e, 0xae, 0x1f, 0x06, 0x2c, 0x4a, 0x5f, 0x27, 0x3e, 0x69, 0xf5, 0x81, 0xae, 0xc1, 0xa1, 0x98,
+	0x55, 0x81, 0x9c, 0x87, 0x3d, 0x6d, 0x61, 0x51, 0xba, 0x14, 0x93, 0xf5, 0xe5, 0x09, 0x55, 0x4c,
+	0x45, 0xeb, 0x77, 0x41, 0x4f, 0x7c, 0xa5, 0xd7, 0x6e, 0x93, 0xf6, 0x07, 0xae, 0x57, 0x67, 0xb7,
+	0x33, 0xdf, 0x7d, 0xf8, 0x24, 0x14, 0x9a, 0x8c, 0x6d, 0xd4, 0x88, 0xbd, 0x61, 0x71, 0x6a, 0x33,
+	0xaf, 0x2e, 0x6f, 0x9e, 0x9c, 0x79, 0xa0, 0x67, 0x7f, 0x5f, 0x9a, 0x07, 0x8f, 0x7c, 0xf9, 0x9f,
+	0x02, 0x3c, 0x25, 0xaa, 0xe3, 0xaf, 0x10, 0x4c, 0x46, 0x11, 0xf0, 0xa9, 0x24, 0x7f, 0xda, 0xcd,
+	0xac, 0x2d, 0x66, 0x8a, 0x95, 0x42, 0xe9, 0x4b, 0xf7, 0x7e, 0xfd, 0xeb, 0xcb, 0x5d, 0x27, 0xf0,
+	0x71, 0x63, 0x78, 0x15, 0x90, 0xbb, 0x47, 0xec, 0x56, 0xc2, 0xdf, 0x20, 0x28, 0x0c, 0x4b, 0xf3,
+	0xff, 0xb1, 0x55, 0x05, 0xdb, 0x22, 0x3e, 0x99, 0x85, 0xcd, 0x0a, 0x42, 0x96, 0x1f, 0x11, 0x3c,
+	0x33, 0xfa, 0xd9, 0xe1, 0x73, 0x19, 0x4a, 0x27, 0x1e, 0xf5, 0x93, 0x01, 0xbf, 0x20, 0x80, 0x97,
+	0xf1, 0x99, 0xcc, 0xc0, 0xd6, 0x6d, 0x09, 0xf7, 0x2d, 0x82, 0xa9, 0x68, 0x4a, 0x8e, 0xb3, 0x14,
+	0xee, 0xbd, 0x05, 0xda, 0x52, 0xb6, 0x60, 0x85, 0x79, 0x56, 0x60, 0x9e, 0xc6, 0x8b, 0x29, 0x98,
+	0xe1, 0x04, 0xf3, 0x38, 0x2c, 0xc7, 0x9f, 0x21, 0xd8, 0xab, 0xd6, 0x03, 0x3c, 0x9f, 0x52, 0x2e,
+	0xbe, 0x55, 0x68, 0x27, 0x76, 0x0a, 0xcb, 0x38, 0x83, 0x92, 0x47, 0xed, 0x1a, 0xf8, 0x6b, 0x04,
+	0xf9, 0xc8, 0x32, 0x81, 0x4f, 0xa6, 0x54, 0x49, 0xee, 0x22, 0xda, 0xa9, 0x2c, 0xa1, 0x19, 0x87,
+	0x4f, 0x42, 0x45, 0xd7, 0x17, 0xfc, 0x13, 0x82, 0xc2, 0xf0, 0x22, 0x81, 0x2b, 0x29, 0x35, 0x53,
+	0x56, 0x18, 0xcd, 0xc8, 0x1c, 0xaf, 0x40, 0x57, 0x04, 0xe8, 0x4b, 0xf8, 0x42, 0x0a, 0x68, 0xff,
+	0x82, 0xe1, 0xc6, 0xdd, 0xf8, 0x15, 0xf4, 0xb1, 0x21, 0xf7, 0x18, 0xfc, 0x1d, 0x82, 0x7c, 0x64,
+	0xe7, 0x48, 0x95, 0x34, 0xb9, 0xe3, 0x68, 0xa7, 0xb2, 0x84, 0x2a, 0xd2, 0xd7, 0x04, 0xe9, 0x05,
+	0xfc, 0xfc, 0x18, 0xa4, 0xe1, 0x9e, 0x83, 0x7f, 0x46, 0x50, 0x18, 0xbe, 0xe4, 0x53, 0x05, 0x4e,
+	0xd9, 0x82, 0x34, 0x23, 0x73, 0xbc, 0xc2, 0xbe, 0x2a, 0xb0, 0x2f, 0xe3, 0xd5, 0x31, 0xb0, 0x13,
+	0x5b, 0x07, 0x7e, 0x80, 0xe0, 0xe0, 0x70, 0x29, 0x8e, 0xb3, 0x42, 0xf5, 0x47, 0xf9, 0x4c, 0xf6,
+	0x03, 0xaa, 0x8d, 0x97, 0x45, 0x1b, 0xe7, 0xf1, 0xb9, 0x9d, 0xdb, 0x48, 0xee, 0x4a, 0xe1, 0x87,
+	0x75, 0x2a, 0x76, 0xe9, 0xa7, 0x7e, 0xa0, 0x46, 0xad, 0x3f, 0xda, 0x52, 0xb6, 0x60, 0x85, 0x7a,
+	0x45, 0xa0, 0x5e, 0xc2, 0x2b, 0xe9, 0xa8, 0x75, 0x77, 0x47, 0xc5, 0x85, 0xdc, 0xdf, 0x23, 0x98,
+	0x8e, 0x15, 0xe1, 0x38, 0x13, 0x4b, 0x5f, 0xe8, 0xd3, 0x19, 0xa3, 0x15, 0xfa, 0x05, 0x81, 0x7e,
+	0x16, 0x57, 0x9f, 0x44, 0x65, 0x29, 0xf1, 0x47, 0xb0, 0x47, 0xee, 0x24, 0xf8, 0x78, 0x4a, 0xcd,
+	0xd8, 0xea, 0xa3, 0xcd, 0xef, 0x10, 0xa5, 0x88, 0xe6, 0x05, 0x51, 0x19, 0xcf, 0xa6, 0x7e, 0xc8,
+	0xc4, 0x1e, 0x74, 0xe5, 0xe1, 0x56, 0x09, 0x3d, 0xda, 0x2a, 0xa1, 0x3f, 0xb7, 0x4a, 0xe8, 0x8b,
+	0xed, 0xd2, 0xc4, 0xa3, 0xed, 0xd2, 0xc4, 0x6f, 0xdb, 0xa5, 0x89, 0x1b, 0x86, 0xe3, 0x06, 0x8d,
+	0x4e, 0xad, 0x62, 0xb3, 0x96, 0xf1, 0x8e, 0x48, 0x71, 0xa9, 0x41, 0x5c, 0xaf, 0x97, 0xae, 0xbb,
+	0x6c, 0xdc, 0xe9, 0xe5, 0x0c, 0x36, 0xdb, 0x94, 0xd7, 0xf6, 0x88, 0xff, 0x1e, 0x9c, 0xfd, 0x77,
+	0x00, 0xc9, 0xfa, 0x52, 0x75, 0xfd, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1111,6 +1159,9 @@ type QueryClient interface {
 	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
 	// ExchangeRateTwap returns twap exchange rate of a pair
 	ExchangeRateTwap(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// ExchangeRateTwapWindow returns the time-weighted average price of a pair
+	// over a lookback window chosen by the caller.
+	ExchangeRateTwapWindow(ctx context.Context, in *QueryExchangeRateTwapWindowRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
 	// ExchangeRates returns exchange rates of all pairs
 	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
 	// Actives returns all active pairs
@@ -1159,6 +1210,15 @@ func (c *queryClient) ExchangeRateTwap(ctx context.Context, in *QueryExchangeRat
 	return out, nil
 }
 
+func (c *queryClient) ExchangeRateTwapWindow(ctx context.Context, in *QueryExchangeRateTwapWindowRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
+	out := new(QueryExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/ExchangeRateTwapWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
 	out := new(QueryExchangeRatesResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/ExchangeRates", in, out, opts...)
@@ -1256,6 +1316,9 @@ type QueryServer interface {
 	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
 	// ExchangeRateTwap returns twap exchange rate of a pair
 	ExchangeRateTwap(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// ExchangeRateTwapWindow returns the time-weighted average price of a pair
+	// over a lookback window chosen by the caller.
+	ExchangeRateTwapWindow(context.Context, *QueryExchangeRateTwapWindowRequest) (*QueryExchangeRateResponse, error)
 	// ExchangeRates returns exchange rates of all pairs
 	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
 	// Actives returns all active pairs
@@ -1288,6 +1351,9 @@ func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExc
 func (*UnimplementedQueryServer) ExchangeRateTwap(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateTwap not implemented")
 }
+func (*UnimplementedQueryServer) ExchangeRateTwapWindow(ctx context.Context, req *QueryExchangeRateTwapWindowRequest) (*QueryExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateTwapWindow not implemented")
+}
 func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
 }
@@ -1359,6 +1425,24 @@ func _Query_ExchangeRateTwap_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExchangeRateTwapWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateTwapWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRateTwapWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/ExchangeRateTwapWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRateTwapWindow(ctx, req.(*QueryExchangeRateTwapWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryExchangeRatesRequest)
 	if err := dec(in); err != nil {
@@ -1551,6 +1635,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExchangeRateTwap",
 			Handler:    _Query_ExchangeRateTwap_Handler,
 		},
+		{
+			MethodName: "ExchangeRateTwapWindow",
+			Handler:    _Query_ExchangeRateTwapWindow_Handler,
+		},
 		{
 			MethodName: "ExchangeRates",
 			Handler:    _Query_ExchangeRates_Handler,
@@ -2282,6 +2370,44 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryExchangeRateTwapWindowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateTwapWindowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateTwapWindowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2563,6 +2689,20 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryExchangeRateTwapWindowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4247,6 +4387,109 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryExchangeRateTwapWindowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateTwapWindowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateTwapWindowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
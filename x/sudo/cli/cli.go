@@ -14,6 +14,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/client"
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/client/tx"
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
 	"github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/version"
 
 	"github.com/NibiruChain/nibiru/v2/x/nutil/flags"
@@ -21,6 +22,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// FlagDeposit is the amount added to a gas sponsor escrow by "set-gas-sponsor".
+const FlagDeposit = "deposit"
+
 // GetTxCmd returns a cli command for this module's transactions
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -36,6 +40,8 @@ func GetTxCmd() *cobra.Command {
 		CmdEditSudoers(),
 		CmdEditZeroGasActors(),
 		CmdChangeRoot(),
+		CmdSetGasSponsor(),
+		CmdRemoveGasSponsor(),
 	)
 
 	return txCmd
@@ -56,6 +62,8 @@ func GetQueryCmd() *cobra.Command {
 	cmds := []*cobra.Command{
 		CmdQuerySudoers(),
 		CmdQueryZeroGasActors(),
+		CmdQueryGasSponsor(),
+		CmdQueryGasSponsors(),
 	}
 	for _, cmd := range cmds {
 		moduleQueryCmd.AddCommand(cmd)
@@ -215,6 +223,95 @@ The [actors_json_string] for "ZeroGasActors" is of the form:
 	return cmd
 }
 
+// CmdSetGasSponsor is a terminal command that broadcasts a
+// "nibiru.sudo.v1.MsgSetGasSponsor" transaction.
+func CmdSetGasSponsor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-gas-sponsor [policy_json_string]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create or update the gas sponsor owned by the sender",
+		Example: heredoc.Docf(`
+%s tx sudo set-gas-sponsor [policy_json_string] --deposit=1000000unibi --from=<key_or_address>
+
+The [policy_json_string] for "GasSponsorPolicy" is of the form:
+{
+  "contracts": ["0x...", "nibi1....", ... ],
+  "senders": ["nibi1...", ... ],
+  "daily_cap_per_sender": "100000",
+  "max_fee_per_tx": "10000",
+  "expires_at": "1767225600"
+}
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var policy sudo.GasSponsorPolicy
+			if err = clientCtx.Codec.UnmarshalJSON([]byte(args[0]), &policy); err != nil {
+				return fmt.Errorf("failed to unpack policy json string: %w", err)
+			}
+
+			msg := &sudo.MsgSetGasSponsor{
+				Sender: clientCtx.GetFromAddress().String(),
+				Policy: policy,
+			}
+			depositStr, err := cmd.Flags().GetString(FlagDeposit)
+			if err != nil {
+				return err
+			}
+			if depositStr != "" {
+				msg.Deposit, err = sdk.ParseCoinNormalized(depositStr)
+				if err != nil {
+					return err
+				}
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDeposit, "", "unibi added to the gas sponsor escrow")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdRemoveGasSponsor is a terminal command that broadcasts a
+// "nibiru.sudo.v1.MsgRemoveGasSponsor" transaction.
+func CmdRemoveGasSponsor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-gas-sponsor",
+		Args:  cobra.NoArgs,
+		Short: "Delete the gas sponsor owned by the sender and refund its escrow",
+		Example: heredoc.Docf(`
+%s tx sudo remove-gas-sponsor --from=<key_or_address>`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &sudo.MsgRemoveGasSponsor{
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdQuerySudoers() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "state",
@@ -274,3 +371,61 @@ func CmdQueryZeroGasActors() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryGasSponsor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-sponsor [sponsor]",
+		Short: "displays the gas sponsor owned by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := sudo.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.QueryGasSponsor(
+				cmd.Context(), &sudo.QueryGasSponsorRequest{Sponsor: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryGasSponsors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-sponsors",
+		Short: "displays all gas sponsors of the x/sudo module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := sudo.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.QueryGasSponsors(
+				cmd.Context(), new(sudo.QueryGasSponsorsRequest),
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
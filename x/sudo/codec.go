@@ -17,6 +17,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		/* implementations */
 		&MsgEditSudoers{},
 		&MsgEditZeroGasActors{},
+		&MsgSetGasSponsor{},
+		&MsgRemoveGasSponsor{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
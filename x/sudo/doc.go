@@ -16,5 +16,21 @@ files.
 Note that this package does not provide actual system integration or execute
 commands with elevated privileges. It only offers a way to manage and verify
 permissions in a sudoers-like manner within your application.
+
+# Gas Sponsors
+
+Any account can become a gas sponsor by escrowing "unibi" in the x/sudo module
+account with MsgSetGasSponsor. The sponsor's policy lists the EVM or Wasm
+contracts it pays for, an optional sender allowlist, an optional per-sender
+daily cap, a maximum fee per transaction, and an optional expiry. Transactions
+that call a sponsored contract pay the base fee with no priority tip, and the
+fee is drawn from the sponsor's escrow instead of the sender. If the sponsor
+cannot pay, the sender pays as usual. MsgRemoveGasSponsor refunds whatever is
+left in escrow.
+
+A sponsor must own every contract in its policy: the admin of a Wasm contract
+(or its creator if no admin is set), or the deployer of an EVM contract as
+registered in x/devgas. This keeps anyone from claiming a contract's
+sponsorship ahead of its team.
 */
 package sudo
@@ -10,6 +10,7 @@ var (
 	ErrUnauthorized = sdkioerrors.Register(ModuleName, 2, "unauthorized: missing sudo permissions")
 	errGenesis      = sdkioerrors.Register(ModuleName, 3, "sudo genesis error")
 	errSudoers      = sdkioerrors.Register(ModuleName, 4, "sudoers error")
+	errGasSponsor   = sdkioerrors.Register(ModuleName, 5, "gas sponsor error")
 )
 
 func ErrGenesis(errMsg string) error {
@@ -19,3 +20,7 @@ func ErrGenesis(errMsg string) error {
 func ErrSudoers(errMsg string) error {
 	return fmt.Errorf("%s: %s", errSudoers, errMsg)
 }
+
+func ErrGasSponsor(errMsg string) error {
+	return fmt.Errorf("%s: %s", errGasSponsor, errMsg)
+}
@@ -5,6 +5,7 @@ package sudo
 
 import (
 	fmt "fmt"
+	types "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -78,29 +79,159 @@ func (m *EventUpdateSudoers) GetAction() string {
 	return ""
 }
 
+// EventGasSponsorUpdate: ABCI event emitted when a gas sponsor is created,
+// updated, or removed.
+type EventGasSponsorUpdate struct {
+	GasSponsor GasSponsor `protobuf:"bytes,1,opt,name=gas_sponsor,json=gasSponsor,proto3" json:"gas_sponsor"`
+	// Removed is true when the gas sponsor was deleted.
+	Removed bool `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (m *EventGasSponsorUpdate) Reset()         { *m = EventGasSponsorUpdate{} }
+func (m *EventGasSponsorUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventGasSponsorUpdate) ProtoMessage()    {}
+func (*EventGasSponsorUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e6085948b018986, []int{1}
+}
+func (m *EventGasSponsorUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGasSponsorUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGasSponsorUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGasSponsorUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGasSponsorUpdate.Merge(m, src)
+}
+func (m *EventGasSponsorUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGasSponsorUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGasSponsorUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGasSponsorUpdate proto.InternalMessageInfo
+
+func (m *EventGasSponsorUpdate) GetGasSponsor() GasSponsor {
+	if m != nil {
+		return m.GasSponsor
+	}
+	return GasSponsor{}
+}
+
+func (m *EventGasSponsorUpdate) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
+// EventGasSponsored: ABCI event emitted when a gas sponsor pays the fees of a
+// transaction.
+type EventGasSponsored struct {
+	// Sponsor: Nibiru Bech32 address of the gas sponsor.
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// Sender: Nibiru Bech32 address of the transaction signer.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Fee: Amount paid by the sponsor.
+	Fee types.Coin `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *EventGasSponsored) Reset()         { *m = EventGasSponsored{} }
+func (m *EventGasSponsored) String() string { return proto.CompactTextString(m) }
+func (*EventGasSponsored) ProtoMessage()    {}
+func (*EventGasSponsored) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e6085948b018986, []int{2}
+}
+func (m *EventGasSponsored) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGasSponsored) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGasSponsored.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGasSponsored) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGasSponsored.Merge(m, src)
+}
+func (m *EventGasSponsored) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGasSponsored) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGasSponsored.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGasSponsored proto.InternalMessageInfo
+
+func (m *EventGasSponsored) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *EventGasSponsored) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventGasSponsored) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*EventUpdateSudoers)(nil), "nibiru.sudo.v1.EventUpdateSudoers")
+	proto.RegisterType((*EventGasSponsorUpdate)(nil), "nibiru.sudo.v1.EventGasSponsorUpdate")
+	proto.RegisterType((*EventGasSponsored)(nil), "nibiru.sudo.v1.EventGasSponsored")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/event.proto", fileDescriptor_7e6085948b018986) }
 
 var fileDescriptor_7e6085948b018986 = []byte{
-	// 240 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0xcb, 0x4c, 0xca,
-	0x2c, 0x2a, 0xd5, 0x2f, 0x2e, 0x4d, 0xc9, 0xd7, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b,
-	0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xe9, 0x81, 0xe4, 0xf4, 0xca, 0x0c,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x4c, 0x7a,
-	0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62,
-	0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x16, 0xdd, 0xfc, 0xe2, 0x92, 0xc4, 0x92, 0x54, 0x88, 0x9c,
-	0x52, 0x2a, 0x97, 0x90, 0x2b, 0xc8, 0xba, 0xd0, 0x82, 0x94, 0xc4, 0x92, 0xd4, 0xe0, 0xd2, 0x94,
-	0xfc, 0xd4, 0xa2, 0x62, 0x21, 0x73, 0x2e, 0xf6, 0x62, 0x08, 0x53, 0x82, 0x51, 0x81, 0x51, 0x83,
-	0xdb, 0x48, 0x5c, 0x0f, 0xd5, 0x1d, 0x7a, 0x50, 0x95, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04,
-	0xc1, 0x54, 0x0b, 0x89, 0x71, 0xb1, 0x25, 0x26, 0x83, 0xec, 0x96, 0x60, 0x52, 0x60, 0xd4, 0xe0,
-	0x0c, 0x82, 0xf2, 0x9c, 0x1c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x3d, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf, 0x0f, 0x6c, 0x87, 0x73,
-	0x46, 0x62, 0x66, 0x9e, 0x3e, 0xd4, 0xcd, 0x65, 0x46, 0xfa, 0x15, 0x60, 0x87, 0x27, 0xb1, 0x81,
-	0x1d, 0x6c, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x35, 0xce, 0x57, 0x2e, 0x01, 0x00, 0x00,
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcd, 0x8a, 0xdb, 0x30,
+	0x14, 0x85, 0xed, 0xa6, 0x24, 0x8d, 0x02, 0x85, 0x9a, 0xfe, 0xb8, 0xa6, 0xb8, 0x21, 0x9b, 0x66,
+	0x25, 0xe1, 0x74, 0xd1, 0x75, 0x12, 0x4a, 0x77, 0x5d, 0x38, 0x74, 0xd3, 0xcd, 0x20, 0xdb, 0x77,
+	0x1c, 0xc1, 0x44, 0xd7, 0x58, 0xb2, 0xc9, 0x63, 0xcc, 0x63, 0x65, 0x99, 0xe5, 0xac, 0x86, 0x21,
+	0x79, 0x91, 0x41, 0x96, 0x3c, 0x3f, 0xd9, 0xdd, 0x7b, 0xcf, 0x11, 0xdf, 0x11, 0x87, 0x44, 0x52,
+	0x64, 0xa2, 0x6e, 0x98, 0x6a, 0x0a, 0x64, 0x6d, 0xc2, 0xa0, 0x05, 0xa9, 0x69, 0x55, 0xa3, 0xc6,
+	0xe0, 0xbd, 0xd5, 0xa8, 0xd1, 0x68, 0x9b, 0x44, 0x71, 0x8e, 0x6a, 0x87, 0x8a, 0x65, 0x5c, 0x01,
+	0x6b, 0x93, 0x0c, 0x34, 0x4f, 0x58, 0x8e, 0x42, 0x5a, 0x7f, 0xf4, 0xb1, 0xc4, 0x12, 0xbb, 0x91,
+	0x99, 0xc9, 0x5d, 0xbf, 0x95, 0x88, 0xe5, 0x0d, 0x30, 0x5e, 0x09, 0xc6, 0xa5, 0x44, 0xcd, 0xb5,
+	0x40, 0xa9, 0x9c, 0x7a, 0xc9, 0x57, 0x9a, 0x6b, 0xb0, 0xda, 0x0c, 0x48, 0xf0, 0xdb, 0xc4, 0xf9,
+	0x57, 0x15, 0x5c, 0xc3, 0xa6, 0x29, 0x10, 0x6a, 0x15, 0xfc, 0x22, 0x23, 0x65, 0xc7, 0xd0, 0x9f,
+	0xfa, 0xf3, 0xc9, 0xe2, 0x0b, 0x7d, 0x9d, 0x93, 0x3a, 0xe7, 0xea, 0xed, 0xe1, 0xfe, 0xbb, 0x97,
+	0xf6, 0xee, 0xe0, 0x33, 0x19, 0xf2, 0xdc, 0xb0, 0xc3, 0x37, 0x53, 0x7f, 0x3e, 0x4e, 0xdd, 0x36,
+	0xd3, 0xe4, 0x53, 0x87, 0xf9, 0xc3, 0xd5, 0xa6, 0x42, 0xa9, 0xb0, 0xb6, 0xc0, 0x60, 0x49, 0x26,
+	0x25, 0x57, 0x57, 0xca, 0x1e, 0x1d, 0x2d, 0xba, 0xa4, 0x3d, 0x3f, 0x73, 0x40, 0x52, 0x3e, 0x5d,
+	0x82, 0x90, 0x8c, 0x6a, 0xd8, 0x61, 0x0b, 0x45, 0x07, 0x7d, 0x97, 0xf6, 0xeb, 0x6c, 0x4f, 0x3e,
+	0x5c, 0x50, 0xa1, 0x30, 0xf6, 0x97, 0xb4, 0x71, 0xda, 0xaf, 0x26, 0xbc, 0x02, 0x59, 0x40, 0xdd,
+	0x87, 0xb7, 0x5b, 0x90, 0x90, 0xc1, 0x35, 0x40, 0x38, 0xe8, 0xb2, 0x7d, 0xa5, 0xb6, 0x21, 0x6a,
+	0x1a, 0xa2, 0xae, 0x21, 0xba, 0x46, 0x21, 0x5d, 0x34, 0xe3, 0x5d, 0x2d, 0x0f, 0xa7, 0xd8, 0x3f,
+	0x9e, 0x62, 0xff, 0xe1, 0x14, 0xfb, 0xb7, 0xe7, 0xd8, 0x3b, 0x9e, 0x63, 0xef, 0xee, 0x1c, 0x7b,
+	0xff, 0x7f, 0x94, 0x42, 0x6f, 0x9b, 0x8c, 0xe6, 0xb8, 0x63, 0x7f, 0xbb, 0x5f, 0xae, 0xb7, 0x5c,
+	0x48, 0xe6, 0x3a, 0x6a, 0x17, 0x6c, 0xdf, 0x15, 0x95, 0x0d, 0xbb, 0x82, 0x7e, 0x3e, 0x0e, 0x00,
+	0x28, 0x95, 0xfc, 0x9c, 0x3e, 0x02, 0x00, 0x00,
 }
 
 func (m *EventUpdateSudoers) Marshal() (dAtA []byte, err error) {
@@ -143,6 +274,96 @@ func (m *EventUpdateSudoers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventGasSponsorUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGasSponsorUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGasSponsorUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Removed {
+		i--
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.GasSponsor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGasSponsored) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGasSponsored) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGasSponsored) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -169,6 +390,39 @@ func (m *EventUpdateSudoers) Size() (n int) {
 	return n
 }
 
+func (m *EventGasSponsorUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasSponsor.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Removed {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventGasSponsored) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -290,6 +544,256 @@ func (m *EventUpdateSudoers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventGasSponsorUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGasSponsorUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGasSponsorUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSponsor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasSponsor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Removed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGasSponsored) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGasSponsored: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGasSponsored: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,26 @@
+package sudo
+
+import (
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+)
+
+// BankKeeper moves the funds escrowed by gas sponsors in the x/sudo module
+// account.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(
+		ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+	) error
+	SendCoinsFromModuleToAccount(
+		ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+	) error
+	SendCoinsFromModuleToModule(
+		ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins,
+	) error
+}
+
+// ContractOwnerKeeper tells whether an account controls a contract. Only the
+// owner of a contract can sponsor gas for it, so that no one can claim a
+// contract's sponsorship ahead of its team.
+type ContractOwnerKeeper interface {
+	IsContractOwner(ctx sdk.Context, contract, owner sdk.AccAddress) bool
+}
@@ -0,0 +1,144 @@
+package sudo
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/v2/app/appconst"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/nutil/set"
+)
+
+// GasSponsorDenom is the only denomination gas sponsors can escrow. Both EVM
+// and Cosmos transaction fees on Nibiru are paid in "unibi".
+const GasSponsorDenom = appconst.DENOM_UNIBI
+
+// GasSponsorContractAddr parses a sponsored contract address given as EVM hex
+// or Nibiru Bech32. Both formats map to the same [sdk.AccAddress], so an EVM
+// contract can be referenced either way.
+func GasSponsorContractAddr(contract string) (sdk.AccAddress, error) {
+	return eth.NibiruAddrFromStr(contract)
+}
+
+// GasSponsorDay returns the number of whole days between the Unix epoch and
+// blockTime. Daily caps reset when this value changes.
+func GasSponsorDay(blockTime time.Time) uint64 {
+	unix := blockTime.Unix()
+	if unix < 0 {
+		return 0
+	}
+	return uint64(unix / int64(24*time.Hour/time.Second))
+}
+
+// Validate performs stateless validation of the policy.
+func (policy GasSponsorPolicy) Validate() error {
+	if len(policy.Contracts) == 0 {
+		return ErrGasSponsor("policy must include at least one contract")
+	}
+	seenContracts := set.New[string]()
+	for _, contract := range policy.Contracts {
+		addr, err := GasSponsorContractAddr(contract)
+		if err != nil {
+			return ErrGasSponsor("contract addr: " + err.Error())
+		}
+		if seenContracts.Has(addr.String()) {
+			return ErrGasSponsor("duplicate contract: " + contract)
+		}
+		seenContracts.Add(addr.String())
+	}
+	for _, sender := range policy.Senders {
+		if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+			return ErrGasSponsor("sender addr: " + err.Error())
+		}
+	}
+	if !policy.DailyCapPerSender.IsNil() && policy.DailyCapPerSender.IsNegative() {
+		return ErrGasSponsor("daily cap per sender must be non-negative")
+	}
+	if policy.MaxFeePerTx.IsNil() || !policy.MaxFeePerTx.IsPositive() {
+		return ErrGasSponsor("max fee per tx must be positive")
+	}
+	if policy.ExpiresAt < 0 {
+		return ErrGasSponsor("expires at must be non-negative")
+	}
+	return nil
+}
+
+// IsExpired returns true if the policy stopped paying for gas at blockTime.
+func (policy GasSponsorPolicy) IsExpired(blockTime time.Time) bool {
+	return policy.ExpiresAt != 0 && blockTime.Unix() >= policy.ExpiresAt
+}
+
+// CoversSender returns true if the sender allowlist is empty or contains
+// sender.
+func (policy GasSponsorPolicy) CoversSender(sender sdk.AccAddress) bool {
+	if len(policy.Senders) == 0 {
+		return true
+	}
+	for _, allowed := range policy.Senders {
+		if allowed == sender.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate performs stateless validation of the gas sponsor.
+func (gs GasSponsor) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(gs.Sponsor); err != nil {
+		return ErrGasSponsor("sponsor addr: " + err.Error())
+	}
+	if gs.Balance.IsNil() || gs.Balance.IsNegative() {
+		return ErrGasSponsor("balance must be non-negative")
+	}
+	return gs.Policy.Validate()
+}
+
+// Validate performs stateless validation of the spend record.
+func (spend GasSponsorSpend) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(spend.Sponsor); err != nil {
+		return ErrGasSponsor("sponsor addr: " + err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(spend.Sender); err != nil {
+		return ErrGasSponsor("sender addr: " + err.Error())
+	}
+	if spend.Spent.IsNil() || spend.Spent.IsNegative() {
+		return ErrGasSponsor("spent must be non-negative")
+	}
+	return nil
+}
+
+// ValidateGasSponsors checks that each gas sponsor is valid, that no account
+// owns more than one sponsorship, and that no contract has more than one
+// sponsor.
+func ValidateGasSponsors(sponsors []GasSponsor) error {
+	seenSponsors := set.New[string]()
+	contractSponsor := make(map[string]string)
+	for _, gs := range sponsors {
+		if err := gs.Validate(); err != nil {
+			return err
+		}
+		if seenSponsors.Has(gs.Sponsor) {
+			return ErrGasSponsor("duplicate sponsor: " + gs.Sponsor)
+		}
+		seenSponsors.Add(gs.Sponsor)
+		for _, contract := range gs.Policy.Contracts {
+			addr, _ := GasSponsorContractAddr(contract)
+			if other, ok := contractSponsor[addr.String()]; ok {
+				return ErrGasSponsor(fmt.Sprintf(
+					"contract %s is sponsored by both %s and %s", contract, other, gs.Sponsor,
+				))
+			}
+			contractSponsor[addr.String()] = gs.Sponsor
+		}
+	}
+	return nil
+}
+
+// NewGasSponsorCoin returns amount as a [sdk.Coin] in [GasSponsorDenom].
+func NewGasSponsorCoin(amount sdkmath.Int) sdk.Coin {
+	return sdk.NewCoin(GasSponsorDenom, amount)
+}
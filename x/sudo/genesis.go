@@ -24,6 +24,14 @@ func (gen *GenesisState) Validate() error {
 			return ErrGenesis(err.Error())
 		}
 	}
+	if err := ValidateGasSponsors(gen.GasSponsors); err != nil {
+		return ErrGenesis(err.Error())
+	}
+	for _, spend := range gen.GasSponsorSpends {
+		if err := spend.Validate(); err != nil {
+			return ErrGenesis(err.Error())
+		}
+	}
 	return nil
 }
 
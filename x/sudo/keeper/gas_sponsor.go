@@ -0,0 +1,307 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdkioerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	authtypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/auth/types"
+
+	"github.com/NibiruChain/nibiru/v2/x/collections"
+	"github.com/NibiruChain/nibiru/v2/x/sudo"
+)
+
+// SetBankKeeper sets the bank keeper used to escrow gas sponsor deposits. The
+// sudo keeper is created before the bank keeper is injected, so the app sets
+// it afterward.
+func (k *Keeper) SetBankKeeper(bankKeeper sudo.BankKeeper) {
+	k.bankKeeper = bankKeeper
+}
+
+// SetContractOwnerKeeper sets the keeper that checks who owns a sponsored
+// contract. Like the bank keeper, it is created after the sudo keeper, so the
+// app sets it afterward.
+func (k *Keeper) SetContractOwnerKeeper(contractOwnerKeeper sudo.ContractOwnerKeeper) {
+	k.contractOwnerKeeper = contractOwnerKeeper
+}
+
+// GasSponsorEscrowAddr returns the address of the x/sudo module account, which
+// holds the funds of every gas sponsor.
+func (k Keeper) GasSponsorEscrowAddr() sdk.AccAddress {
+	return authtypes.NewModuleAddress(sudo.ModuleName)
+}
+
+// SetGasSponsor creates or updates the gas sponsor owned by the sender and
+// escrows the optional deposit. The sender must own every contract in the
+// policy: the Wasm admin (or creator if no admin is set), or the EVM deployer
+// registered in x/devgas.
+func (k Keeper) SetGasSponsor(
+	goCtx context.Context, msg *sudo.MsgSetGasSponsor,
+) (*sudo.MsgSetGasSponsorResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sponsor := msg.GetSigners()[0]
+
+	gs, err := k.GasSponsors.Get(ctx, sponsor)
+	if err != nil {
+		gs = sudo.GasSponsor{
+			Sponsor: sponsor.String(),
+			Balance: sdkmath.ZeroInt(),
+		}
+	}
+
+	if k.contractOwnerKeeper == nil {
+		return nil, sudo.ErrGasSponsor("contract owner keeper is not set")
+	}
+	// Each contract has at most one sponsor so that fee payment is
+	// deterministic. Only the contract's owner can claim it.
+	for _, contract := range msg.Policy.Contracts {
+		contractAddr, _ := sudo.GasSponsorContractAddr(contract)
+		if !k.contractOwnerKeeper.IsContractOwner(ctx, contractAddr, sponsor) {
+			return nil, sudo.ErrGasSponsor(fmt.Sprintf(
+				"%s does not own contract %s", sponsor, contract,
+			))
+		}
+		current, err := k.GasSponsorContracts.Get(ctx, contractAddr)
+		if err == nil && !current.Equals(sponsor) {
+			return nil, sudo.ErrGasSponsor(fmt.Sprintf(
+				"contract %s is already sponsored by %s", contract, current,
+			))
+		}
+	}
+	k.unindexGasSponsorContracts(ctx, gs)
+
+	if !msg.Deposit.Amount.IsNil() && msg.Deposit.IsPositive() {
+		if k.bankKeeper == nil {
+			return nil, sudo.ErrGasSponsor("bank keeper is not set")
+		}
+		err = k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx, sponsor, sudo.ModuleName, sdk.NewCoins(msg.Deposit),
+		)
+		if err != nil {
+			return nil, sdkioerrors.Wrap(err, "failed to escrow gas sponsor deposit")
+		}
+		gs.Balance = gs.Balance.Add(msg.Deposit.Amount)
+	}
+
+	gs.Policy = msg.Policy
+	gs = k.setGasSponsor(ctx, gs)
+
+	return &sudo.MsgSetGasSponsorResponse{GasSponsor: gs},
+		ctx.EventManager().EmitTypedEvent(&sudo.EventGasSponsorUpdate{
+			GasSponsor: gs,
+		})
+}
+
+// RemoveGasSponsor deletes the gas sponsor owned by the sender and refunds its
+// escrowed balance.
+func (k Keeper) RemoveGasSponsor(
+	goCtx context.Context, msg *sudo.MsgRemoveGasSponsor,
+) (*sudo.MsgRemoveGasSponsorResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sponsor := msg.GetSigners()[0]
+
+	gs, err := k.GasSponsors.Get(ctx, sponsor)
+	if err != nil {
+		return nil, sudo.ErrGasSponsor("no gas sponsor found for " + msg.Sender)
+	}
+
+	refund := sudo.NewGasSponsorCoin(gs.Balance)
+	if refund.IsPositive() {
+		if k.bankKeeper == nil {
+			return nil, sudo.ErrGasSponsor("bank keeper is not set")
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, sudo.ModuleName, sponsor, sdk.NewCoins(refund),
+		)
+		if err != nil {
+			return nil, sdkioerrors.Wrap(err, "failed to refund gas sponsor balance")
+		}
+	}
+
+	k.unindexGasSponsorContracts(ctx, gs)
+	spends := k.GasSponsorSpends.Iterate(
+		ctx,
+		collections.PairRange[sdk.AccAddress, sdk.AccAddress]{}.Prefix(sponsor),
+	).Keys()
+	for _, key := range spends {
+		_ = k.GasSponsorSpends.Delete(ctx, key)
+	}
+	_ = k.GasSponsors.Delete(ctx, sponsor)
+
+	gs.Balance = sdkmath.ZeroInt()
+	return &sudo.MsgRemoveGasSponsorResponse{Refund: refund},
+		ctx.EventManager().EmitTypedEvent(&sudo.EventGasSponsorUpdate{
+			GasSponsor: gs,
+			Removed:    true,
+		})
+}
+
+// setGasSponsor stores gs and indexes its sponsored contracts. A missing daily
+// cap is stored as zero (no cap).
+func (k Keeper) setGasSponsor(ctx sdk.Context, gs sudo.GasSponsor) sudo.GasSponsor {
+	if gs.Policy.DailyCapPerSender.IsNil() {
+		gs.Policy.DailyCapPerSender = sdkmath.ZeroInt()
+	}
+	sponsor := sdk.MustAccAddressFromBech32(gs.Sponsor)
+	k.GasSponsors.Insert(ctx, sponsor, gs)
+	for _, contract := range gs.Policy.Contracts {
+		contractAddr, _ := sudo.GasSponsorContractAddr(contract)
+		k.GasSponsorContracts.Insert(ctx, contractAddr, sponsor)
+	}
+	return gs
+}
+
+func (k Keeper) unindexGasSponsorContracts(ctx sdk.Context, gs sudo.GasSponsor) {
+	for _, contract := range gs.Policy.Contracts {
+		contractAddr, err := sudo.GasSponsorContractAddr(contract)
+		if err != nil {
+			continue
+		}
+		_ = k.GasSponsorContracts.Delete(ctx, contractAddr)
+	}
+}
+
+// GetGasSponsor returns the sponsor paying for transactions from sender to
+// contract. The sponsor must exist, must not be expired, must cover the
+// sender, and must have a positive escrowed balance. Daily caps are enforced
+// when the fee is charged with [Keeper.ChargeGasSponsor].
+func (k Keeper) GetGasSponsor(
+	ctx sdk.Context, sender, contract sdk.AccAddress,
+) (sponsor sdk.AccAddress, ok bool) {
+	sponsor, err := k.GasSponsorContracts.Get(ctx, contract)
+	if err != nil {
+		return nil, false
+	}
+	gs, err := k.GasSponsors.Get(ctx, sponsor)
+	if err != nil {
+		return nil, false
+	}
+	if gs.Policy.IsExpired(ctx.BlockTime()) ||
+		!gs.Policy.CoversSender(sender) ||
+		!gs.Balance.IsPositive() {
+		return nil, false
+	}
+	return sponsor, true
+}
+
+// ChargeGasSponsor debits fee (unibi) from the escrowed balance of sponsor on
+// behalf of sender. It only updates the sponsor's bookkeeping. The caller moves
+// the funds out of the escrow account.
+//
+// This function will fail if:
+//   - the sponsor does not exist
+//   - the fee exceeds the sponsor's max fee per tx
+//   - the escrowed balance is lower than fee
+//   - the fee would exceed the sponsor's daily cap for sender
+func (k Keeper) ChargeGasSponsor(
+	ctx sdk.Context, sponsor, sender sdk.AccAddress, fee sdkmath.Int,
+) error {
+	gs, err := k.GasSponsors.Get(ctx, sponsor)
+	if err != nil {
+		return sudo.ErrGasSponsor("no gas sponsor found for " + sponsor.String())
+	}
+	if maxFee := gs.Policy.MaxFeePerTx; !maxFee.IsNil() && fee.GT(maxFee) {
+		return sudo.ErrGasSponsor(fmt.Sprintf(
+			"fee exceeds max fee per tx: max=%s%s, fee=%s%s",
+			maxFee, sudo.GasSponsorDenom, fee, sudo.GasSponsorDenom,
+		))
+	}
+	if gs.Balance.LT(fee) {
+		return sudo.ErrGasSponsor(fmt.Sprintf(
+			"insufficient sponsor balance: balance=%s%s, fee=%s%s",
+			gs.Balance, sudo.GasSponsorDenom, fee, sudo.GasSponsorDenom,
+		))
+	}
+
+	spend := k.gasSponsorSpendToday(ctx, sponsor, sender)
+	spent := spend.Spent.Add(fee)
+	dailyCap := gs.Policy.DailyCapPerSender
+	if dailyCap.IsPositive() && spent.GT(dailyCap) {
+		return sudo.ErrGasSponsor(fmt.Sprintf(
+			"daily cap per sender exceeded: cap=%s%s, spent=%s%s, fee=%s%s",
+			dailyCap, sudo.GasSponsorDenom,
+			spend.Spent, sudo.GasSponsorDenom,
+			fee, sudo.GasSponsorDenom,
+		))
+	}
+
+	gs.Balance = gs.Balance.Sub(fee)
+	k.GasSponsors.Insert(ctx, sponsor, gs)
+	spend.Spent = spent
+	k.GasSponsorSpends.Insert(ctx, collections.Join(sponsor, sender), spend)
+
+	return ctx.EventManager().EmitTypedEvent(&sudo.EventGasSponsored{
+		Sponsor: sponsor.String(),
+		Sender:  sender.String(),
+		Fee:     sudo.NewGasSponsorCoin(fee),
+	})
+}
+
+// RefundGasSponsor credits unused fees back to the escrowed balance of
+// sponsor and lowers the daily spend recorded for sender. The caller moves
+// the funds back into the escrow account.
+func (k Keeper) RefundGasSponsor(
+	ctx sdk.Context, sponsor, sender sdk.AccAddress, refund sdkmath.Int,
+) {
+	if !refund.IsPositive() {
+		return
+	}
+	gs, err := k.GasSponsors.Get(ctx, sponsor)
+	if err != nil {
+		return
+	}
+	gs.Balance = gs.Balance.Add(refund)
+	k.GasSponsors.Insert(ctx, sponsor, gs)
+
+	spend := k.gasSponsorSpendToday(ctx, sponsor, sender)
+	spend.Spent = sdkmath.MaxInt(spend.Spent.Sub(refund), sdkmath.ZeroInt())
+	k.GasSponsorSpends.Insert(ctx, collections.Join(sponsor, sender), spend)
+}
+
+// DeductGasSponsorFee charges fee to sponsor with [Keeper.ChargeGasSponsor] and
+// sends it from the escrow account to the fee collector. Used for Cosmos
+// transactions, where fees are not refunded.
+func (k Keeper) DeductGasSponsorFee(
+	ctx sdk.Context, sponsor, sender sdk.AccAddress, fee sdkmath.Int,
+) error {
+	if k.bankKeeper == nil {
+		return sudo.ErrGasSponsor("bank keeper is not set")
+	}
+	if err := k.ChargeGasSponsor(ctx, sponsor, sender, fee); err != nil {
+		return err
+	}
+	if !fee.IsPositive() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToModule(
+		ctx, sudo.ModuleName, authtypes.FeeCollectorName,
+		sdk.NewCoins(sudo.NewGasSponsorCoin(fee)),
+	)
+}
+
+// gasSponsorSpendToday returns the spend record of sender for the current
+// block day, starting from zero when the stored record is from an earlier day.
+func (k Keeper) gasSponsorSpendToday(
+	ctx sdk.Context, sponsor, sender sdk.AccAddress,
+) sudo.GasSponsorSpend {
+	day := sudo.GasSponsorDay(ctx.BlockTime())
+	spend, err := k.GasSponsorSpends.Get(ctx, collections.Join(sponsor, sender))
+	if err != nil || spend.Day != day {
+		return sudo.GasSponsorSpend{
+			Sponsor: sponsor.String(),
+			Sender:  sender.String(),
+			Day:     day,
+			Spent:   sdkmath.ZeroInt(),
+		}
+	}
+	return spend
+}
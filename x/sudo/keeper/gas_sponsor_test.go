@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
+	authtypes "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/x/auth/types"
+
+	"github.com/NibiruChain/nibiru/v2/app"
+	"github.com/NibiruChain/nibiru/v2/eth"
+	devgastypes "github.com/NibiruChain/nibiru/v2/x/devgas/v1/types"
+	"github.com/NibiruChain/nibiru/v2/x/nutil/testapp"
+	"github.com/NibiruChain/nibiru/v2/x/nutil/testutil"
+	"github.com/NibiruChain/nibiru/v2/x/sudo"
+)
+
+func unibi(amount int64) sdk.Coin {
+	return sdk.NewInt64Coin(sudo.GasSponsorDenom, amount)
+}
+
+// setContractDeployer registers deployer as the owner of contract in x/devgas.
+func setContractDeployer(
+	nibiru *app.NibiruApp, ctx sdk.Context, contract, deployer sdk.AccAddress,
+) {
+	nibiru.DevGasKeeper.SetFeeShare(ctx, devgastypes.NewFeeShare(contract, deployer, deployer))
+}
+
+func (s *Suite) TestGasSponsor_SetAndRemove() {
+	nibiru, k, ctx := setup()
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	sponsor := testutil.NewAccAddress()
+	otherSponsor := testutil.NewAccAddress()
+	for _, addr := range []sdk.AccAddress{sponsor, otherSponsor} {
+		s.Require().NoError(testapp.FundAccount(
+			nibiru.BankKeeper, ctx, addr, sdk.NewCoins(unibi(1_000_000)),
+		))
+	}
+	evmContract := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	wasmContract := testutil.NewAccAddress()
+	policy := sudo.GasSponsorPolicy{
+		Contracts:         []string{evmContract.Hex(), wasmContract.String()},
+		DailyCapPerSender: sdkmath.NewInt(500),
+		MaxFeePerTx:       sdkmath.NewInt(100),
+	}
+
+	s.T().Log("Only the owner of a contract can sponsor it")
+	_, err := k.SetGasSponsor(goCtx, &sudo.MsgSetGasSponsor{
+		Sender:  sponsor.String(),
+		Policy:  policy,
+		Deposit: unibi(400_000),
+	})
+	s.Require().ErrorContains(err, "does not own contract")
+	setContractDeployer(nibiru, ctx, eth.EthAddrToNibiruAddr(evmContract), sponsor)
+	setContractDeployer(nibiru, ctx, wasmContract, sponsor)
+
+	s.T().Log("Policies must cap the fee paid per tx")
+	_, err = k.SetGasSponsor(goCtx, &sudo.MsgSetGasSponsor{
+		Sender: sponsor.String(),
+		Policy: sudo.GasSponsorPolicy{Contracts: policy.Contracts},
+	})
+	s.Require().ErrorContains(err, "max fee per tx must be positive")
+
+	s.T().Log("Create the sponsor with a deposit")
+	resp, err := k.SetGasSponsor(goCtx, &sudo.MsgSetGasSponsor{
+		Sender:  sponsor.String(),
+		Policy:  policy,
+		Deposit: unibi(400_000),
+	})
+	s.Require().NoError(err)
+	s.Equal(sdkmath.NewInt(400_000), resp.GasSponsor.Balance)
+	s.Equal(
+		unibi(600_000),
+		nibiru.BankKeeper.GetBalance(ctx, sponsor, sudo.GasSponsorDenom),
+	)
+	s.Equal(
+		unibi(400_000),
+		nibiru.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(sudo.ModuleName), sudo.GasSponsorDenom),
+	)
+
+	s.T().Log("EVM contracts resolve by their Bech32 address too")
+	got, ok := k.GetGasSponsor(ctx, testutil.NewAccAddress(), eth.EthAddrToNibiruAddr(evmContract))
+	s.Require().True(ok)
+	s.Equal(sponsor, got)
+
+	s.T().Log("A contract can only have one sponsor")
+	otherPolicy := sudo.GasSponsorPolicy{
+		Contracts:   []string{wasmContract.String()},
+		MaxFeePerTx: sdkmath.NewInt(100),
+	}
+	_, err = k.SetGasSponsor(goCtx, &sudo.MsgSetGasSponsor{
+		Sender: otherSponsor.String(),
+		Policy: otherPolicy,
+	})
+	s.Require().ErrorContains(err, "does not own contract")
+	setContractDeployer(nibiru, ctx, wasmContract, otherSponsor)
+	_, err = k.SetGasSponsor(goCtx, &sudo.MsgSetGasSponsor{
+		Sender: otherSponsor.String(),
+		Policy: otherPolicy,
+	})
+	s.Require().ErrorContains(err, "already sponsored")
+
+	s.T().Log("Top up and narrow the policy")
+	resp, err = k.SetGasSponsor(goCtx, &sudo.MsgSetGasSponsor{
+		Sender: sponsor.String(),
+		Policy: sudo.GasSponsorPolicy{
+			Contracts:   []string{evmContract.Hex()},
+			MaxFeePerTx: sdkmath.NewInt(100),
+		},
+		Deposit: unibi(100_000),
+	})
+	s.Require().NoError(err)
+	s.Equal(sdkmath.NewInt(500_000), resp.GasSponsor.Balance)
+	s.True(resp.GasSponsor.Policy.DailyCapPerSender.IsZero())
+	_, ok = k.GetGasSponsor(ctx, testutil.NewAccAddress(), wasmContract)
+	s.False(ok, "dropped contracts are no longer sponsored")
+
+	queryResp, err := k.QueryGasSponsors(goCtx, nil)
+	s.Require().NoError(err)
+	s.Require().Len(queryResp.GasSponsors, 1)
+	s.Equal(sponsor.String(), queryResp.GasSponsors[0].Sponsor)
+
+	s.T().Log("Remove refunds the escrow")
+	removeResp, err := k.RemoveGasSponsor(goCtx, &sudo.MsgRemoveGasSponsor{
+		Sender: sponsor.String(),
+	})
+	s.Require().NoError(err)
+	s.Equal(unibi(500_000), removeResp.Refund)
+	s.Equal(
+		unibi(1_000_000),
+		nibiru.BankKeeper.GetBalance(ctx, sponsor, sudo.GasSponsorDenom),
+	)
+	_, err = k.QueryGasSponsor(goCtx, &sudo.QueryGasSponsorRequest{Sponsor: sponsor.String()})
+	s.Require().ErrorContains(err, "no gas sponsor found")
+	_, ok = k.GetGasSponsor(ctx, testutil.NewAccAddress(), eth.EthAddrToNibiruAddr(evmContract))
+	s.False(ok)
+}
+
+func (s *Suite) TestGasSponsor_ChargeAndRefund() {
+	nibiru, k, ctx := setup()
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	sponsor := testutil.NewAccAddress()
+	sender := testutil.NewAccAddress()
+	contract := testutil.NewAccAddress()
+	s.Require().NoError(testapp.FundAccount(
+		nibiru.BankKeeper, ctx, sponsor, sdk.NewCoins(unibi(10_000)),
+	))
+	setContractDeployer(nibiru, ctx, contract, sponsor)
+	_, err := k.SetGasSponsor(goCtx, &sudo.MsgSetGasSponsor{
+		Sender: sponsor.String(),
+		Policy: sudo.GasSponsorPolicy{
+			Contracts:         []string{contract.String()},
+			Senders:           []string{sender.String()},
+			DailyCapPerSender: sdkmath.NewInt(1_000),
+			MaxFeePerTx:       sdkmath.NewInt(4_000),
+			ExpiresAt:         ctx.BlockTime().Add(48 * time.Hour).Unix(),
+		},
+		Deposit: unibi(5_000),
+	})
+	s.Require().NoError(err)
+
+	_, ok := k.GetGasSponsor(ctx, testutil.NewAccAddress(), contract)
+	s.False(ok, "senders outside the allowlist are not covered")
+	_, ok = k.GetGasSponsor(ctx, sender, contract)
+	s.True(ok)
+
+	s.T().Log("Charges count toward the daily cap and refunds free it up")
+	s.Require().NoError(k.ChargeGasSponsor(ctx, sponsor, sender, sdkmath.NewInt(800)))
+	s.Require().ErrorContains(
+		k.ChargeGasSponsor(ctx, sponsor, sender, sdkmath.NewInt(300)),
+		"daily cap per sender exceeded",
+	)
+	k.RefundGasSponsor(ctx, sponsor, sender, sdkmath.NewInt(200))
+	s.Require().NoError(k.ChargeGasSponsor(ctx, sponsor, sender, sdkmath.NewInt(400)))
+
+	gs, err := k.GasSponsors.Get(ctx, sponsor)
+	s.Require().NoError(err)
+	s.Equal(sdkmath.NewInt(5_000-800+200-400), gs.Balance)
+
+	s.T().Log("The cap resets the next day")
+	nextDay := ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	s.Require().NoError(k.ChargeGasSponsor(nextDay, sponsor, sender, sdkmath.NewInt(1_000)))
+
+	s.T().Log("Fees above the max fee per tx or the escrowed balance fail")
+	dayAfter := nextDay.WithBlockTime(nextDay.BlockTime().Add(24 * time.Hour))
+	s.Require().ErrorContains(
+		k.ChargeGasSponsor(dayAfter, sponsor, sender, sdkmath.NewInt(4_001)),
+		"fee exceeds max fee per tx",
+	)
+	s.Require().ErrorContains(
+		k.ChargeGasSponsor(dayAfter, sponsor, sender, sdkmath.NewInt(4_000)),
+		"insufficient sponsor balance",
+	)
+
+	s.T().Log("Expired sponsors stop paying")
+	expired := ctx.WithBlockTime(ctx.BlockTime().Add(48 * time.Hour))
+	_, ok = k.GetGasSponsor(expired, sender, contract)
+	s.False(ok)
+
+	s.T().Log("Cosmos fees move from the escrow to the fee collector")
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	feesBefore := nibiru.BankKeeper.GetBalance(ctx, feeCollector, sudo.GasSponsorDenom)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(72 * time.Hour))
+	s.Require().NoError(k.DeductGasSponsorFee(ctx, sponsor, sender, sdkmath.NewInt(100)))
+	s.Equal(
+		feesBefore.AddAmount(sdkmath.NewInt(100)),
+		nibiru.BankKeeper.GetBalance(ctx, feeCollector, sudo.GasSponsorDenom),
+	)
+}
@@ -19,6 +19,19 @@ type Keeper struct {
 	Sudoers                collections.Item[sudo.Sudoers]
 	ZeroGasActors          collections.Item[sudo.ZeroGasActors]
 	WasmBlockHooksContract collections.Item[string]
+
+	// GasSponsors: Gas sponsors keyed by the sponsor address.
+	GasSponsors collections.Map[sdk.AccAddress, sudo.GasSponsor]
+	// GasSponsorContracts: Index from a sponsored contract to its sponsor.
+	GasSponsorContracts collections.Map[sdk.AccAddress, sdk.AccAddress]
+	// GasSponsorSpends: Daily spend of each gas sponsor on each sender, keyed
+	// by (sponsor, sender).
+	GasSponsorSpends collections.Map[
+		collections.Pair[sdk.AccAddress, sdk.AccAddress], sudo.GasSponsorSpend,
+	]
+
+	bankKeeper          sudo.BankKeeper
+	contractOwnerKeeper sudo.ContractOwnerKeeper
 }
 
 func NewKeeper(
@@ -41,6 +54,26 @@ func NewKeeper(
 			sudo.NamespaceWasmBlockHooksContract,
 			nutil.StringValueEncoder,
 		),
+		GasSponsors: collections.NewMap(
+			storeKey,
+			sudo.NamespaceGasSponsors,
+			collections.AccAddressKeyEncoder,
+			collections.ProtoValueEncoder[sudo.GasSponsor](cdc),
+		),
+		GasSponsorContracts: collections.NewMap(
+			storeKey,
+			sudo.NamespaceGasSponsorContracts,
+			collections.AccAddressKeyEncoder,
+			collections.AccAddressValueEncoder,
+		),
+		GasSponsorSpends: collections.NewMap(
+			storeKey,
+			sudo.NamespaceGasSponsorSpends,
+			collections.PairKeyEncoder(
+				collections.AccAddressKeyEncoder, collections.AccAddressKeyEncoder,
+			),
+			collections.ProtoValueEncoder[sudo.GasSponsorSpend](cdc),
+		),
 	}
 }
 
@@ -214,6 +247,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState sudo.GenesisState) {
 		k.ZeroGasActors.Set(ctx, *genState.ZeroGasActors)
 	}
 	k.WasmBlockHooksContract.Set(ctx, genState.WasmBlockHooksContract)
+	for _, gs := range genState.GasSponsors {
+		k.setGasSponsor(ctx, gs)
+	}
+	for _, spend := range genState.GasSponsorSpends {
+		k.GasSponsorSpends.Insert(ctx, collections.Join(
+			sdk.MustAccAddressFromBech32(spend.Sponsor),
+			sdk.MustAccAddressFromBech32(spend.Sender),
+		), spend)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis state.
@@ -231,5 +273,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *sudo.GenesisState {
 		Sudoers:                pbSudoers,
 		ZeroGasActors:          &zeroGasActors,
 		WasmBlockHooksContract: k.WasmBlockHooksContract.GetOr(ctx, ""),
+		GasSponsors: k.GasSponsors.Iterate(
+			ctx, collections.Range[sdk.AccAddress]{},
+		).Values(),
+		GasSponsorSpends: k.GasSponsorSpends.Iterate(
+			ctx, collections.PairRange[sdk.AccAddress, sdk.AccAddress]{},
+		).Values(),
 	}
 }
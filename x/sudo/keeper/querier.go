@@ -8,6 +8,7 @@ import (
 	sdk "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/v2/eth"
+	"github.com/NibiruChain/nibiru/v2/x/collections"
 	"github.com/NibiruChain/nibiru/v2/x/sudo"
 )
 
@@ -74,3 +75,31 @@ func (k Keeper) QueryZeroGasActors(
 		Actors: k.GetZeroGasActors(ctx),
 	}, nil
 }
+
+func (k Keeper) QueryGasSponsor(
+	goCtx context.Context,
+	req *sudo.QueryGasSponsorRequest,
+) (resp *sudo.QueryGasSponsorResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, err
+	}
+	gs, err := k.GasSponsors.Get(ctx, sponsor)
+	if err != nil {
+		return nil, sudo.ErrGasSponsor("no gas sponsor found for " + req.Sponsor)
+	}
+	return &sudo.QueryGasSponsorResponse{GasSponsor: gs}, nil
+}
+
+func (k Keeper) QueryGasSponsors(
+	goCtx context.Context,
+	_ *sudo.QueryGasSponsorsRequest,
+) (resp *sudo.QueryGasSponsorsResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &sudo.QueryGasSponsorsResponse{
+		GasSponsors: k.GasSponsors.Iterate(
+			ctx, collections.Range[sdk.AccAddress]{},
+		).Values(),
+	}, nil
+}
@@ -11,4 +11,7 @@ var (
 	NamespaceSudoers                collections.Namespace = 1
 	NamespaceZeroGasActors          collections.Namespace = 2
 	NamespaceWasmBlockHooksContract collections.Namespace = 3
+	NamespaceGasSponsors            collections.Namespace = 4
+	NamespaceGasSponsorContracts    collections.Namespace = 5
+	NamespaceGasSponsorSpends       collections.Namespace = 6
 )
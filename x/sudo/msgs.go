@@ -14,6 +14,8 @@ var (
 	_ legacytx.LegacyMsg = &MsgEditSudoers{}
 	_ legacytx.LegacyMsg = &MsgChangeRoot{}
 	_ sdk.Msg            = (*MsgEditZeroGasActors)(nil)
+	_ sdk.Msg            = (*MsgSetGasSponsor)(nil)
+	_ sdk.Msg            = (*MsgRemoveGasSponsor)(nil)
 )
 
 // ----------------- "nibiru.sudo.v1.MsgEditSudoers" -----------------
@@ -197,3 +199,55 @@ func (m MsgEditZeroGasActors) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// ----------------- "nibiru.sudo.v1.MsgSetGasSponsor" -----------------
+
+// ValidateBasic performs a stateless validation check.
+func (m MsgSetGasSponsor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return ErrGasSponsor("sender addr: " + err.Error())
+	}
+	if err := m.Policy.Validate(); err != nil {
+		return err
+	}
+	if m.Deposit.Denom == "" && m.Deposit.Amount.IsNil() {
+		return nil // no deposit
+	}
+	if err := m.Deposit.Validate(); err != nil {
+		return ErrGasSponsor("deposit: " + err.Error())
+	}
+	if m.Deposit.Denom != GasSponsorDenom {
+		return ErrGasSponsor(fmt.Sprintf(
+			"deposit denom must be %s, got %s", GasSponsorDenom, m.Deposit.Denom,
+		))
+	}
+	return nil
+}
+
+// GetSigners returns the addrs of signers that must sign.
+func (m MsgSetGasSponsor) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// ----------------- "nibiru.sudo.v1.MsgRemoveGasSponsor" -----------------
+
+// ValidateBasic performs a stateless validation check.
+func (m MsgRemoveGasSponsor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return ErrGasSponsor("sender addr: " + err.Error())
+	}
+	return nil
+}
+
+// GetSigners returns the addrs of signers that must sign.
+func (m MsgRemoveGasSponsor) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
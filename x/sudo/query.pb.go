@@ -197,40 +197,230 @@ func (m *QueryZeroGasActorsResponse) GetActors() ZeroGasActors {
 	return ZeroGasActors{}
 }
 
+// QueryGasSponsorRequest is the request type for the gRPC query method,
+// "/nibiru.sudo.v1.Query/QueryGasSponsor"
+type QueryGasSponsorRequest struct {
+	// Sponsor: Nibiru Bech32 address of the gas sponsor.
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+}
+
+func (m *QueryGasSponsorRequest) Reset()         { *m = QueryGasSponsorRequest{} }
+func (m *QueryGasSponsorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasSponsorRequest) ProtoMessage()    {}
+func (*QueryGasSponsorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{4}
+}
+func (m *QueryGasSponsorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasSponsorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasSponsorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasSponsorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasSponsorRequest.Merge(m, src)
+}
+func (m *QueryGasSponsorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasSponsorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasSponsorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasSponsorRequest proto.InternalMessageInfo
+
+func (m *QueryGasSponsorRequest) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+// QueryGasSponsorResponse is the response type for the gRPC query method,
+// "/nibiru.sudo.v1.Query/QueryGasSponsor"
+type QueryGasSponsorResponse struct {
+	GasSponsor GasSponsor `protobuf:"bytes,1,opt,name=gas_sponsor,json=gasSponsor,proto3" json:"gas_sponsor"`
+}
+
+func (m *QueryGasSponsorResponse) Reset()         { *m = QueryGasSponsorResponse{} }
+func (m *QueryGasSponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasSponsorResponse) ProtoMessage()    {}
+func (*QueryGasSponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{5}
+}
+func (m *QueryGasSponsorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasSponsorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasSponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasSponsorResponse.Merge(m, src)
+}
+func (m *QueryGasSponsorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasSponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasSponsorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasSponsorResponse proto.InternalMessageInfo
+
+func (m *QueryGasSponsorResponse) GetGasSponsor() GasSponsor {
+	if m != nil {
+		return m.GasSponsor
+	}
+	return GasSponsor{}
+}
+
+// QueryGasSponsorsRequest is the request type for the gRPC query method,
+// "/nibiru.sudo.v1.Query/QueryGasSponsors"
+type QueryGasSponsorsRequest struct {
+}
+
+func (m *QueryGasSponsorsRequest) Reset()         { *m = QueryGasSponsorsRequest{} }
+func (m *QueryGasSponsorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasSponsorsRequest) ProtoMessage()    {}
+func (*QueryGasSponsorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{6}
+}
+func (m *QueryGasSponsorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasSponsorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasSponsorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasSponsorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasSponsorsRequest.Merge(m, src)
+}
+func (m *QueryGasSponsorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasSponsorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasSponsorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasSponsorsRequest proto.InternalMessageInfo
+
+// QueryGasSponsorsResponse is the response type for the gRPC query method,
+// "/nibiru.sudo.v1.Query/QueryGasSponsors"
+type QueryGasSponsorsResponse struct {
+	GasSponsors []GasSponsor `protobuf:"bytes,1,rep,name=gas_sponsors,json=gasSponsors,proto3" json:"gas_sponsors"`
+}
+
+func (m *QueryGasSponsorsResponse) Reset()         { *m = QueryGasSponsorsResponse{} }
+func (m *QueryGasSponsorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasSponsorsResponse) ProtoMessage()    {}
+func (*QueryGasSponsorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{7}
+}
+func (m *QueryGasSponsorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasSponsorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasSponsorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasSponsorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasSponsorsResponse.Merge(m, src)
+}
+func (m *QueryGasSponsorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasSponsorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasSponsorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasSponsorsResponse proto.InternalMessageInfo
+
+func (m *QueryGasSponsorsResponse) GetGasSponsors() []GasSponsor {
+	if m != nil {
+		return m.GasSponsors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuerySudoersRequest)(nil), "nibiru.sudo.v1.QuerySudoersRequest")
 	proto.RegisterType((*QuerySudoersResponse)(nil), "nibiru.sudo.v1.QuerySudoersResponse")
 	proto.RegisterType((*QueryZeroGasActorsRequest)(nil), "nibiru.sudo.v1.QueryZeroGasActorsRequest")
 	proto.RegisterType((*QueryZeroGasActorsResponse)(nil), "nibiru.sudo.v1.QueryZeroGasActorsResponse")
+	proto.RegisterType((*QueryGasSponsorRequest)(nil), "nibiru.sudo.v1.QueryGasSponsorRequest")
+	proto.RegisterType((*QueryGasSponsorResponse)(nil), "nibiru.sudo.v1.QueryGasSponsorResponse")
+	proto.RegisterType((*QueryGasSponsorsRequest)(nil), "nibiru.sudo.v1.QueryGasSponsorsRequest")
+	proto.RegisterType((*QueryGasSponsorsResponse)(nil), "nibiru.sudo.v1.QueryGasSponsorsResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/query.proto", fileDescriptor_3c5c8e03d8d77d77) }
 
 var fileDescriptor_3c5c8e03d8d77d77 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3d, 0x4f, 0x02, 0x41,
-	0x10, 0x86, 0xef, 0x88, 0x62, 0xb2, 0x1a, 0x8b, 0x15, 0xbf, 0x4e, 0x5c, 0xcd, 0x49, 0xe2, 0x47,
-	0x71, 0x1b, 0xb0, 0xb0, 0xb0, 0x02, 0x0b, 0x3b, 0x8d, 0x58, 0x49, 0x43, 0x16, 0xd8, 0x1c, 0x97,
-	0xe8, 0x0d, 0xdc, 0xee, 0xe1, 0x47, 0xe9, 0x2f, 0xd0, 0xe8, 0x8f, 0xa2, 0x24, 0xb1, 0xb1, 0x32,
-	0x06, 0xfc, 0x21, 0x86, 0xbd, 0x25, 0x72, 0xe7, 0xc5, 0xd8, 0x6d, 0xe6, 0x9d, 0x79, 0xde, 0x77,
-	0x26, 0x8b, 0x2c, 0xdf, 0x6b, 0x78, 0x41, 0x48, 0x45, 0xd8, 0x02, 0xda, 0x2b, 0xd2, 0x6e, 0xc8,
-	0x83, 0x7b, 0xa7, 0x13, 0x80, 0x04, 0xbc, 0x18, 0x69, 0xce, 0x58, 0x73, 0x7a, 0x45, 0x2b, 0xe7,
-	0x82, 0x0b, 0x4a, 0xa2, 0xe3, 0x57, 0xd4, 0x65, 0xe5, 0x5d, 0x00, 0xf7, 0x9a, 0x53, 0xd6, 0xf1,
-	0x28, 0xf3, 0x7d, 0x90, 0x4c, 0x7a, 0xe0, 0x0b, 0xad, 0x26, 0xf9, 0x42, 0x32, 0xc9, 0x23, 0xcd,
-	0x5e, 0x46, 0x4b, 0x17, 0x63, 0xbb, 0xcb, 0xb0, 0x05, 0x3c, 0x10, 0x55, 0xde, 0x0d, 0xb9, 0x90,
-	0xf6, 0x39, 0xca, 0xc5, 0xcb, 0xa2, 0x03, 0xbe, 0xe0, 0xf8, 0x08, 0xcd, 0x89, 0xa8, 0xb4, 0x66,
-	0x6e, 0x9b, 0x7b, 0xf3, 0xa5, 0x55, 0x27, 0x1e, 0xd0, 0xd1, 0x13, 0x95, 0x99, 0xfe, 0xc7, 0x96,
-	0x51, 0x9d, 0x74, 0xdb, 0x1b, 0x68, 0x5d, 0x01, 0x6b, 0x3c, 0x80, 0x53, 0x26, 0xca, 0x4d, 0x09,
-	0x3f, 0x6e, 0x57, 0xc8, 0x4a, 0x13, 0xb5, 0xe7, 0x31, 0xca, 0x32, 0x55, 0xd1, 0x96, 0x9b, 0x49,
-	0xcb, 0xd8, 0x98, 0x36, 0xd6, 0x23, 0xa5, 0xd7, 0x0c, 0x9a, 0x55, 0x6c, 0x7c, 0x8b, 0x16, 0xa6,
-	0x57, 0xc2, 0x3b, 0x49, 0x4c, 0xca, 0x1d, 0xac, 0xc2, 0xdf, 0x4d, 0x51, 0x42, 0x3b, 0xff, 0xf8,
-	0xf6, 0xf5, 0x92, 0x59, 0xc1, 0x39, 0x3a, 0x7d, 0x69, 0xbd, 0x3a, 0x7e, 0x36, 0x11, 0xfe, 0xbd,
-	0x1e, 0xde, 0x4f, 0x45, 0xa7, 0xdd, 0xc7, 0x3a, 0xf8, 0x4f, 0xab, 0xce, 0x52, 0x50, 0x59, 0x08,
-	0xce, 0xc7, 0xb2, 0x3c, 0xf0, 0x00, 0xea, 0x2e, 0x13, 0xf5, 0xe8, 0x2c, 0x95, 0x72, 0x7f, 0x48,
-	0xcc, 0xc1, 0x90, 0x98, 0x9f, 0x43, 0x62, 0x3e, 0x8d, 0x88, 0x31, 0x18, 0x11, 0xe3, 0x7d, 0x44,
-	0x8c, 0xda, 0xae, 0xeb, 0xc9, 0x76, 0xd8, 0x70, 0x9a, 0x70, 0x43, 0xcf, 0x14, 0xe1, 0xa4, 0xcd,
-	0x3c, 0x7f, 0x42, 0xeb, 0x95, 0xe8, 0x9d, 0x42, 0x36, 0xb2, 0xea, 0x03, 0x1d, 0x7e, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0x16, 0xfb, 0xdf, 0xcb, 0xbe, 0x02, 0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x63, 0x28, 0xad, 0x18, 0x57, 0x80, 0x96, 0xd0, 0x26, 0x6e, 0x30, 0xe0, 0x16, 0x5a,
+	0x40, 0xf2, 0xaa, 0xe6, 0xc0, 0x81, 0x53, 0xda, 0x43, 0x6f, 0x20, 0xd2, 0x13, 0x15, 0x52, 0xb4,
+	0x69, 0x57, 0xae, 0x25, 0xf0, 0xa4, 0xde, 0x75, 0xf8, 0x27, 0x2e, 0x9c, 0xb8, 0x01, 0xe2, 0x49,
+	0x78, 0x8b, 0x1e, 0x2b, 0x71, 0xe1, 0x84, 0x50, 0xc2, 0x83, 0x20, 0xaf, 0x37, 0x89, 0xff, 0xd1,
+	0xe4, 0xe6, 0xcc, 0xcc, 0xf7, 0x7d, 0x3f, 0xcd, 0x8e, 0x02, 0x56, 0x18, 0xf4, 0x82, 0x28, 0xa6,
+	0x22, 0x3e, 0x42, 0x3a, 0xd8, 0xa6, 0x27, 0x31, 0x8f, 0xde, 0xb9, 0xfd, 0x08, 0x25, 0x92, 0x2b,
+	0x69, 0xcf, 0x4d, 0x7a, 0xee, 0x60, 0xdb, 0xaa, 0xfb, 0xe8, 0xa3, 0x6a, 0xd1, 0xe4, 0x2b, 0x9d,
+	0xb2, 0x5a, 0x3e, 0xa2, 0xff, 0x8a, 0x53, 0xd6, 0x0f, 0x28, 0x0b, 0x43, 0x94, 0x4c, 0x06, 0x18,
+	0x0a, 0xdd, 0x2d, 0xfa, 0x0b, 0xc9, 0x24, 0x4f, 0x7b, 0xce, 0x0d, 0xb8, 0xfe, 0x3c, 0x89, 0xdb,
+	0x8f, 0x8f, 0x90, 0x47, 0xa2, 0xc3, 0x4f, 0x62, 0x2e, 0xa4, 0xf3, 0x0c, 0xea, 0xf9, 0xb2, 0xe8,
+	0x63, 0x28, 0x38, 0x79, 0x0c, 0x4b, 0x22, 0x2d, 0x35, 0x8c, 0xdb, 0xc6, 0x96, 0xe9, 0xad, 0xba,
+	0x79, 0x40, 0x57, 0x2b, 0x76, 0x16, 0x4e, 0x7f, 0xdf, 0xaa, 0x75, 0xc6, 0xd3, 0xce, 0x1a, 0x34,
+	0x95, 0xe1, 0x01, 0x8f, 0x70, 0x8f, 0x89, 0xf6, 0xa1, 0xc4, 0x69, 0xda, 0x0b, 0xb0, 0xaa, 0x9a,
+	0x3a, 0xf3, 0x09, 0x2c, 0x32, 0x55, 0xd1, 0x91, 0x37, 0x8b, 0x91, 0x39, 0x99, 0x0e, 0xd6, 0x12,
+	0xc7, 0x83, 0x15, 0x65, 0xbd, 0xc7, 0xc4, 0x7e, 0x62, 0x87, 0x91, 0x0e, 0x25, 0x0d, 0x58, 0x12,
+	0x69, 0x45, 0xf9, 0x5e, 0xee, 0x8c, 0x7f, 0x3a, 0x2f, 0x61, 0xb5, 0xa4, 0xd1, 0x2c, 0x6d, 0x30,
+	0x7d, 0x26, 0xba, 0x59, 0xa1, 0xe9, 0x59, 0x45, 0xa0, 0xa9, 0x50, 0xd3, 0x80, 0x3f, 0xa9, 0x38,
+	0xcd, 0x92, 0xfb, 0x64, 0x0f, 0x5d, 0x68, 0x94, 0x5b, 0x3a, 0x79, 0x17, 0x96, 0x33, 0xc9, 0xc9,
+	0x2e, 0x2e, 0xce, 0x15, 0x6d, 0x4e, 0xa3, 0x85, 0xf7, 0x63, 0x01, 0x2e, 0xa9, 0x04, 0xf2, 0x06,
+	0x96, 0xb3, 0x0f, 0x4c, 0xd6, 0x8b, 0x46, 0x15, 0x57, 0x61, 0x6d, 0x9c, 0x3f, 0x94, 0x92, 0x3a,
+	0xad, 0x4f, 0x3f, 0xff, 0x7e, 0xbf, 0xb0, 0x42, 0xea, 0x34, 0x7b, 0x77, 0xfa, 0x10, 0xc8, 0x37,
+	0x03, 0x48, 0xf9, 0xb1, 0xc9, 0xfd, 0x4a, 0xeb, 0xaa, 0x6b, 0xb1, 0x1e, 0xcc, 0x33, 0xaa, 0x59,
+	0x36, 0x14, 0x8b, 0x4d, 0x5a, 0x39, 0x96, 0xf7, 0x3c, 0xc2, 0x6e, 0xb2, 0xcd, 0xf4, 0x48, 0xc8,
+	0x17, 0x03, 0xae, 0x16, 0x16, 0x4f, 0xee, 0x55, 0xa6, 0x94, 0xce, 0xc8, 0xda, 0x9c, 0x39, 0xa7,
+	0x51, 0x1e, 0x2a, 0x94, 0xbb, 0x64, 0x3d, 0x87, 0x92, 0x7d, 0x53, 0xfa, 0x41, 0x7f, 0x7d, 0x24,
+	0x9f, 0x0d, 0xb8, 0x56, 0x30, 0x12, 0x64, 0x56, 0xd4, 0x64, 0x43, 0x5b, 0xb3, 0x07, 0x35, 0xd4,
+	0x1d, 0x05, 0xb5, 0x46, 0x9a, 0xff, 0x85, 0xda, 0x69, 0x9f, 0x0e, 0x6d, 0xe3, 0x6c, 0x68, 0x1b,
+	0x7f, 0x86, 0xb6, 0xf1, 0x75, 0x64, 0xd7, 0xce, 0x46, 0x76, 0xed, 0xd7, 0xc8, 0xae, 0x1d, 0x6c,
+	0xfa, 0x81, 0x3c, 0x8e, 0x7b, 0xee, 0x21, 0xbe, 0xa6, 0x4f, 0x95, 0x7c, 0xf7, 0x98, 0x05, 0xe1,
+	0xd8, 0x6a, 0xe0, 0xd1, 0xb7, 0xca, 0xaf, 0xb7, 0xa8, 0xfe, 0x6b, 0x1e, 0xfd, 0x1b, 0x00, 0x18,
+	0xb5, 0xdf, 0xd7, 0xe9, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -250,6 +440,10 @@ type QueryClient interface {
 	// a set of accounts that can execute zero gas transactions against a
 	// whitelisted  set of smart contracts.
 	QueryZeroGasActors(ctx context.Context, in *QueryZeroGasActorsRequest, opts ...grpc.CallOption) (*QueryZeroGasActorsResponse, error)
+	// QueryGasSponsor returns the "GasSponsor" owned by an account.
+	QueryGasSponsor(ctx context.Context, in *QueryGasSponsorRequest, opts ...grpc.CallOption) (*QueryGasSponsorResponse, error)
+	// QueryGasSponsors returns all gas sponsors.
+	QueryGasSponsors(ctx context.Context, in *QueryGasSponsorsRequest, opts ...grpc.CallOption) (*QueryGasSponsorsResponse, error)
 }
 
 type queryClient struct {
@@ -278,6 +472,24 @@ func (c *queryClient) QueryZeroGasActors(ctx context.Context, in *QueryZeroGasAc
 	return out, nil
 }
 
+func (c *queryClient) QueryGasSponsor(ctx context.Context, in *QueryGasSponsorRequest, opts ...grpc.CallOption) (*QueryGasSponsorResponse, error) {
+	out := new(QueryGasSponsorResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Query/QueryGasSponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryGasSponsors(ctx context.Context, in *QueryGasSponsorsRequest, opts ...grpc.CallOption) (*QueryGasSponsorsResponse, error) {
+	out := new(QueryGasSponsorsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Query/QueryGasSponsors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	QuerySudoers(context.Context, *QuerySudoersRequest) (*QuerySudoersResponse, error)
@@ -285,6 +497,10 @@ type QueryServer interface {
 	// a set of accounts that can execute zero gas transactions against a
 	// whitelisted  set of smart contracts.
 	QueryZeroGasActors(context.Context, *QueryZeroGasActorsRequest) (*QueryZeroGasActorsResponse, error)
+	// QueryGasSponsor returns the "GasSponsor" owned by an account.
+	QueryGasSponsor(context.Context, *QueryGasSponsorRequest) (*QueryGasSponsorResponse, error)
+	// QueryGasSponsors returns all gas sponsors.
+	QueryGasSponsors(context.Context, *QueryGasSponsorsRequest) (*QueryGasSponsorsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -297,6 +513,12 @@ func (*UnimplementedQueryServer) QuerySudoers(ctx context.Context, req *QuerySud
 func (*UnimplementedQueryServer) QueryZeroGasActors(ctx context.Context, req *QueryZeroGasActorsRequest) (*QueryZeroGasActorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryZeroGasActors not implemented")
 }
+func (*UnimplementedQueryServer) QueryGasSponsor(ctx context.Context, req *QueryGasSponsorRequest) (*QueryGasSponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGasSponsor not implemented")
+}
+func (*UnimplementedQueryServer) QueryGasSponsors(ctx context.Context, req *QueryGasSponsorsRequest) (*QueryGasSponsorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryGasSponsors not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -338,6 +560,42 @@ func _Query_QueryZeroGasActors_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryGasSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasSponsorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGasSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Query/QueryGasSponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGasSponsor(ctx, req.(*QueryGasSponsorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryGasSponsors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasSponsorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryGasSponsors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Query/QueryGasSponsors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryGasSponsors(ctx, req.(*QueryGasSponsorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.sudo.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -350,6 +608,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryZeroGasActors",
 			Handler:    _Query_QueryZeroGasActors_Handler,
 		},
+		{
+			MethodName: "QueryGasSponsor",
+			Handler:    _Query_QueryGasSponsor_Handler,
+		},
+		{
+			MethodName: "QueryGasSponsors",
+			Handler:    _Query_QueryGasSponsors_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/sudo/v1/query.proto",
@@ -467,6 +733,129 @@ func (m *QueryZeroGasActorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGasSponsorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasSponsorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasSponsorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasSponsorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasSponsorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasSponsorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GasSponsor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasSponsorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasSponsorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasSponsorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasSponsorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasSponsorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasSponsorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasSponsors) > 0 {
+		for iNdEx := len(m.GasSponsors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasSponsors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -518,14 +907,62 @@ func (m *QueryZeroGasActorsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryGasSponsorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryGasSponsorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasSponsor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
-func (m *QuerySudoersRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *QueryGasSponsorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGasSponsorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasSponsors) > 0 {
+		for _, e := range m.GasSponsors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySudoersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -790,6 +1227,305 @@ func (m *QueryZeroGasActorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGasSponsorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasSponsorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasSponsorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasSponsorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasSponsorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasSponsorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSponsor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasSponsor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasSponsorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasSponsorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasSponsorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasSponsorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasSponsorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasSponsorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSponsors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasSponsors = append(m.GasSponsors, GasSponsor{})
+			if err := m.GasSponsors[len(m.GasSponsors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@
 package sudo
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -83,6 +84,12 @@ type GenesisState struct {
 	Sudoers                Sudoers        `protobuf:"bytes,1,opt,name=sudoers,proto3" json:"sudoers"`
 	ZeroGasActors          *ZeroGasActors `protobuf:"bytes,2,opt,name=zero_gas_actors,json=zeroGasActors,proto3" json:"zero_gas_actors,omitempty"`
 	WasmBlockHooksContract string         `protobuf:"bytes,3,opt,name=wasm_block_hooks_contract,json=wasmBlockHooksContract,proto3" json:"wasm_block_hooks_contract,omitempty"`
+	// GasSponsors: Accounts paying gas for transactions to their sponsored
+	// contracts.
+	GasSponsors []GasSponsor `protobuf:"bytes,4,rep,name=gas_sponsors,json=gasSponsors,proto3" json:"gas_sponsors"`
+	// GasSponsorSpends: Amounts spent by gas sponsors on each sender during the
+	// current day.
+	GasSponsorSpends []GasSponsorSpend `protobuf:"bytes,5,rep,name=gas_sponsor_spends,json=gasSponsorSpends,proto3" json:"gas_sponsor_spends"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -139,6 +146,20 @@ func (m *GenesisState) GetWasmBlockHooksContract() string {
 	return ""
 }
 
+func (m *GenesisState) GetGasSponsors() []GasSponsor {
+	if m != nil {
+		return m.GasSponsors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGasSponsorSpends() []GasSponsorSpend {
+	if m != nil {
+		return m.GasSponsorSpends
+	}
+	return nil
+}
+
 // ZeroGasActors: Actors that can execute zero gas transactions against a set of
 // smart contracts.
 type ZeroGasActors struct {
@@ -207,40 +228,261 @@ func (m *ZeroGasActors) GetAlwaysZeroGasContracts() []string {
 	return nil
 }
 
+// GasSponsor: An account that escrows funds in the x/sudo module to pay the gas
+// of transactions sent to its sponsored contracts. Unlike "ZeroGasActors",
+// sponsored transactions are not free. Their fees are drawn from the sponsor's
+// escrowed balance instead of the sender's account.
+type GasSponsor struct {
+	// Sponsor: Nibiru Bech32 address of the account that owns the sponsorship.
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// Balance: Amount of "unibi" held in escrow to pay for sponsored gas.
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+	// Policy: Rules deciding which transactions the sponsor pays for.
+	Policy GasSponsorPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *GasSponsor) Reset()         { *m = GasSponsor{} }
+func (m *GasSponsor) String() string { return proto.CompactTextString(m) }
+func (*GasSponsor) ProtoMessage()    {}
+func (*GasSponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{3}
+}
+func (m *GasSponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasSponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasSponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasSponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasSponsor.Merge(m, src)
+}
+func (m *GasSponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasSponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasSponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasSponsor proto.InternalMessageInfo
+
+func (m *GasSponsor) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *GasSponsor) GetPolicy() GasSponsorPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return GasSponsorPolicy{}
+}
+
+// GasSponsorPolicy: Rules deciding which transactions a gas sponsor pays for.
+type GasSponsorPolicy struct {
+	// Contracts: Contract addresses whose transactions are sponsored. Entries
+	// may be EVM hex addresses or Wasm Bech32 addresses. A contract can have at
+	// most one sponsor.
+	Contracts []string `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// Senders: Optional allowlist of Nibiru Bech32 addresses. When empty, any
+	// sender calling the sponsored contracts is covered.
+	Senders []string `protobuf:"bytes,2,rep,name=senders,proto3" json:"senders,omitempty"`
+	// DailyCapPerSender: Maximum amount of "unibi" the sponsor pays for a single
+	// sender in one day (UTC, by block time). Zero means no cap.
+	DailyCapPerSender cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=daily_cap_per_sender,json=dailyCapPerSender,proto3,customtype=cosmossdk.io/math.Int" json:"daily_cap_per_sender"`
+	// ExpiresAt: Unix timestamp in seconds, compared with the block time, after
+	// which the sponsorship stops paying for gas. Zero means no expiry.
+	ExpiresAt int64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// MaxFeePerTx: Maximum amount of "unibi" the sponsor pays for a single
+	// transaction. Must be positive. Transactions with a higher fee are paid by
+	// the sender instead.
+	MaxFeePerTx cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_fee_per_tx,json=maxFeePerTx,proto3,customtype=cosmossdk.io/math.Int" json:"max_fee_per_tx"`
+}
+
+func (m *GasSponsorPolicy) Reset()         { *m = GasSponsorPolicy{} }
+func (m *GasSponsorPolicy) String() string { return proto.CompactTextString(m) }
+func (*GasSponsorPolicy) ProtoMessage()    {}
+func (*GasSponsorPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{4}
+}
+func (m *GasSponsorPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasSponsorPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasSponsorPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasSponsorPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasSponsorPolicy.Merge(m, src)
+}
+func (m *GasSponsorPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasSponsorPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasSponsorPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasSponsorPolicy proto.InternalMessageInfo
+
+func (m *GasSponsorPolicy) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *GasSponsorPolicy) GetSenders() []string {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+func (m *GasSponsorPolicy) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// GasSponsorSpend: Amount of "unibi" a gas sponsor has paid for a sender during
+// a single day. Used to enforce "GasSponsorPolicy.daily_cap_per_sender".
+type GasSponsorSpend struct {
+	// Sponsor: Nibiru Bech32 address of the gas sponsor.
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// Sender: Nibiru Bech32 address of the sponsored sender.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Day: Number of days since the Unix epoch (block time, UTC).
+	Day uint64 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	// Spent: Amount of "unibi" paid during "day".
+	Spent cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=spent,proto3,customtype=cosmossdk.io/math.Int" json:"spent"`
+}
+
+func (m *GasSponsorSpend) Reset()         { *m = GasSponsorSpend{} }
+func (m *GasSponsorSpend) String() string { return proto.CompactTextString(m) }
+func (*GasSponsorSpend) ProtoMessage()    {}
+func (*GasSponsorSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{5}
+}
+func (m *GasSponsorSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasSponsorSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasSponsorSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasSponsorSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasSponsorSpend.Merge(m, src)
+}
+func (m *GasSponsorSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasSponsorSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasSponsorSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasSponsorSpend proto.InternalMessageInfo
+
+func (m *GasSponsorSpend) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *GasSponsorSpend) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *GasSponsorSpend) GetDay() uint64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Sudoers)(nil), "nibiru.sudo.v1.Sudoers")
 	proto.RegisterType((*GenesisState)(nil), "nibiru.sudo.v1.GenesisState")
 	proto.RegisterType((*ZeroGasActors)(nil), "nibiru.sudo.v1.ZeroGasActors")
+	proto.RegisterType((*GasSponsor)(nil), "nibiru.sudo.v1.GasSponsor")
+	proto.RegisterType((*GasSponsorPolicy)(nil), "nibiru.sudo.v1.GasSponsorPolicy")
+	proto.RegisterType((*GasSponsorSpend)(nil), "nibiru.sudo.v1.GasSponsorSpend")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/state.proto", fileDescriptor_4b462ff6aaf658cf) }
 
 var fileDescriptor_4b462ff6aaf658cf = []byte{
-	// 374 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x31, 0x6f, 0xda, 0x40,
-	0x14, 0xb6, 0x0b, 0x2a, 0xe2, 0x5a, 0x5a, 0xe9, 0x54, 0x51, 0x17, 0x51, 0x17, 0xb1, 0x94, 0xc9,
-	0x27, 0xe8, 0x50, 0x55, 0x9d, 0x00, 0x45, 0x64, 0xca, 0x60, 0x36, 0x16, 0xeb, 0x6c, 0x4e, 0xc6,
-	0x02, 0xee, 0xa1, 0xbb, 0x33, 0x24, 0x8c, 0xf9, 0x05, 0xf9, 0x59, 0x64, 0x63, 0xcc, 0x14, 0x45,
-	0xf0, 0x47, 0xa2, 0x3b, 0xdb, 0x21, 0x64, 0xc8, 0xf6, 0xde, 0x7d, 0x9f, 0xbe, 0xf7, 0x7d, 0xef,
-	0x1e, 0x6a, 0xf0, 0x24, 0x4c, 0x44, 0x4a, 0x64, 0x3a, 0x05, 0xb2, 0xee, 0x12, 0xa9, 0xa8, 0x62,
-	0xde, 0x4a, 0x80, 0x02, 0xfc, 0x25, 0xc3, 0x3c, 0x8d, 0x79, 0xeb, 0x6e, 0xe3, 0x5b, 0x0c, 0x31,
-	0x18, 0x88, 0xe8, 0x2a, 0x63, 0x35, 0x9a, 0x31, 0x40, 0xbc, 0x60, 0x84, 0xae, 0x12, 0x42, 0x39,
-	0x07, 0x45, 0x55, 0x02, 0x5c, 0x66, 0x68, 0xfb, 0x3f, 0xaa, 0x8c, 0xd3, 0x29, 0x30, 0x21, 0x31,
-	0x46, 0x65, 0x01, 0xa0, 0x1c, 0xbb, 0x65, 0x77, 0xaa, 0xbe, 0xa9, 0x71, 0x13, 0x55, 0x23, 0xe0,
-	0x4a, 0xd0, 0x48, 0x49, 0xe7, 0x43, 0xab, 0xd4, 0xa9, 0xfa, 0xa7, 0x87, 0xf6, 0xbd, 0x8d, 0x3e,
-	0x8f, 0x18, 0x67, 0x32, 0x91, 0x63, 0xed, 0x0b, 0xff, 0x45, 0x15, 0x99, 0xa9, 0x19, 0x95, 0x4f,
-	0xbd, 0xef, 0xde, 0xb9, 0x47, 0x2f, 0x1f, 0x36, 0x28, 0xef, 0x1e, 0x7f, 0x59, 0x7e, 0xc1, 0xc6,
-	0x17, 0xe8, 0xeb, 0x96, 0x09, 0x08, 0x62, 0x2a, 0x03, 0x1a, 0x29, 0x10, 0x7a, 0x9a, 0x16, 0xf8,
-	0xf9, 0x56, 0x60, 0xc2, 0x04, 0x8c, 0xa8, 0xec, 0x1b, 0x92, 0x5f, 0xdb, 0xbe, 0x6e, 0xf1, 0x3f,
-	0xf4, 0x63, 0x43, 0xe5, 0x32, 0x08, 0x17, 0x10, 0xcd, 0x83, 0x19, 0xc0, 0x5c, 0x06, 0x85, 0x5d,
-	0xa7, 0x64, 0x72, 0xd5, 0x35, 0x61, 0xa0, 0xf1, 0x4b, 0x0d, 0x0f, 0x73, 0xb4, 0x7d, 0x6b, 0xa3,
-	0xda, 0x99, 0x36, 0x76, 0x50, 0x45, 0x32, 0x3e, 0xcd, 0xc2, 0xe8, 0xe4, 0x45, 0xfb, 0xfe, 0x56,
-	0xb4, 0x09, 0xba, 0xd8, 0xd0, 0x1b, 0x19, 0xbc, 0x44, 0x3a, 0xb1, 0x4b, 0x86, 0x5d, 0xcf, 0x08,
-	0xf9, 0xbc, 0xc2, 0x83, 0x1c, 0xf4, 0x77, 0x07, 0xd7, 0xde, 0x1f, 0x5c, 0xfb, 0xe9, 0xe0, 0xda,
-	0x77, 0x47, 0xd7, 0xda, 0x1f, 0x5d, 0xeb, 0xe1, 0xe8, 0x5a, 0x93, 0xdf, 0x71, 0xa2, 0x66, 0x69,
-	0xe8, 0x45, 0xb0, 0x24, 0x57, 0x66, 0x23, 0xc3, 0x19, 0x4d, 0x38, 0xc9, 0xcf, 0x63, 0xdd, 0x23,
-	0xd7, 0xe6, 0x46, 0xc2, 0x8f, 0xe6, 0x5f, 0xff, 0x3c, 0x07, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x97,
-	0x6b, 0xf1, 0x39, 0x02, 0x00, 0x00,
+	// 640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x4f, 0xdb, 0x4e,
+	0x10, 0x8d, 0x71, 0x00, 0x65, 0xc2, 0xbf, 0xdf, 0x8a, 0x1f, 0x75, 0x23, 0x08, 0x51, 0x2e, 0xcd,
+	0xc9, 0x16, 0xe1, 0x50, 0x55, 0x95, 0x2a, 0x91, 0xa8, 0xa5, 0xbd, 0x20, 0xe4, 0xf4, 0xc4, 0xc5,
+	0xda, 0xd8, 0x5b, 0xc7, 0x22, 0xf1, 0x58, 0x9e, 0x0d, 0x18, 0x8e, 0x3d, 0xf6, 0xd4, 0x6f, 0xd0,
+	0xaf, 0xc3, 0x91, 0x63, 0xd5, 0x03, 0xaa, 0xc8, 0xe7, 0xa8, 0x54, 0xed, 0xda, 0x01, 0x12, 0x09,
+	0xca, 0x6d, 0x77, 0xe7, 0xed, 0x7b, 0x33, 0xef, 0xad, 0x16, 0x6a, 0x71, 0xd4, 0x8f, 0xd2, 0xb1,
+	0x43, 0xe3, 0x00, 0x9d, 0xb3, 0x3d, 0x87, 0x24, 0x97, 0xc2, 0x4e, 0x52, 0x94, 0xc8, 0xd6, 0xf2,
+	0x9a, 0xad, 0x6a, 0xf6, 0xd9, 0x5e, 0x6d, 0x33, 0xc4, 0x10, 0x75, 0xc9, 0x51, 0xab, 0x1c, 0x55,
+	0xdb, 0x0e, 0x11, 0xc3, 0xa1, 0x70, 0x78, 0x12, 0x39, 0x3c, 0x8e, 0x51, 0x72, 0x19, 0x61, 0x4c,
+	0x79, 0xb5, 0xf9, 0x16, 0x96, 0x7b, 0xe3, 0x00, 0x45, 0x4a, 0x8c, 0x41, 0x39, 0x45, 0x94, 0x96,
+	0xd1, 0x30, 0x5a, 0x15, 0x57, 0xaf, 0xd9, 0x36, 0x54, 0x7c, 0x8c, 0x65, 0xca, 0x7d, 0x49, 0xd6,
+	0x42, 0xc3, 0x6c, 0x55, 0xdc, 0xfb, 0x83, 0xe6, 0x64, 0x01, 0x56, 0x0e, 0x45, 0x2c, 0x28, 0xa2,
+	0x9e, 0xea, 0x8b, 0xbd, 0x86, 0x65, 0xca, 0xd9, 0x34, 0x4b, 0xb5, 0xfd, 0xc2, 0x9e, 0xed, 0xd1,
+	0x2e, 0xc4, 0x3a, 0xe5, 0xab, 0x9b, 0xdd, 0x92, 0x3b, 0x45, 0xb3, 0xf7, 0xb0, 0x7e, 0x29, 0x52,
+	0xf4, 0x42, 0x4e, 0x1e, 0xf7, 0x25, 0xa6, 0x4a, 0x4d, 0x11, 0xec, 0xcc, 0x13, 0x9c, 0x88, 0x14,
+	0x0f, 0x39, 0x1d, 0x68, 0x90, 0xbb, 0x7a, 0xf9, 0x70, 0xcb, 0xde, 0xc0, 0xcb, 0x73, 0x4e, 0x23,
+	0xaf, 0x3f, 0x44, 0xff, 0xd4, 0x1b, 0x20, 0x9e, 0x92, 0x37, 0x6d, 0xd7, 0x32, 0xf5, 0x5c, 0x5b,
+	0x0a, 0xd0, 0x51, 0xf5, 0x8f, 0xaa, 0xdc, 0x2d, 0xaa, 0xac, 0x0b, 0x2b, 0x4a, 0x9c, 0x12, 0x8c,
+	0x49, 0xc9, 0x97, 0x1b, 0x66, 0xab, 0xda, 0xae, 0xcd, 0xcb, 0x1f, 0x72, 0xea, 0xe5, 0x90, 0x62,
+	0x84, 0x6a, 0x78, 0x77, 0x42, 0xac, 0x07, 0xec, 0x01, 0x89, 0x47, 0x89, 0x88, 0x03, 0xb2, 0x16,
+	0x35, 0xd5, 0xee, 0xe3, 0x54, 0x3d, 0x85, 0x2b, 0xf8, 0x36, 0xc2, 0xd9, 0x63, 0x6a, 0x7e, 0x35,
+	0x60, 0x75, 0x66, 0x6a, 0x66, 0xc1, 0x32, 0x89, 0x38, 0xc8, 0x6d, 0x56, 0x99, 0x4c, 0xb7, 0x4f,
+	0xe7, 0xa5, 0xec, 0xe1, 0xc3, 0x73, 0x7e, 0x41, 0xde, 0x9d, 0xd9, 0xf7, 0x68, 0x53, 0xa3, 0xb7,
+	0x72, 0x40, 0xa1, 0xd7, 0xbd, 0x8b, 0xfa, 0x87, 0x01, 0x70, 0xdf, 0xb0, 0xee, 0x20, 0x5f, 0x16,
+	0xcf, 0x65, 0xba, 0x55, 0x4f, 0xa0, 0xcf, 0x87, 0x3c, 0xf6, 0x85, 0x4e, 0xb0, 0xd2, 0xd9, 0x51,
+	0x63, 0xfd, 0xba, 0xd9, 0xfd, 0xdf, 0x47, 0x1a, 0x21, 0x51, 0x70, 0x6a, 0x47, 0xe8, 0x8c, 0xb8,
+	0x1c, 0xd8, 0x9f, 0x62, 0xe9, 0x4e, 0xd1, 0xec, 0x1d, 0x2c, 0x25, 0x38, 0x8c, 0xfc, 0x0b, 0x1d,
+	0x54, 0xb5, 0xdd, 0x78, 0xdc, 0xaf, 0x63, 0x8d, 0x2b, 0x0c, 0x2b, 0x6e, 0x35, 0xff, 0x18, 0xb0,
+	0x31, 0x0f, 0x99, 0xf5, 0xc3, 0x98, 0xf7, 0xe3, 0x81, 0x8f, 0x0b, 0xb3, 0x3e, 0x1e, 0xc1, 0x66,
+	0xc0, 0xa3, 0xe1, 0x85, 0xe7, 0xf3, 0xc4, 0x4b, 0x44, 0xea, 0xe5, 0x05, 0xcb, 0x7c, 0xce, 0x48,
+	0xff, 0xe9, 0xab, 0x5d, 0x9e, 0x1c, 0x8b, 0xb4, 0xa7, 0xef, 0xb1, 0x1d, 0x00, 0x91, 0x25, 0x51,
+	0x2a, 0xc8, 0xe3, 0xd2, 0x2a, 0x37, 0x8c, 0x96, 0xe9, 0x56, 0x8a, 0x93, 0x03, 0xc9, 0x3a, 0xb0,
+	0x36, 0xe2, 0x99, 0xf7, 0x45, 0x08, 0x2d, 0x26, 0x33, 0x6b, 0xf1, 0x39, 0x42, 0xd5, 0x11, 0xcf,
+	0x3e, 0x08, 0x71, 0x2c, 0xd2, 0xcf, 0x59, 0xf3, 0x9b, 0x01, 0xeb, 0x73, 0x4f, 0xea, 0x89, 0x98,
+	0xb6, 0x60, 0xa9, 0x18, 0x49, 0xa7, 0xe4, 0x16, 0x3b, 0xb6, 0x01, 0x66, 0xc0, 0xf3, 0x08, 0xca,
+	0xae, 0x5a, 0xb2, 0x7d, 0x58, 0x54, 0xef, 0x38, 0xef, 0xfa, 0x9f, 0x2d, 0xe5, 0xd8, 0xce, 0xc1,
+	0xd5, 0x6d, 0xdd, 0xb8, 0xbe, 0xad, 0x1b, 0xbf, 0x6f, 0xeb, 0xc6, 0xf7, 0x49, 0xbd, 0x74, 0x3d,
+	0xa9, 0x97, 0x7e, 0x4e, 0xea, 0xa5, 0x93, 0x57, 0x61, 0x24, 0x07, 0xe3, 0xbe, 0xed, 0xe3, 0xc8,
+	0x39, 0xd2, 0x01, 0x77, 0x07, 0x3c, 0x8a, 0x9d, 0xe2, 0x9f, 0x3b, 0x6b, 0x3b, 0x99, 0xfe, 0xec,
+	0xfa, 0x4b, 0xfa, 0x83, 0xda, 0xff, 0x3b, 0x00, 0x9d, 0xf3, 0xe3, 0xb8, 0x02, 0x05, 0x00, 0x00,
 }
 
 func (m *Sudoers) Marshal() (dAtA []byte, err error) {
@@ -302,6 +544,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GasSponsorSpends) > 0 {
+		for iNdEx := len(m.GasSponsorSpends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasSponsorSpends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GasSponsors) > 0 {
+		for iNdEx := len(m.GasSponsors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasSponsors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.WasmBlockHooksContract) > 0 {
 		i -= len(m.WasmBlockHooksContract)
 		copy(dAtA[i:], m.WasmBlockHooksContract)
@@ -384,6 +654,174 @@ func (m *ZeroGasActors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasSponsorPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasSponsorPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasSponsorPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFeePerTx.Size()
+		i -= size
+		if _, err := m.MaxFeePerTx.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ExpiresAt != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.DailyCapPerSender.Size()
+		i -= size
+		if _, err := m.DailyCapPerSender.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Senders[iNdEx])
+			copy(dAtA[i:], m.Senders[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.Senders[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasSponsorSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasSponsorSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasSponsorSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Spent.Size()
+		i -= size
+		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Day != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintState(dAtA []byte, offset int, v uint64) int {
 	offset -= sovState(v)
 	base := offset
@@ -411,62 +849,620 @@ func (m *Sudoers) Size() (n int) {
 			n += 1 + l + sovState(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Sudoers.Size()
-	n += 1 + l + sovState(uint64(l))
-	if m.ZeroGasActors != nil {
-		l = m.ZeroGasActors.Size()
-		n += 1 + l + sovState(uint64(l))
-	}
-	l = len(m.WasmBlockHooksContract)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	return n
-}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sudoers.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.ZeroGasActors != nil {
+		l = m.ZeroGasActors.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.WasmBlockHooksContract)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.GasSponsors) > 0 {
+		for _, e := range m.GasSponsors {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if len(m.GasSponsorSpends) > 0 {
+		for _, e := range m.GasSponsorSpends {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ZeroGasActors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if len(m.AlwaysZeroGasContracts) > 0 {
+		for _, s := range m.AlwaysZeroGasContracts {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasSponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Policy.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *GasSponsorPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = m.DailyCapPerSender.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.ExpiresAt != 0 {
+		n += 1 + sovState(uint64(m.ExpiresAt))
+	}
+	l = m.MaxFeePerTx.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *GasSponsorSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovState(uint64(m.Day))
+	}
+	l = m.Spent.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Sudoers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sudoers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sudoers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sudoers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroGasActors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ZeroGasActors == nil {
+				m.ZeroGasActors = &ZeroGasActors{}
+			}
+			if err := m.ZeroGasActors.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmBlockHooksContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WasmBlockHooksContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSponsors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasSponsors = append(m.GasSponsors, GasSponsor{})
+			if err := m.GasSponsors[len(m.GasSponsors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSponsorSpends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasSponsorSpends = append(m.GasSponsorSpends, GasSponsorSpend{})
+			if err := m.GasSponsorSpends[len(m.GasSponsorSpends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZeroGasActors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZeroGasActors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZeroGasActors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlwaysZeroGasContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlwaysZeroGasContracts = append(m.AlwaysZeroGasContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ZeroGasActors) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Senders) > 0 {
-		for _, s := range m.Senders {
-			l = len(s)
-			n += 1 + l + sovState(uint64(l))
-		}
-	}
-	if len(m.Contracts) > 0 {
-		for _, s := range m.Contracts {
-			l = len(s)
-			n += 1 + l + sovState(uint64(l))
-		}
-	}
-	if len(m.AlwaysZeroGasContracts) > 0 {
-		for _, s := range m.AlwaysZeroGasContracts {
-			l = len(s)
-			n += 1 + l + sovState(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Sudoers) Unmarshal(dAtA []byte) error {
+func (m *GasSponsor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -489,15 +1485,15 @@ func (m *Sudoers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sudoers: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasSponsor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasSponsor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -525,11 +1521,11 @@ func (m *Sudoers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Root = string(dAtA[iNdEx:postIndex])
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -557,7 +1553,42 @@ func (m *Sudoers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -580,7 +1611,7 @@ func (m *Sudoers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *GasSponsorPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -603,17 +1634,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasSponsorPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasSponsorPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sudoers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -623,30 +1654,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sudoers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZeroGasActors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -656,31 +1686,80 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ZeroGasActors == nil {
-				m.ZeroGasActors = &ZeroGasActors{}
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyCapPerSender", wireType)
 			}
-			if err := m.ZeroGasActors.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DailyCapPerSender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WasmBlockHooksContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerTx", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -708,7 +1787,9 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WasmBlockHooksContract = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxFeePerTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -731,7 +1812,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ZeroGasActors) Unmarshal(dAtA []byte) error {
+func (m *GasSponsorSpend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -754,15 +1835,15 @@ func (m *ZeroGasActors) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ZeroGasActors: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasSponsorSpend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ZeroGasActors: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasSponsorSpend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -790,11 +1871,11 @@ func (m *ZeroGasActors) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -822,11 +1903,30 @@ func (m *ZeroGasActors) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlwaysZeroGasContracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -854,7 +1954,9 @@ func (m *ZeroGasActors) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AlwaysZeroGasContracts = append(m.AlwaysZeroGasContracts, string(dAtA[iNdEx:postIndex]))
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
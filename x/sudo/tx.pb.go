@@ -6,6 +6,7 @@ package sudo
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/NibiruChain/nibiru/v2/lib/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -318,6 +319,212 @@ func (m *MsgEditZeroGasActorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEditZeroGasActorsResponse proto.InternalMessageInfo
 
+// MsgSetGasSponsor: Tx msg to create or update the "GasSponsor" owned by the
+// sender.
+type MsgSetGasSponsor struct {
+	// Sender: Nibiru Bech32 address of the gas sponsor.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Policy: Rules deciding which transactions the sponsor pays for. Replaces
+	// any existing policy.
+	Policy GasSponsorPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+	// Deposit: Optional amount of "unibi" added to the sponsor's escrowed
+	// balance.
+	Deposit types.Coin `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit"`
+}
+
+func (m *MsgSetGasSponsor) Reset()         { *m = MsgSetGasSponsor{} }
+func (m *MsgSetGasSponsor) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGasSponsor) ProtoMessage()    {}
+func (*MsgSetGasSponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{6}
+}
+func (m *MsgSetGasSponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetGasSponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetGasSponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetGasSponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGasSponsor.Merge(m, src)
+}
+func (m *MsgSetGasSponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetGasSponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGasSponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetGasSponsor proto.InternalMessageInfo
+
+func (m *MsgSetGasSponsor) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetGasSponsor) GetPolicy() GasSponsorPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return GasSponsorPolicy{}
+}
+
+func (m *MsgSetGasSponsor) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+// MsgSetGasSponsorResponse indicates the successful execution of
+// MsgSetGasSponsor.
+type MsgSetGasSponsorResponse struct {
+	GasSponsor GasSponsor `protobuf:"bytes,1,opt,name=gas_sponsor,json=gasSponsor,proto3" json:"gas_sponsor"`
+}
+
+func (m *MsgSetGasSponsorResponse) Reset()         { *m = MsgSetGasSponsorResponse{} }
+func (m *MsgSetGasSponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGasSponsorResponse) ProtoMessage()    {}
+func (*MsgSetGasSponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{7}
+}
+func (m *MsgSetGasSponsorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetGasSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetGasSponsorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetGasSponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGasSponsorResponse.Merge(m, src)
+}
+func (m *MsgSetGasSponsorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetGasSponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGasSponsorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetGasSponsorResponse proto.InternalMessageInfo
+
+func (m *MsgSetGasSponsorResponse) GetGasSponsor() GasSponsor {
+	if m != nil {
+		return m.GasSponsor
+	}
+	return GasSponsor{}
+}
+
+// MsgRemoveGasSponsor: Tx msg to delete the "GasSponsor" owned by the sender.
+type MsgRemoveGasSponsor struct {
+	// Sender: Nibiru Bech32 address of the gas sponsor.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgRemoveGasSponsor) Reset()         { *m = MsgRemoveGasSponsor{} }
+func (m *MsgRemoveGasSponsor) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveGasSponsor) ProtoMessage()    {}
+func (*MsgRemoveGasSponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{8}
+}
+func (m *MsgRemoveGasSponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveGasSponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveGasSponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveGasSponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveGasSponsor.Merge(m, src)
+}
+func (m *MsgRemoveGasSponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveGasSponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveGasSponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveGasSponsor proto.InternalMessageInfo
+
+func (m *MsgRemoveGasSponsor) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgRemoveGasSponsorResponse indicates the successful execution of
+// MsgRemoveGasSponsor.
+type MsgRemoveGasSponsorResponse struct {
+	// Refund: Escrowed balance returned to the sponsor.
+	Refund types.Coin `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund"`
+}
+
+func (m *MsgRemoveGasSponsorResponse) Reset()         { *m = MsgRemoveGasSponsorResponse{} }
+func (m *MsgRemoveGasSponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveGasSponsorResponse) ProtoMessage()    {}
+func (*MsgRemoveGasSponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{9}
+}
+func (m *MsgRemoveGasSponsorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveGasSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveGasSponsorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveGasSponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveGasSponsorResponse.Merge(m, src)
+}
+func (m *MsgRemoveGasSponsorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveGasSponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveGasSponsorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveGasSponsorResponse proto.InternalMessageInfo
+
+func (m *MsgRemoveGasSponsorResponse) GetRefund() types.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgEditSudoers)(nil), "nibiru.sudo.v1.MsgEditSudoers")
 	proto.RegisterType((*MsgEditSudoersResponse)(nil), "nibiru.sudo.v1.MsgEditSudoersResponse")
@@ -325,42 +532,59 @@ func init() {
 	proto.RegisterType((*MsgChangeRootResponse)(nil), "nibiru.sudo.v1.MsgChangeRootResponse")
 	proto.RegisterType((*MsgEditZeroGasActors)(nil), "nibiru.sudo.v1.MsgEditZeroGasActors")
 	proto.RegisterType((*MsgEditZeroGasActorsResponse)(nil), "nibiru.sudo.v1.MsgEditZeroGasActorsResponse")
+	proto.RegisterType((*MsgSetGasSponsor)(nil), "nibiru.sudo.v1.MsgSetGasSponsor")
+	proto.RegisterType((*MsgSetGasSponsorResponse)(nil), "nibiru.sudo.v1.MsgSetGasSponsorResponse")
+	proto.RegisterType((*MsgRemoveGasSponsor)(nil), "nibiru.sudo.v1.MsgRemoveGasSponsor")
+	proto.RegisterType((*MsgRemoveGasSponsorResponse)(nil), "nibiru.sudo.v1.MsgRemoveGasSponsorResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/tx.proto", fileDescriptor_a610e3c1609cdcbc) }
 
 var fileDescriptor_a610e3c1609cdcbc = []byte{
-	// 467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0xe3, 0x04, 0x15, 0xb2, 0x15, 0x95, 0xb0, 0x4a, 0xeb, 0x9a, 0xd4, 0xa4, 0xe6, 0x5f,
-	0x91, 0x90, 0x57, 0x0d, 0x47, 0x4e, 0x4d, 0x85, 0x38, 0x85, 0x83, 0xb9, 0xf5, 0x40, 0xb4, 0xb1,
-	0x57, 0x1b, 0x0b, 0xd8, 0x89, 0x76, 0x36, 0x69, 0xc4, 0x91, 0x23, 0x27, 0x04, 0x5f, 0xaa, 0xc7,
-	0x4a, 0x5c, 0x38, 0x21, 0x94, 0xf0, 0x41, 0x90, 0xd7, 0xae, 0xeb, 0x6d, 0x4b, 0xd4, 0x5b, 0x36,
-	0x6f, 0xe6, 0xf7, 0x66, 0xde, 0xc8, 0x64, 0x5b, 0x66, 0xa3, 0x4c, 0x4d, 0x29, 0x4e, 0x53, 0xa0,
-	0xb3, 0x03, 0xaa, 0xe7, 0xd1, 0x44, 0x81, 0x06, 0x77, 0xa3, 0x10, 0xa2, 0x5c, 0x88, 0x66, 0x07,
-	0xfe, 0xa6, 0x00, 0x01, 0x46, 0xa2, 0xf9, 0xaf, 0xa2, 0xca, 0xef, 0x08, 0x00, 0xf1, 0x91, 0x53,
-	0x36, 0xc9, 0x28, 0x93, 0x12, 0x34, 0xd3, 0x19, 0x48, 0x2c, 0x55, 0xff, 0x12, 0x1c, 0x35, 0xd3,
-	0xbc, 0xd0, 0xc2, 0xf7, 0x64, 0x63, 0x80, 0xe2, 0x75, 0x9a, 0xe9, 0x77, 0xd3, 0x14, 0xb8, 0x42,
-	0x77, 0x8b, 0xac, 0xb1, 0x24, 0x6f, 0xf7, 0x9c, 0xae, 0xb3, 0xdf, 0x8e, 0xcb, 0x97, 0xdb, 0x21,
-	0xed, 0x04, 0xa4, 0x56, 0x2c, 0xd1, 0xe8, 0x35, 0xbb, 0xad, 0xfd, 0x76, 0x7c, 0xf1, 0x47, 0xde,
-	0x85, 0x5c, 0xa6, 0x5c, 0x79, 0xad, 0xa2, 0xab, 0x78, 0x85, 0x1e, 0xd9, 0xb2, 0xf9, 0x31, 0xc7,
-	0x09, 0x48, 0xe4, 0x61, 0x9f, 0xdc, 0x1d, 0xa0, 0x38, 0x1a, 0x33, 0x29, 0x78, 0x0c, 0xa0, 0x6b,
-	0x08, 0xa7, 0x8e, 0x70, 0x77, 0xc8, 0x1d, 0xc9, 0x4f, 0x86, 0x0a, 0x40, 0x7b, 0x4d, 0xa3, 0xdc,
-	0x96, 0xfc, 0x24, 0x6f, 0x09, 0xb7, 0xc9, 0x7d, 0x8b, 0x51, 0xc1, 0x3f, 0x90, 0xcd, 0xd2, 0xf6,
-	0x98, 0x2b, 0x78, 0xc3, 0xf0, 0x30, 0xd1, 0xa0, 0xd0, 0x7d, 0x65, 0x96, 0x03, 0x85, 0xc6, 0x63,
-	0xbd, 0xb7, 0x1b, 0xd9, 0xf9, 0x46, 0x56, 0x79, 0xff, 0xd6, 0xe9, 0xef, 0x87, 0x8d, 0xb8, 0x6c,
-	0xa9, 0x0d, 0xd8, 0xb4, 0x76, 0x0c, 0x48, 0xe7, 0x3a, 0xb3, 0xf3, 0x61, 0x7a, 0x5f, 0x5b, 0xa4,
-	0x35, 0x40, 0xe1, 0xce, 0xc9, 0x7a, 0x3d, 0xe8, 0xe0, 0xb2, 0xb7, 0x1d, 0x94, 0xff, 0x74, 0xb5,
-	0x5e, 0xed, 0xba, 0xf7, 0xe5, 0xe7, 0xdf, 0x1f, 0xcd, 0x07, 0xe1, 0x0e, 0xad, 0xdf, 0x99, 0xa7,
-	0x99, 0x1e, 0x62, 0x69, 0xa5, 0x09, 0xa9, 0x05, 0xbd, 0x7b, 0x0d, 0xf8, 0x42, 0xf6, 0x9f, 0xac,
-	0x94, 0x2b, 0xdb, 0xae, 0xb1, 0xf5, 0x43, 0xcf, 0xb2, 0x4d, 0x4c, 0xa1, 0x39, 0x96, 0xfb, 0xdd,
-	0x21, 0xf7, 0xae, 0x9e, 0xe0, 0xf1, 0x7f, 0xd6, 0xb2, 0xaa, 0xfc, 0x17, 0x37, 0xa9, 0xaa, 0x66,
-	0x79, 0x6e, 0x66, 0x79, 0x14, 0xee, 0x5d, 0x8d, 0xe0, 0x33, 0x57, 0x30, 0x14, 0x0c, 0x87, 0xc5,
-	0x11, 0xfb, 0x87, 0xa7, 0x8b, 0xc0, 0x39, 0x5b, 0x04, 0xce, 0x9f, 0x45, 0xe0, 0x7c, 0x5b, 0x06,
-	0x8d, 0xb3, 0x65, 0xd0, 0xf8, 0xb5, 0x0c, 0x1a, 0xc7, 0xcf, 0x44, 0xa6, 0xc7, 0xd3, 0x51, 0x94,
-	0xc0, 0x27, 0xfa, 0xd6, 0x60, 0x8e, 0xc6, 0x2c, 0x93, 0xe7, 0xc8, 0x59, 0x8f, 0xce, 0x0d, 0x77,
-	0xb4, 0x66, 0x3e, 0x9d, 0x97, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x7b, 0x0c, 0x7c, 0xb5,
-	0x03, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x4f, 0xd4, 0x4e,
+	0x14, 0xde, 0x2e, 0xbf, 0xdf, 0x22, 0x6f, 0x03, 0xc1, 0x8a, 0x50, 0xca, 0x52, 0x96, 0x82, 0x82,
+	0x51, 0xdb, 0xec, 0x7a, 0x30, 0xc6, 0xc4, 0x04, 0x88, 0xe1, 0xb4, 0xc6, 0x2c, 0x89, 0x07, 0x12,
+	0xdd, 0x74, 0xdb, 0x71, 0x68, 0x84, 0x79, 0x9b, 0x99, 0xd9, 0x05, 0xbd, 0xe9, 0xdd, 0xc4, 0xe8,
+	0xdd, 0x9b, 0xff, 0x0b, 0x47, 0x12, 0x2f, 0x9e, 0x8c, 0x01, 0xff, 0x10, 0xd3, 0x69, 0xb7, 0xb4,
+	0xcb, 0x02, 0x7b, 0x6b, 0xe7, 0x7d, 0xef, 0xfb, 0xbe, 0x37, 0xfd, 0x5e, 0x61, 0x8e, 0x85, 0xed,
+	0x90, 0x77, 0x5d, 0xd1, 0x0d, 0xd0, 0xed, 0xd5, 0x5c, 0x79, 0xe4, 0x74, 0x38, 0x4a, 0xd4, 0xa7,
+	0xe2, 0x82, 0x13, 0x15, 0x9c, 0x5e, 0xcd, 0xb4, 0x7c, 0x14, 0x07, 0x28, 0xdc, 0xb6, 0x27, 0x88,
+	0xdb, 0xab, 0xb5, 0x89, 0xf4, 0x6a, 0xae, 0x8f, 0x21, 0x8b, 0xf1, 0xe6, 0x0c, 0x45, 0x8a, 0xea,
+	0xd1, 0x8d, 0x9e, 0x92, 0xd3, 0x0a, 0x45, 0xa4, 0xfb, 0xc4, 0xf5, 0x3a, 0xa1, 0xeb, 0x31, 0x86,
+	0xd2, 0x93, 0x21, 0x32, 0x91, 0x54, 0xcd, 0x01, 0x71, 0x21, 0x3d, 0x49, 0xe2, 0x9a, 0xfd, 0x06,
+	0xa6, 0x1a, 0x82, 0x3e, 0x0f, 0x42, 0xb9, 0xd3, 0x0d, 0x90, 0x70, 0xa1, 0xcf, 0x42, 0xc9, 0xf3,
+	0xa3, 0x76, 0x43, 0xab, 0x6a, 0xeb, 0x13, 0xcd, 0xe4, 0x4d, 0xaf, 0xc0, 0x84, 0x8f, 0x4c, 0x72,
+	0xcf, 0x97, 0xc2, 0x28, 0x56, 0xc7, 0xd6, 0x27, 0x9a, 0xe7, 0x07, 0x51, 0x97, 0x20, 0x2c, 0x20,
+	0xdc, 0x18, 0x8b, 0xbb, 0xe2, 0x37, 0xdb, 0x80, 0xd9, 0x3c, 0x7f, 0x93, 0x88, 0x0e, 0x32, 0x41,
+	0xec, 0x4d, 0x98, 0x6c, 0x08, 0xba, 0xb5, 0xe7, 0x31, 0x4a, 0x9a, 0x88, 0x32, 0x43, 0xa1, 0x65,
+	0x29, 0xf4, 0x79, 0xb8, 0xc1, 0xc8, 0x61, 0x8b, 0x23, 0x4a, 0xa3, 0xa8, 0x2a, 0xe3, 0x8c, 0x1c,
+	0x46, 0x2d, 0xf6, 0x1c, 0xdc, 0xce, 0x71, 0xa4, 0xe4, 0xef, 0x60, 0x26, 0x91, 0xdd, 0x25, 0x1c,
+	0xb7, 0x3d, 0xb1, 0xe1, 0x4b, 0xe4, 0x42, 0x7f, 0xaa, 0x86, 0x43, 0x2e, 0x94, 0x46, 0xb9, 0xbe,
+	0xe8, 0xe4, 0xef, 0xdf, 0xc9, 0xc1, 0x37, 0xff, 0x3b, 0xfe, 0xbd, 0x54, 0x68, 0x26, 0x2d, 0x19,
+	0x83, 0xc5, 0xdc, 0x8c, 0x16, 0x54, 0x86, 0x89, 0xa5, 0x66, 0x7e, 0x68, 0x30, 0xdd, 0x10, 0x74,
+	0x87, 0xc8, 0x6d, 0x4f, 0xec, 0x44, 0x67, 0xc8, 0x2f, 0x9d, 0xf6, 0x19, 0x94, 0x3a, 0xb8, 0x1f,
+	0xfa, 0xef, 0x95, 0x48, 0xb9, 0x5e, 0x1d, 0x74, 0x78, 0xce, 0xf1, 0x52, 0xe1, 0xfa, 0x26, 0xe3,
+	0x2e, 0xfd, 0x09, 0x8c, 0x07, 0xa4, 0x83, 0x22, 0x94, 0xea, 0x4b, 0x94, 0xeb, 0xf3, 0x4e, 0x1c,
+	0x29, 0x27, 0x8a, 0x94, 0x93, 0x44, 0xca, 0xd9, 0xc2, 0x90, 0x25, 0x9d, 0x7d, 0xbc, 0xfd, 0x1a,
+	0x8c, 0x41, 0x9b, 0xfd, 0x19, 0xf4, 0x0d, 0x28, 0x53, 0x4f, 0xb4, 0x44, 0x7c, 0x9c, 0xdc, 0x9e,
+	0x79, 0xb9, 0xb7, 0x84, 0x1b, 0x68, 0x7a, 0x62, 0x3f, 0x84, 0x5b, 0x0d, 0x41, 0x9b, 0xe4, 0x00,
+	0x7b, 0xe4, 0xfa, 0x8b, 0xb0, 0x5f, 0xc1, 0xc2, 0x10, 0x78, 0x6a, 0xe8, 0x31, 0x94, 0x38, 0x79,
+	0xdb, 0x65, 0x81, 0xa1, 0x8d, 0x36, 0x66, 0x02, 0xaf, 0x7f, 0xff, 0x1f, 0xc6, 0x1a, 0x82, 0xea,
+	0x47, 0x50, 0xce, 0xc6, 0xde, 0x1a, 0x9c, 0x25, 0x1f, 0x5b, 0xf3, 0xee, 0xd5, 0xf5, 0xf4, 0x63,
+	0x2f, 0x7f, 0xfa, 0xf9, 0xf7, 0x5b, 0x71, 0xc1, 0x9e, 0x77, 0xb3, 0x5b, 0x47, 0x82, 0x50, 0xb6,
+	0x44, 0x22, 0x25, 0x01, 0x32, 0xb1, 0x5f, 0x1c, 0x42, 0x7c, 0x5e, 0x36, 0xef, 0x5c, 0x59, 0x4e,
+	0x65, 0xab, 0x4a, 0xd6, 0xb4, 0x8d, 0x9c, 0xac, 0xaf, 0x80, 0x6a, 0x75, 0xf4, 0xaf, 0x1a, 0xdc,
+	0xbc, 0xb8, 0x10, 0xab, 0x97, 0x8c, 0x95, 0x43, 0x99, 0x0f, 0x46, 0x41, 0xa5, 0x5e, 0xee, 0x29,
+	0x2f, 0x2b, 0xf6, 0xf2, 0xc5, 0x2b, 0xf8, 0x40, 0x38, 0xb6, 0xa2, 0x20, 0x25, 0x2b, 0xf5, 0x51,
+	0x83, 0xc9, 0xfc, 0x5e, 0x54, 0x87, 0x48, 0xe5, 0x10, 0xe6, 0xfa, 0x75, 0x88, 0xd4, 0xc8, 0xaa,
+	0x32, 0x62, 0xd9, 0x95, 0x9c, 0x11, 0x41, 0x64, 0x2b, 0x93, 0x65, 0xfd, 0xb3, 0x06, 0xd3, 0x17,
+	0x52, 0xb9, 0x32, 0x44, 0x64, 0x10, 0x64, 0xde, 0x1f, 0x01, 0x94, 0x9a, 0x59, 0x53, 0x66, 0x96,
+	0xed, 0xa5, 0x9c, 0x19, 0xae, 0xe0, 0x59, 0x3f, 0x9b, 0x1b, 0xc7, 0xa7, 0x96, 0x76, 0x72, 0x6a,
+	0x69, 0x7f, 0x4e, 0x2d, 0xed, 0xcb, 0x99, 0x55, 0x38, 0x39, 0xb3, 0x0a, 0xbf, 0xce, 0xac, 0xc2,
+	0xee, 0x1a, 0x0d, 0xe5, 0x5e, 0xb7, 0xed, 0xf8, 0x78, 0xe0, 0xbe, 0x50, 0x24, 0x5b, 0x7b, 0x5e,
+	0xc8, 0xfa, 0x84, 0xbd, 0xba, 0x7b, 0xa4, 0x58, 0xdb, 0x25, 0xf5, 0x73, 0x7f, 0xf4, 0x6f, 0x00,
+	0x9d, 0x68, 0xcc, 0x45, 0x77, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -382,6 +606,13 @@ type MsgClient interface {
 	// a set of accounts that can execute zero gas transactions against a
 	// whitelisted  set of smart contracts.
 	EditZeroGasActors(ctx context.Context, in *MsgEditZeroGasActors, opts ...grpc.CallOption) (*MsgEditZeroGasActorsResponse, error)
+	// SetGasSponsor creates or updates the "GasSponsor" owned by the sender and
+	// escrows the deposit in the x/sudo module account. Any account can become a
+	// gas sponsor without sudo permissions.
+	SetGasSponsor(ctx context.Context, in *MsgSetGasSponsor, opts ...grpc.CallOption) (*MsgSetGasSponsorResponse, error)
+	// RemoveGasSponsor deletes the "GasSponsor" owned by the sender and refunds
+	// its remaining escrowed balance.
+	RemoveGasSponsor(ctx context.Context, in *MsgRemoveGasSponsor, opts ...grpc.CallOption) (*MsgRemoveGasSponsorResponse, error)
 }
 
 type msgClient struct {
@@ -419,6 +650,24 @@ func (c *msgClient) EditZeroGasActors(ctx context.Context, in *MsgEditZeroGasAct
 	return out, nil
 }
 
+func (c *msgClient) SetGasSponsor(ctx context.Context, in *MsgSetGasSponsor, opts ...grpc.CallOption) (*MsgSetGasSponsorResponse, error) {
+	out := new(MsgSetGasSponsorResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/SetGasSponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveGasSponsor(ctx context.Context, in *MsgRemoveGasSponsor, opts ...grpc.CallOption) (*MsgRemoveGasSponsorResponse, error) {
+	out := new(MsgRemoveGasSponsorResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/RemoveGasSponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EditSudoers updates the "Sudoers" state
@@ -428,6 +677,13 @@ type MsgServer interface {
 	// a set of accounts that can execute zero gas transactions against a
 	// whitelisted  set of smart contracts.
 	EditZeroGasActors(context.Context, *MsgEditZeroGasActors) (*MsgEditZeroGasActorsResponse, error)
+	// SetGasSponsor creates or updates the "GasSponsor" owned by the sender and
+	// escrows the deposit in the x/sudo module account. Any account can become a
+	// gas sponsor without sudo permissions.
+	SetGasSponsor(context.Context, *MsgSetGasSponsor) (*MsgSetGasSponsorResponse, error)
+	// RemoveGasSponsor deletes the "GasSponsor" owned by the sender and refunds
+	// its remaining escrowed balance.
+	RemoveGasSponsor(context.Context, *MsgRemoveGasSponsor) (*MsgRemoveGasSponsorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -443,6 +699,12 @@ func (*UnimplementedMsgServer) ChangeRoot(ctx context.Context, req *MsgChangeRoo
 func (*UnimplementedMsgServer) EditZeroGasActors(ctx context.Context, req *MsgEditZeroGasActors) (*MsgEditZeroGasActorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditZeroGasActors not implemented")
 }
+func (*UnimplementedMsgServer) SetGasSponsor(ctx context.Context, req *MsgSetGasSponsor) (*MsgSetGasSponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGasSponsor not implemented")
+}
+func (*UnimplementedMsgServer) RemoveGasSponsor(ctx context.Context, req *MsgRemoveGasSponsor) (*MsgRemoveGasSponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGasSponsor not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -502,6 +764,42 @@ func _Msg_EditZeroGasActors_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetGasSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetGasSponsor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetGasSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/SetGasSponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetGasSponsor(ctx, req.(*MsgSetGasSponsor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveGasSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveGasSponsor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveGasSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/RemoveGasSponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveGasSponsor(ctx, req.(*MsgRemoveGasSponsor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.sudo.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -518,6 +816,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EditZeroGasActors",
 			Handler:    _Msg_EditZeroGasActors_Handler,
 		},
+		{
+			MethodName: "SetGasSponsor",
+			Handler:    _Msg_SetGasSponsor_Handler,
+		},
+		{
+			MethodName: "RemoveGasSponsor",
+			Handler:    _Msg_RemoveGasSponsor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/sudo/v1/tx.proto",
@@ -715,68 +1021,214 @@ func (m *MsgEditZeroGasActorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetGasSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgEditSudoers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetGasSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGasSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Contracts) > 0 {
-		for _, s := range m.Contracts {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEditSudoersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetGasSponsorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgChangeRoot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetGasSponsorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGasSponsorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewRoot)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.GasSponsor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeRootResponse) Size() (n int) {
-	if m == nil {
+func (m *MsgRemoveGasSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveGasSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveGasSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveGasSponsorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveGasSponsorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveGasSponsorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEditSudoers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditSudoersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeRootResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -808,13 +1260,375 @@ func (m *MsgEditZeroGasActorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetGasSponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetGasSponsorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasSponsor.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveGasSponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveGasSponsorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Refund.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
+func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditSudoers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditSudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditSudoersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditSudoersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeRootResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -837,49 +1651,67 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditSudoers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeRootResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditSudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditZeroGasActors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditZeroGasActors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditZeroGasActors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actors", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -889,25 +1721,26 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			if err := m.Actors.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -960,7 +1793,7 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEditZeroGasActorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -983,10 +1816,10 @@ func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditSudoersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditZeroGasActorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditSudoersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditZeroGasActorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1010,7 +1843,7 @@ func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
+func (m *MsgSetGasSponsor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1033,10 +1866,10 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeRoot: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetGasSponsor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetGasSponsor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1073,9 +1906,9 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1085,23 +1918,57 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewRoot = string(dAtA[iNdEx:postIndex])
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1124,7 +1991,7 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeRootResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetGasSponsorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1147,12 +2014,45 @@ func (m *MsgChangeRootResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeRootResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetGasSponsorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetGasSponsorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSponsor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasSponsor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1174,7 +2074,7 @@ func (m *MsgChangeRootResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditZeroGasActors) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveGasSponsor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1197,46 +2097,13 @@ func (m *MsgEditZeroGasActors) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditZeroGasActors: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveGasSponsor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditZeroGasActors: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveGasSponsor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actors", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Actors.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -1289,7 +2156,7 @@ func (m *MsgEditZeroGasActors) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditZeroGasActorsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveGasSponsorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1312,12 +2179,45 @@ func (m *MsgEditZeroGasActorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditZeroGasActorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveGasSponsorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditZeroGasActorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveGasSponsorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])